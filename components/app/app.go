@@ -9,6 +9,10 @@ import (
 	"github.com/iotaledger/iota-core/components/coreapi"
 	"github.com/iotaledger/iota-core/components/dashboard"
 	dashboardmetrics "github.com/iotaledger/iota-core/components/dashboard_metrics"
+	"github.com/iotaledger/iota-core/components/debugapi"
+	"github.com/iotaledger/iota-core/components/eventstream"
+	"github.com/iotaledger/iota-core/components/indexer"
+	"github.com/iotaledger/iota-core/components/inx"
 	"github.com/iotaledger/iota-core/components/metricstracker"
 	"github.com/iotaledger/iota-core/components/p2p"
 	"github.com/iotaledger/iota-core/components/protocol"
@@ -33,12 +37,16 @@ func App() *app.App {
 			profiling.Component,
 			restapi.Component,
 			coreapi.Component,
+			debugapi.Component,
 			metricstracker.Component,
 			protocol.Component,
 			blockissuer.Component,
 			activity.Component,
 			dashboardmetrics.Component,
 			dashboard.Component,
+			indexer.Component,
+			eventstream.Component,
+			inx.Component,
 		),
 	)
 }
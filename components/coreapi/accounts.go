@@ -0,0 +1,75 @@
+package coreapi
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	restapipkg "github.com/iotaledger/iota-core/pkg/restapi"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func accountIDFromParam(c echo.Context) (iotago.AccountID, error) {
+	accountID, err := iotago.IdentifierFromHexString(c.Param(restapipkg.ParameterAccountID))
+	if err != nil {
+		return iotago.AccountID{}, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid account ID: %s, error: %s", c.Param(restapipkg.ParameterAccountID), err)
+	}
+
+	return accountID, nil
+}
+
+func accountByID(c echo.Context) (*accountResponse, error) {
+	accountID, err := accountIDFromParam(c)
+	if err != nil {
+		return nil, err
+	}
+
+	engineInstance := deps.Protocol.MainEngineInstance()
+	latestCommittedSlot := engineInstance.Storage.Settings().LatestCommitment().Index()
+
+	accountData, exists, err := engineInstance.Ledger.Account(accountID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load account %s", accountID.ToHex())
+	} else if !exists {
+		return nil, errors.WithMessagef(echo.ErrNotFound, "account not found: %s", accountID.ToHex())
+	}
+
+	credits, _, err := engineInstance.Ledger.Credits(accountID, latestCommittedSlot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load block issuance credits of account %s", accountID.ToHex())
+	}
+
+	return &accountResponse{
+		AccountID:                 accountData.ID.ToHex(),
+		OutputID:                  accountData.OutputID.ToHex(),
+		Deposit:                   accountData.Deposit,
+		BlockIssuanceCredits:      credits,
+		CreditsUpdateSlotIndex:    accountData.Credits.UpdateTime,
+		LatestCommittedSlot:       latestCommittedSlot,
+		StoredMana:                accountData.Mana.Value,
+		StoredManaUpdateSlotIndex: accountData.Mana.UpdateTime,
+	}, nil
+}
+
+func accountManaByID(c echo.Context) (*accountManaResponse, error) {
+	accountID, err := accountIDFromParam(c)
+	if err != nil {
+		return nil, err
+	}
+
+	engineInstance := deps.Protocol.MainEngineInstance()
+	slotIndex := engineInstance.API().SlotTimeProvider().IndexFromTime(engineInstance.Clock.Accepted().RelativeTime())
+
+	mana, exists, err := engineInstance.Ledger.Mana(accountID, slotIndex)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load mana of account %s", accountID.ToHex())
+	} else if !exists {
+		return nil, errors.WithMessagef(echo.ErrNotFound, "account not found: %s", accountID.ToHex())
+	}
+
+	return &accountManaResponse{
+		AccountID: accountID.ToHex(),
+		Mana:      mana,
+		SlotIndex: slotIndex,
+	}, nil
+}
@@ -6,10 +6,13 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
 
+	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/hive.go/runtime/contextutils"
 	"github.com/iotaledger/inx-app/pkg/httpserver"
 	"github.com/iotaledger/iota-core/pkg/blockissuer"
 	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/notarization"
 	"github.com/iotaledger/iota-core/pkg/restapi"
 	iotago "github.com/iotaledger/iota.go/v4"
 )
@@ -34,18 +37,154 @@ func blockMetadataResponseByID(c echo.Context) (*blockMetadataResponse, error) {
 		return nil, err
 	}
 
-	// TODO: fill in blockReason, TxState, TxReason.
+	return blockMetadataResponseFromBlock(block)
+}
+
+func blockMetadataResponseFromBlock(block *model.Block) (*blockMetadataResponse, error) {
+	engineInstance := deps.Protocol.MainEngineInstance()
+
+	state, reason, err := blockStateWithReason(engineInstance, block.ID())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to retrieve state of block %s", block.ID().ToHex())
+	}
+
 	bmResponse := &blockMetadataResponse{
 		BlockID:            block.ID().ToHex(),
 		StrongParents:      block.Block().StrongParents.ToHex(),
 		WeakParents:        block.Block().WeakParents.ToHex(),
 		ShallowLikeParents: block.Block().ShallowLikeParents.ToHex(),
-		BlockState:         blockStatePending.String(),
+		BlockState:         state.String(),
+		BlockStateReason:   reason,
+	}
+
+	if transaction, isTransaction := block.Block().Payload.(*iotago.Transaction); isTransaction {
+		txState, txReason, err := transactionStateWithReason(engineInstance, block.ID(), transaction)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to retrieve state of transaction of block %s", block.ID().ToHex())
+		}
+
+		bmResponse.TxState = txState.String()
+		bmResponse.TxStateReason = txReason
+
+		// the payload of an orphaned block only needs to be reissued if the transaction can still be accepted.
+		reissuePayload := state == blockStateOrphaned && txState != txStateAccepted && txState != txStateInvalid && txState != txStateRejected
+		bmResponse.ReissuePayload = &reissuePayload
 	}
 
 	return bmResponse, nil
 }
 
+func blockStateWithReason(engineInstance *engine.Engine, blockID iotago.BlockID) (state blockState, reason string, err error) {
+	latestFinalizedSlot := engineInstance.Storage.Settings().LatestFinalizedSlot()
+
+	block, exists := engineInstance.BlockCache.Block(blockID)
+	if !exists || block.IsRootBlock() {
+		return committedBlockStateWithReason(engineInstance, blockID)
+	}
+
+	switch {
+	case block.IsAccepted() && blockID.Index() <= latestFinalizedSlot:
+		return blockStateFinalized, "", nil
+	case block.IsConfirmed():
+		return blockStateConfirmed, "", nil
+	case block.IsAccepted():
+		return blockStateAccepted, "", nil
+	case block.IsInvalid():
+		return blockStateOrphaned, "block is invalid", nil
+	case block.IsDropped():
+		return blockStateOrphaned, "block was dropped by the scheduler", nil
+	case blockID.Index() <= engineInstance.Storage.Settings().LatestCommitment().Index():
+		return blockStateOrphaned, "block was not accepted before its slot was committed", nil
+	case block.IsOrphaned():
+		return blockStateOrphaned, "block can no longer be accepted as it was not referenced in time", nil
+	default:
+		return blockStatePending, "", nil
+	}
+}
+
+// committedBlockStateWithReason derives the state of a block that is not tracked by the block cache anymore (or that
+// is a root block) from the commitment of its slot.
+func committedBlockStateWithReason(engineInstance *engine.Engine, blockID iotago.BlockID) (state blockState, reason string, err error) {
+	if blockID.Index() > engineInstance.Storage.Settings().LatestCommitment().Index() {
+		return blockStatePending, "", nil
+	}
+
+	included, err := engineInstance.Notarization.IsBlockIncluded(blockID)
+	if err != nil {
+		return blockStatePending, "", errors.Wrapf(err, "failed to check if block %s is included in the commitment of its slot", blockID.ToHex())
+	}
+
+	// nodes that started from a snapshot only know the root blocks of the slots that were committed before.
+	if !included {
+		if rootBlocks := engineInstance.Storage.RootBlocks(blockID.Index()); rootBlocks != nil {
+			if included, err = rootBlocks.Has(blockID); err != nil {
+				return blockStatePending, "", errors.Wrapf(err, "failed to check if block %s is a root block", blockID.ToHex())
+			}
+		}
+	}
+
+	switch {
+	case !included:
+		return blockStateOrphaned, "block was not accepted before its slot was committed", nil
+	case blockID.Index() <= engineInstance.Storage.Settings().LatestFinalizedSlot():
+		return blockStateFinalized, "", nil
+	default:
+		return blockStateAccepted, "", nil
+	}
+}
+
+func transactionStateWithReason(engineInstance *engine.Engine, blockID iotago.BlockID, transaction *iotago.Transaction) (state txState, reason string, err error) {
+	transactionMetadata, exists := engineInstance.Ledger.TransactionMetadataByAttachment(blockID)
+	if !exists {
+		return committedTransactionStateWithReason(engineInstance, blockID, transaction)
+	}
+
+	switch {
+	case transactionMetadata.IsInvalid():
+		// the callback is executed immediately as the event was already triggered.
+		transactionMetadata.OnInvalid(func(err error) {
+			reason = err.Error()
+		})
+
+		return txStateInvalid, reason, nil
+	case transactionMetadata.IsRejected():
+		return txStateRejected, "a conflicting transaction was accepted instead", nil
+	case transactionMetadata.IsAccepted() || transactionMetadata.IsCommitted():
+		return txStateAccepted, "", nil
+	case transactionMetadata.IsConflicting():
+		return txStateConflicting, "", nil
+	case transactionMetadata.IsExecuted():
+		return txStateExecuted, "", nil
+	case transactionMetadata.IsSolid():
+		return txStateSolid, "", nil
+	default:
+		return txStatePending, "", nil
+	}
+}
+
+// committedTransactionStateWithReason derives the state of a transaction that is not tracked by the mempool (anymore)
+// from the committed ledger state.
+func committedTransactionStateWithReason(engineInstance *engine.Engine, blockID iotago.BlockID, transaction *iotago.Transaction) (state txState, reason string, err error) {
+	transactionID, err := transaction.ID()
+	if err != nil {
+		return txStatePending, "", errors.Wrap(err, "failed to compute transaction ID")
+	}
+
+	committed, err := engineInstance.Ledger.TransactionCommitted(transactionID)
+	if err != nil {
+		return txStatePending, "", errors.Wrapf(err, "failed to check if transaction %s is committed", transactionID.ToHex())
+	}
+
+	switch {
+	case committed:
+		return txStateAccepted, "", nil
+	case blockID.Index() <= engineInstance.Storage.Settings().LatestCommitment().Index():
+		return txStateOrphaned, "transaction was evicted without being accepted", nil
+	default:
+		return txStatePending, "", nil
+	}
+}
+
 func blockIssuance(_ echo.Context) (*blockIssuanceResponse, error) {
 	references := deps.Protocol.TipManager.Tips(iotago.BlockMaxParents)
 	slotCommitment := deps.Protocol.MainEngineInstance().Storage.Settings().LatestCommitment()
@@ -130,3 +269,58 @@ func sendBlock(c echo.Context) (*blockCreatedResponse, error) {
 		BlockID: blockID.ToHex(),
 	}, nil
 }
+
+func getBlockInclusionProof(c echo.Context) (*blockInclusionProofResponse, error) {
+	blockID, err := httpserver.ParseBlockIDParam(c, restapi.ParameterBlockID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse block ID: %s", c.Param(restapi.ParameterBlockID))
+	}
+
+	engineInstance := deps.Protocol.MainEngineInstance()
+	if latestCommitment := engineInstance.Storage.Settings().LatestCommitment(); blockID.Index() > latestCommitment.Index() {
+		return nil, errors.WithMessagef(echo.ErrNotFound, "slot %d of block %s is not committed yet", blockID.Index(), blockID.ToHex())
+	}
+
+	commitment, err := engineInstance.Storage.Permanent.Commitments().Load(blockID.Index())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load commitment of slot %d", blockID.Index())
+	}
+
+	rootsStorage := engineInstance.Storage.Roots(blockID.Index())
+	if rootsStorage == nil {
+		return nil, errors.WithMessagef(echo.ErrNotFound, "roots of slot %d are not available", blockID.Index())
+	}
+
+	roots, err := rootsStorage.Load()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load roots of slot %d", blockID.Index())
+	}
+
+	proof, err := engineInstance.Notarization.BlockInclusionProof(blockID)
+	if err != nil {
+		if errors.Is(err, notarization.ErrBlockNotIncluded) {
+			return nil, errors.WithMessagef(echo.ErrNotFound, "block %s is not included in a commitment", blockID.ToHex())
+		}
+
+		return nil, errors.Wrapf(err, "failed to create inclusion proof for block %s", blockID.ToHex())
+	}
+
+	commitmentJSON, err := deps.Protocol.API().JSONEncode(commitment.Commitment())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode commitment")
+	}
+
+	rootsJSON, err := deps.Protocol.API().JSONEncode(roots)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode roots")
+	}
+
+	return &blockInclusionProofResponse{
+		BlockID:    blockID.ToHex(),
+		Commitment: commitmentJSON,
+		Roots:      rootsJSON,
+		SideNodes: lo.Map(proof.SideNodes, func(sideNode iotago.Identifier) string {
+			return sideNode.ToHex()
+		}),
+	}, nil
+}
@@ -0,0 +1,130 @@
+package coreapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	hivedb "github.com/iotaledger/hive.go/kvstore/database"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/notarization/slotnotarization"
+	"github.com/iotaledger/iota-core/pkg/protocol/snapshotcreator"
+	"github.com/iotaledger/iota-core/pkg/storage"
+	"github.com/iotaledger/iota-core/pkg/testsuite"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func TestBlockStateWithReason(t *testing.T) {
+	ts, engineInstance := newCommittedTestSuite(t)
+	defer ts.Shutdown()
+
+	// blocks that are not accepted yet are pending.
+	state, reason, err := blockStateWithReason(engineInstance, ts.BlockID("6.2"))
+	require.NoError(t, err)
+	require.Equal(t, blockStatePending, state)
+	require.Empty(t, reason)
+
+	// blocks of committed slots are accepted (or finalized) if the commitment includes them.
+	state, reason, err = committedBlockStateWithReason(engineInstance, ts.BlockID("1.1"))
+	require.NoError(t, err)
+	require.Equal(t, expectedAcceptedBlockState(engineInstance, 1), state)
+	require.Empty(t, reason)
+
+	// blocks of committed slots that the commitment does not include are orphaned.
+	state, reason, err = blockStateWithReason(engineInstance, iotago.NewSlotIdentifier(1, iotago.Identifier{1}))
+	require.NoError(t, err)
+	require.Equal(t, blockStateOrphaned, state)
+	require.Equal(t, "block was not accepted before its slot was committed", reason)
+
+	// unknown blocks of slots that are not committed yet are still pending.
+	state, reason, err = blockStateWithReason(engineInstance, iotago.NewSlotIdentifier(6, iotago.Identifier{1}))
+	require.NoError(t, err)
+	require.Equal(t, blockStatePending, state)
+	require.Empty(t, reason)
+}
+
+func TestCommittedTransactionStateWithReason(t *testing.T) {
+	ts, engineInstance := newCommittedTestSuite(t)
+	defer ts.Shutdown()
+
+	transaction := tpkg.RandTransaction()
+	transactionID := lo.PanicOnErr(transaction.ID())
+
+	// transactions of slots that are not committed yet can still be accepted.
+	state, reason, err := transactionStateWithReason(engineInstance, iotago.NewSlotIdentifier(6, iotago.Identifier{1}), transaction)
+	require.NoError(t, err)
+	require.Equal(t, txStatePending, state)
+	require.Empty(t, reason)
+
+	// transactions that were evicted without being accepted are orphaned.
+	state, reason, err = transactionStateWithReason(engineInstance, iotago.NewSlotIdentifier(1, iotago.Identifier{1}), transaction)
+	require.NoError(t, err)
+	require.Equal(t, txStateOrphaned, state)
+	require.Equal(t, "transaction was evicted without being accepted", reason)
+
+	// transactions whose outputs are part of the committed ledger state are accepted.
+	require.NoError(t, engineInstance.Ledger.AddUnspentOutput(ledgerstate.CreateOutput(engineInstance.API(), iotago.OutputIDFromTransactionIDAndIndex(transactionID, 0), iotago.NewSlotIdentifier(1, iotago.Identifier{1}), 1, engineInstance.API().SlotTimeProvider().StartTime(1), &iotago.BasicOutput{
+		Amount: 1,
+		Conditions: iotago.BasicOutputUnlockConditions{
+			&iotago.AddressUnlockCondition{Address: tpkg.RandEd25519Address()},
+		},
+	})))
+
+	state, reason, err = transactionStateWithReason(engineInstance, iotago.NewSlotIdentifier(1, iotago.Identifier{1}), transaction)
+	require.NoError(t, err)
+	require.Equal(t, txStateAccepted, state)
+	require.Empty(t, reason)
+}
+
+// newCommittedTestSuite starts two validators that issue blocks until slot 1 is committed.
+func newCommittedTestSuite(t *testing.T) (*testsuite.TestSuite, *engine.Engine) {
+	ts := testsuite.NewTestSuite(t, testsuite.WithSnapshotOptions(snapshotcreator.WithDatabaseEngine(hivedb.EngineMapDB)))
+
+	node1 := ts.AddValidatorNode("node1", 50)
+	node2 := ts.AddValidatorNode("node2", 50)
+
+	nodeOptions := []options.Option[protocol.Protocol]{
+		protocol.WithStorageOptions(storage.WithDBEngine(hivedb.EngineMapDB)),
+		protocol.WithNotarizationProvider(
+			slotnotarization.NewProvider(slotnotarization.WithMinCommittableSlotAge(1)),
+		),
+	}
+	ts.Run(map[string][]options.Option[protocol.Protocol]{
+		"node1": nodeOptions,
+		"node2": nodeOptions,
+	})
+	ts.Wait()
+
+	ts.AssertNodeState(ts.Nodes(),
+		testsuite.WithSnapshotImported(true),
+		testsuite.WithLatestCommitment(iotago.NewEmptyCommitment()),
+	)
+
+	ts.IssueBlockAtSlot("1.1", 1, iotago.NewEmptyCommitment(), node1, iotago.EmptyBlockID())
+	ts.IssueBlockAtSlot("1.2", 1, iotago.NewEmptyCommitment(), node2, iotago.EmptyBlockID())
+	ts.IssueBlockAtSlot("2.2", 2, iotago.NewEmptyCommitment(), node2, ts.BlockIDs("1.1", "1.2")...)
+	ts.IssueBlockAtSlot("3.1", 3, iotago.NewEmptyCommitment(), node1, ts.BlockID("2.2"))
+	ts.IssueBlockAtSlot("4.2", 4, iotago.NewEmptyCommitment(), node2, ts.BlockID("3.1"))
+	ts.IssueBlockAtSlot("5.1", 5, iotago.NewEmptyCommitment(), node1, ts.BlockID("4.2"))
+	ts.IssueBlockAtSlot("6.2", 6, iotago.NewEmptyCommitment(), node2, ts.BlockID("5.1"))
+
+	ts.AssertNodeState(ts.Nodes(),
+		testsuite.WithLatestCommitmentSlotIndex(1),
+	)
+
+	return ts, node1.Protocol.MainEngineInstance()
+}
+
+// expectedAcceptedBlockState returns the state of an accepted block of the given slot.
+func expectedAcceptedBlockState(engineInstance *engine.Engine, slot iotago.SlotIndex) blockState {
+	if slot <= engineInstance.Storage.Settings().LatestFinalizedSlot() {
+		return blockStateFinalized
+	}
+
+	return blockStateAccepted
+}
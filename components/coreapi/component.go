@@ -13,6 +13,8 @@ import (
 	"github.com/iotaledger/iota-core/components/metricstracker"
 	"github.com/iotaledger/iota-core/components/restapi"
 	"github.com/iotaledger/iota-core/pkg/blockissuer"
+	"github.com/iotaledger/iota-core/pkg/network/manualpeering"
+	"github.com/iotaledger/iota-core/pkg/network/p2p"
 	"github.com/iotaledger/iota-core/pkg/protocol"
 	restapipkg "github.com/iotaledger/iota-core/pkg/restapi"
 	iotago "github.com/iotaledger/iota.go/v4"
@@ -37,6 +39,10 @@ const (
 	// GET returns block metadata.
 	RouteBlockMetadata = "/blocks/:" + restapipkg.ParameterBlockID + "/metadata"
 
+	// RouteBlockInclusionProof is the route for getting a Merkle proof that a block is included in the commitment of its slot.
+	// GET returns the proof together with the commitment and its roots.
+	RouteBlockInclusionProof = "/blocks/:" + restapipkg.ParameterBlockID + "/inclusion-proof"
+
 	// RouteBlocks is the route for creating new blocks.
 	// POST creates a single new block and returns the new block ID.
 	// The block is parsed based on the given type in the request "Content-Type" header.
@@ -55,6 +61,10 @@ const (
 	// GET returns the output metadata.
 	RouteOutputMetadata = "/outputs/:" + restapipkg.ParameterOutputID + "/metadata"
 
+	// RouteOutputProof is the route for getting a Merkle proof that an unspent output is part of the state root of the latest commitment.
+	// GET returns the proof together with the commitment and its roots.
+	RouteOutputProof = "/outputs/:" + restapipkg.ParameterOutputID + "/proof"
+
 	// RouteTransactionsIncludedBlock is the route for getting the block that was first confirmed for a given transaction ID.
 	// GET returns the block based on the given type in the request "Accept" header.
 	// MIMEApplicationJSON => json.
@@ -65,6 +75,12 @@ const (
 	// GET returns block metadata (including info about "promotion/reattachment needed").
 	RouteTransactionsIncludedBlockMetadata = "/transactions/:" + restapipkg.ParameterTransactionID + "/included-block/metadata"
 
+	// RouteTransactionsSimulate is the route for executing a transaction against the current ledger state without attaching it.
+	// POST returns the outputs that the transaction would create or the reason why it is invalid.
+	// MIMEApplicationJSON => json.
+	// MIMEVendorIOTASerializer => bytes.
+	RouteTransactionsSimulate = "/transactions/simulate"
+
 	// RouteCommitmentByID is the route for getting a slot commitment by its ID.
 	// GET returns the commitment.
 	// MIMEApplicationJSON => json.
@@ -93,7 +109,11 @@ const (
 	// GET returns the account mana details.
 	RouteAccountMana = "/accounts/:" + restapipkg.ParameterAccountID + "/mana"
 
-	// RoutePeer is the route for getting peers by their peerID.
+	// RouteEquivocationsByIndex is the route for getting the evidence of all equivocations that were detected in a slot.
+	// GET returns the conflicting blocks of the validators that equivocated.
+	RouteEquivocationsByIndex = "/equivocations/by-index/:" + restapipkg.ParameterSlotIndex
+
+	// RoutePeer is the route for getting peers by their peerID (the base58 encoded public key of the peer).
 	// GET returns the peer
 	// DELETE deletes the peer.
 	RoutePeer = "/peers/:" + restapipkg.ParameterPeerID
@@ -104,12 +124,16 @@ const (
 	RoutePeers = "/peers"
 
 	// RouteControlDatabasePrune is the control route to manually prune the database.
-	// POST prunes the database.
+	// POST starts a job that prunes the database.
 	RouteControlDatabasePrune = "/control/database/prune"
 
 	// RouteControlSnapshotsCreate is the control route to manually create a snapshot files.
-	// POST creates a full snapshot.
+	// POST starts a job that creates a full snapshot.
 	RouteControlSnapshotsCreate = "/control/snapshots/create"
+
+	// RouteControlJob is the control route to get the status of a pruning or snapshot creation job by its ID.
+	// GET returns the job.
+	RouteControlJob = "/control/jobs/:" + restapipkg.ParameterJobID
 )
 
 func init() {
@@ -138,6 +162,8 @@ type dependencies struct {
 	RestRouteManager *restapi.RestRouteManager
 	BlockIssuer      *blockissuer.BlockIssuer
 	MetricsTracker   *metricstracker.MetricsTracker
+	ManualPeeringMgr *manualpeering.Manager
+	P2PManager       *p2p.Manager
 }
 
 func configure() error {
@@ -176,7 +202,6 @@ func configure() error {
 	})
 
 	routeGroup.GET(RouteBlockMetadata, func(c echo.Context) error {
-		// TODO: fill in blockReason, TxState, TxReason.
 		resp, err := blockMetadataResponseByID(c)
 		if err != nil {
 			return err
@@ -185,6 +210,15 @@ func configure() error {
 		return httpserver.JSONResponse(c, http.StatusOK, resp)
 	}, checkNodeSynced())
 
+	routeGroup.GET(RouteBlockInclusionProof, func(c echo.Context) error {
+		resp, err := getBlockInclusionProof(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
 	routeGroup.POST(RouteBlocks, func(c echo.Context) error {
 		resp, err := sendBlock(c)
 		if err != nil {
@@ -260,6 +294,33 @@ func configure() error {
 		return httpserver.JSONResponse(c, http.StatusOK, resp)
 	}, checkNodeSynced())
 
+	routeGroup.GET(RouteAccountsByAcciuntID, func(c echo.Context) error {
+		resp, err := accountByID(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	}, checkNodeSynced())
+
+	routeGroup.GET(RouteAccountMana, func(c echo.Context) error {
+		resp, err := accountManaByID(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	}, checkNodeSynced())
+
+	routeGroup.GET(RouteEquivocationsByIndex, func(c echo.Context) error {
+		resp, err := equivocationsByIndex(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
 	routeGroup.GET(RouteOutput, func(c echo.Context) error {
 		output, err := getOutput(c)
 		if err != nil {
@@ -279,6 +340,15 @@ func configure() error {
 		return httpserver.JSONResponse(c, http.StatusOK, resp)
 	}, checkNodeSynced())
 
+	routeGroup.GET(RouteOutputProof, func(c echo.Context) error {
+		resp, err := getOutputProof(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	}, checkNodeSynced())
+
 	routeGroup.GET(RouteTransactionsIncludedBlock, func(c echo.Context) error {
 		block, err := blockByTransactionID(c)
 		if err != nil {
@@ -297,6 +367,72 @@ func configure() error {
 		return httpserver.JSONResponse(c, http.StatusOK, resp)
 	}, checkNodeSynced())
 
+	routeGroup.POST(RouteTransactionsSimulate, func(c echo.Context) error {
+		resp, err := simulateTransaction(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	}, checkNodeSynced())
+
+	routeGroup.GET(RoutePeers, func(c echo.Context) error {
+		return httpserver.JSONResponse(c, http.StatusOK, getPeers())
+	})
+
+	routeGroup.POST(RoutePeers, func(c echo.Context) error {
+		resp, err := addPeer(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RoutePeer, func(c echo.Context) error {
+		resp, err := getPeer(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.DELETE(RoutePeer, func(c echo.Context) error {
+		if err := removePeer(c); err != nil {
+			return err
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	})
+
+	routeGroup.POST(RouteControlDatabasePrune, func(c echo.Context) error {
+		resp, err := pruneDatabase(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusAccepted, resp)
+	})
+
+	routeGroup.POST(RouteControlSnapshotsCreate, func(c echo.Context) error {
+		resp, err := createSnapshot(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusAccepted, resp)
+	})
+
+	routeGroup.GET(RouteControlJob, func(c echo.Context) error {
+		resp, err := controlJobByID(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
 	return nil
 }
 
@@ -320,10 +456,9 @@ func checkNodeSynced() echo.MiddlewareFunc {
 func checkUpcomingUnsupportedProtocolVersion() echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			// todo update with protocol upgrades support
-			// if !deps.ProtocolManager.NextPendingSupported() {
-			//	return errors.WithMessage(echo.ErrServiceUnavailable, "node does not support the upcoming protocol upgrade")
-			// }
+			if !deps.Protocol.NextPendingSupported() {
+				return errors.WithMessage(echo.ErrServiceUnavailable, "node does not support the upcoming protocol upgrade")
+			}
 
 			return next(c)
 		}
@@ -0,0 +1,275 @@
+package coreapi
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	protocolcomponent "github.com/iotaledger/iota-core/components/protocol"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	restapipkg "github.com/iotaledger/iota-core/pkg/restapi"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+const (
+	controlJobTypePruning  = "pruning"
+	controlJobTypeSnapshot = "snapshot"
+
+	controlJobStatusRunning   = "running"
+	controlJobStatusSucceeded = "succeeded"
+	controlJobStatusFailed    = "failed"
+
+	// maxFinishedControlJobs is the amount of finished jobs that are kept to be queried by their ID.
+	maxFinishedControlJobs = 100
+)
+
+// controlJobs keeps track of the background jobs that were started via the control API.
+var controlJobs = newControlJobManager(logFinishedControlJob)
+
+// controlJobManager runs the long-running control operations (pruning, snapshot creation) in the background and keeps
+// track of their status. Only a single job is allowed to run at the same time.
+type controlJobManager struct {
+	jobs         map[uint64]*controlJobResponse
+	finishedJobs []uint64
+	lastJobID    uint64
+	runningJobID uint64
+	mutex        sync.RWMutex
+
+	// jobFinished is called with a copy of every job that finished.
+	jobFinished func(job *controlJobResponse)
+}
+
+func newControlJobManager(jobFinished func(job *controlJobResponse)) *controlJobManager {
+	return &controlJobManager{
+		jobs:        make(map[uint64]*controlJobResponse),
+		jobFinished: jobFinished,
+	}
+}
+
+// Start starts a new background job of the given type, unless another job is still running.
+func (m *controlJobManager) Start(jobType string, jobFunc func() (any, error)) (*controlJobResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.runningJobID != 0 {
+		return nil, errors.WithMessagef(echo.ErrConflict, "job %d of type %s is still running", m.runningJobID, m.jobs[m.runningJobID].Type)
+	}
+
+	m.lastJobID++
+	m.runningJobID = m.lastJobID
+
+	job := &controlJobResponse{
+		JobID:     m.lastJobID,
+		Type:      jobType,
+		Status:    controlJobStatusRunning,
+		StartedAt: time.Now().Unix(),
+	}
+	m.jobs[job.JobID] = job
+
+	go func() {
+		result, err := jobFunc()
+		m.finish(job.JobID, result, err)
+	}()
+
+	return m.copyJob(job), nil
+}
+
+// Job returns the current state of the job with the given ID.
+func (m *controlJobManager) Job(jobID uint64) (*controlJobResponse, bool) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	job, exists := m.jobs[jobID]
+	if !exists {
+		return nil, false
+	}
+
+	return m.copyJob(job), true
+}
+
+func (m *controlJobManager) finish(jobID uint64, result any, err error) {
+	m.mutex.Lock()
+
+	job := m.jobs[jobID]
+	job.FinishedAt = time.Now().Unix()
+
+	if err != nil {
+		job.Status = controlJobStatusFailed
+		job.Error = err.Error()
+	} else {
+		job.Status = controlJobStatusSucceeded
+		job.Result = result
+	}
+
+	m.runningJobID = 0
+	m.finishedJobs = append(m.finishedJobs, jobID)
+
+	for len(m.finishedJobs) > maxFinishedControlJobs {
+		delete(m.jobs, m.finishedJobs[0])
+		m.finishedJobs = m.finishedJobs[1:]
+	}
+
+	finishedJob := m.copyJob(job)
+	m.mutex.Unlock()
+
+	m.jobFinished(finishedJob)
+}
+
+func (m *controlJobManager) copyJob(job *controlJobResponse) *controlJobResponse {
+	jobCopy := *job
+
+	return &jobCopy
+}
+
+// logFinishedControlJob logs the outcome of the given finished job.
+func logFinishedControlJob(job *controlJobResponse) {
+	if job.Status == controlJobStatusFailed {
+		Component.LogWarnf("control job %d of type %s failed: %s", job.JobID, job.Type, job.Error)
+		return
+	}
+
+	Component.LogInfof("control job %d of type %s finished", job.JobID, job.Type)
+}
+
+func pruneDatabase(c echo.Context) (*controlJobResponse, error) {
+	request := &pruneDatabaseRequest{}
+	if err := c.Bind(request); err != nil {
+		return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid request, error: %s", err)
+	}
+
+	storage := deps.Protocol.MainEngineInstance().Storage
+
+	targetIndex, err := pruningTargetIndex(request, storage.Settings().LatestFinalizedSlot())
+	if err != nil {
+		return nil, err
+	}
+
+	return controlJobs.Start(controlJobTypePruning, func() (any, error) {
+		storage.PruneUntilSlot(targetIndex)
+
+		lastPrunedSlot, hasPruned := storage.LastPrunedSlot()
+		if !hasPruned {
+			return nil, errors.Errorf("nothing was pruned until slot %d", targetIndex)
+		}
+
+		return &pruneDatabaseResult{
+			Index: lastPrunedSlot,
+		}, nil
+	})
+}
+
+// pruningTargetIndex returns the slot until which the database should be pruned according to the given request.
+func pruningTargetIndex(request *pruneDatabaseRequest, latestFinalizedSlot iotago.SlotIndex) (targetIndex iotago.SlotIndex, err error) {
+	if (request.Index == nil) == (request.Depth == nil) {
+		return 0, errors.WithMessage(httpserver.ErrInvalidParameter, "either index or depth has to be specified")
+	}
+
+	if request.Depth != nil {
+		if *request.Depth >= latestFinalizedSlot {
+			return 0, errors.WithMessagef(httpserver.ErrInvalidParameter, "pruning depth %d exceeds latest finalized slot %d", *request.Depth, latestFinalizedSlot)
+		}
+
+		targetIndex = latestFinalizedSlot - *request.Depth
+	} else {
+		targetIndex = *request.Index
+	}
+
+	// only finalized slots can be pruned, as everything above might still be needed to switch chains.
+	if targetIndex > latestFinalizedSlot {
+		return 0, errors.WithMessagef(httpserver.ErrInvalidParameter, "target index %d is above latest finalized slot %d", targetIndex, latestFinalizedSlot)
+	}
+
+	return targetIndex, nil
+}
+
+func createSnapshot(c echo.Context) (*controlJobResponse, error) {
+	request := &createSnapshotRequest{}
+	if err := c.Bind(request); err != nil {
+		return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid request, error: %s", err)
+	}
+
+	engineInstance := deps.Protocol.MainEngineInstance()
+
+	latestCommitmentIndex := engineInstance.Storage.Settings().LatestCommitment().Index()
+	targetIndex := latestCommitmentIndex
+	if request.Index != nil {
+		targetIndex = *request.Index
+	}
+
+	if targetIndex > latestCommitmentIndex {
+		return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "target index %d is above latest commitment %d", targetIndex, latestCommitmentIndex)
+	}
+
+	// the state diffs needed to roll back the ledger to the target slot are gone once the slot was pruned.
+	if lastPrunedSlot, hasPruned := engineInstance.Storage.LastPrunedSlot(); hasPruned && targetIndex <= lastPrunedSlot {
+		return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "target index %d is already pruned (last pruned slot %d)", targetIndex, lastPrunedSlot)
+	}
+
+	filePath := filepath.Join(filepath.Dir(protocolcomponent.ParamsProtocol.Snapshot.Path), fmt.Sprintf("snapshot_%d.bin", targetIndex))
+
+	return controlJobs.Start(controlJobTypeSnapshot, func() (any, error) {
+		return writeSnapshot(engineInstance, filePath, targetIndex)
+	})
+}
+
+// writeSnapshot writes the snapshot of the given slot to the given file and returns its size and hash.
+func writeSnapshot(engineInstance *engine.Engine, filePath string, targetIndex iotago.SlotIndex) (*createSnapshotResult, error) {
+	if err := engineInstance.WriteSnapshot(filePath, targetIndex); err != nil {
+		return nil, err
+	}
+
+	fileSize, fileHash, err := snapshotFileInfo(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &createSnapshotResult{
+		Index:    targetIndex,
+		FilePath: filePath,
+		FileSize: fileSize,
+		FileHash: hex.EncodeToString(fileHash),
+	}, nil
+}
+
+func controlJobByID(c echo.Context) (*controlJobResponse, error) {
+	jobID, err := httpserver.ParseUint64Param(c, restapipkg.ParameterJobID)
+	if err != nil {
+		return nil, err
+	}
+
+	job, exists := controlJobs.Job(jobID)
+	if !exists {
+		return nil, errors.WithMessagef(echo.ErrNotFound, "job %d not found", jobID)
+	}
+
+	return job, nil
+}
+
+// snapshotFileInfo returns the size and the BLAKE2b-256 hash of the given file.
+func snapshotFileInfo(filePath string) (size int64, hash []byte, err error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "failed to open snapshot file")
+	}
+	defer file.Close()
+
+	hasher, err := blake2b.New256(nil)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "failed to create hasher")
+	}
+
+	if size, err = io.Copy(hasher, file); err != nil {
+		return 0, nil, errors.Wrap(err, "failed to hash snapshot file")
+	}
+
+	return size, hasher.Sum(nil), nil
+}
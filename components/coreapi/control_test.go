@@ -0,0 +1,101 @@
+package coreapi
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestControlJobManager_RejectsConcurrentJobs(t *testing.T) {
+	finishedJobs := make(chan *controlJobResponse, 2)
+	manager := newControlJobManager(func(job *controlJobResponse) { finishedJobs <- job })
+
+	releaseJob := make(chan struct{})
+	job, err := manager.Start(controlJobTypePruning, func() (any, error) {
+		<-releaseJob
+		return &pruneDatabaseResult{Index: 1}, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, controlJobStatusRunning, job.Status)
+
+	// a second job is rejected while the first one is still running.
+	_, err = manager.Start(controlJobTypeSnapshot, func() (any, error) { return nil, nil })
+	require.ErrorIs(t, err, echo.ErrConflict)
+
+	close(releaseJob)
+	finishedJob := <-finishedJobs
+	require.Equal(t, job.JobID, finishedJob.JobID)
+	require.Equal(t, controlJobStatusSucceeded, finishedJob.Status)
+	require.Equal(t, &pruneDatabaseResult{Index: 1}, finishedJob.Result)
+
+	storedJob, exists := manager.Job(job.JobID)
+	require.True(t, exists)
+	require.Equal(t, finishedJob, storedJob)
+
+	// new jobs can be started once the running job finished.
+	secondJob, err := manager.Start(controlJobTypeSnapshot, func() (any, error) { return nil, os.ErrNotExist })
+	require.NoError(t, err)
+	require.Greater(t, secondJob.JobID, job.JobID)
+
+	select {
+	case finishedJob = <-finishedJobs:
+		require.Equal(t, controlJobStatusFailed, finishedJob.Status)
+		require.Equal(t, os.ErrNotExist.Error(), finishedJob.Error)
+	case <-time.After(10 * time.Second):
+		require.FailNow(t, "second job did not finish")
+	}
+}
+
+func TestPruningTargetIndex(t *testing.T) {
+	slot := func(index iotago.SlotIndex) *iotago.SlotIndex { return &index }
+
+	targetIndex, err := pruningTargetIndex(&pruneDatabaseRequest{Index: slot(5)}, 10)
+	require.NoError(t, err)
+	require.EqualValues(t, 5, targetIndex)
+
+	targetIndex, err = pruningTargetIndex(&pruneDatabaseRequest{Depth: slot(3)}, 10)
+	require.NoError(t, err)
+	require.EqualValues(t, 7, targetIndex)
+
+	// slots above the latest finalized slot might still be needed to switch chains.
+	_, err = pruningTargetIndex(&pruneDatabaseRequest{Index: slot(11)}, 10)
+	require.ErrorIs(t, err, httpserver.ErrInvalidParameter)
+
+	_, err = pruningTargetIndex(&pruneDatabaseRequest{Depth: slot(10)}, 10)
+	require.ErrorIs(t, err, httpserver.ErrInvalidParameter)
+
+	// exactly one of index and depth has to be specified.
+	_, err = pruningTargetIndex(&pruneDatabaseRequest{}, 10)
+	require.ErrorIs(t, err, httpserver.ErrInvalidParameter)
+
+	_, err = pruningTargetIndex(&pruneDatabaseRequest{Index: slot(5), Depth: slot(3)}, 10)
+	require.ErrorIs(t, err, httpserver.ErrInvalidParameter)
+}
+
+func TestWriteSnapshot(t *testing.T) {
+	ts, engineInstance := newCommittedTestSuite(t)
+	defer ts.Shutdown()
+
+	filePath := filepath.Join(t.TempDir(), "snapshot_1.bin")
+
+	result, err := writeSnapshot(engineInstance, filePath, 1)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, result.Index)
+	require.Equal(t, filePath, result.FilePath)
+
+	fileBytes, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+	require.EqualValues(t, len(fileBytes), result.FileSize)
+
+	fileHash := blake2b.Sum256(fileBytes)
+	require.Equal(t, hex.EncodeToString(fileHash[:]), result.FileHash)
+}
@@ -0,0 +1,55 @@
+package coreapi
+
+import (
+	"encoding/json"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/pkg/model"
+	restapipkg "github.com/iotaledger/iota-core/pkg/restapi"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func equivocationsByIndex(c echo.Context) (*equivocationsResponse, error) {
+	indexUint64, err := httpserver.ParseUint64Param(c, restapipkg.ParameterSlotIndex)
+	if err != nil {
+		return nil, err
+	}
+	index := iotago.SlotIndex(indexUint64)
+
+	store := deps.Protocol.MainEngineInstance().Storage.Equivocations(index)
+	if store == nil {
+		return nil, errors.WithMessagef(echo.ErrNotFound, "equivocations of slot %d are not available", index)
+	}
+
+	equivocations := make([]*equivocationResponse, 0)
+	if err = store.ForEach(func(equivocation *model.Equivocation) error {
+		block1, block2 := equivocation.Blocks()
+
+		blocksJSON := make([]json.RawMessage, 0, 2)
+		for _, block := range []*model.Block{block1, block2} {
+			blockJSON, err := deps.Protocol.API().JSONEncode(block.Block())
+			if err != nil {
+				return errors.Wrapf(err, "failed to encode block %s", block.ID())
+			}
+
+			blocksJSON = append(blocksJSON, blockJSON)
+		}
+
+		equivocations = append(equivocations, &equivocationResponse{
+			IssuerID: equivocation.IssuerID().ToHex(),
+			Blocks:   blocksJSON,
+		})
+
+		return nil
+	}); err != nil {
+		return nil, errors.Wrapf(err, "failed to load equivocations of slot %d", index)
+	}
+
+	return &equivocationsResponse{
+		Index:         index,
+		Equivocations: equivocations,
+	}, nil
+}
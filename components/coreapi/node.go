@@ -17,7 +17,7 @@ func info() (*infoResponse, error) {
 		Version:  deps.AppInfo.Version,
 		IssuerID: deps.BlockIssuer.Account.ID().ToHex(),
 		Status: nodeStatus{
-			IsHealthy:            syncStatus.NodeSynced,
+			IsHealthy:            syncStatus.NodeSynced && deps.Protocol.NextPendingSupported(),
 			ATT:                  cl.Accepted().Time(),
 			RATT:                 cl.Accepted().RelativeTime(),
 			CTT:                  cl.Confirmed().Time(),
@@ -0,0 +1,118 @@
+package coreapi
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/crypto/identity"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/pkg/network/manualpeering"
+	restapipkg "github.com/iotaledger/iota-core/pkg/restapi"
+)
+
+func parsePeerPublicKeyParam(c echo.Context) (ed25519.PublicKey, error) {
+	publicKey, err := ed25519.PublicKeyFromString(c.Param(restapipkg.ParameterPeerID))
+	if err != nil {
+		return ed25519.PublicKey{}, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid peer public key, error: %s", err)
+	}
+
+	return publicKey, nil
+}
+
+func peerResponseFromKnownPeer(knownPeer *manualpeering.KnownPeer) *peerResponse {
+	peerID := identity.NewID(knownPeer.PublicKey)
+
+	resp := &peerResponse{
+		ID:                  peerID.EncodeBase58(),
+		PublicKey:           knownPeer.PublicKey.String(),
+		Address:             knownPeer.Address,
+		ConnectionDirection: string(knownPeer.ConnDirection),
+		ConnectionStatus:    string(knownPeer.ConnStatus),
+	}
+
+	if neighbor, err := deps.P2PManager.Neighbor(peerID); err == nil {
+		resp.PacketsRead = neighbor.PacketsRead()
+		resp.PacketsWritten = neighbor.PacketsWritten()
+	}
+
+	return resp
+}
+
+func knownPeerByPublicKey(publicKey ed25519.PublicKey) (*manualpeering.KnownPeer, error) {
+	for _, knownPeer := range deps.ManualPeeringMgr.GetPeers() {
+		if knownPeer.PublicKey == publicKey {
+			return knownPeer, nil
+		}
+	}
+
+	return nil, errors.WithMessagef(echo.ErrNotFound, "peer not found: %s", publicKey)
+}
+
+func getPeers() []*peerResponse {
+	knownPeers := deps.ManualPeeringMgr.GetPeers()
+
+	peers := make([]*peerResponse, 0, len(knownPeers))
+	for _, knownPeer := range knownPeers {
+		peers = append(peers, peerResponseFromKnownPeer(knownPeer))
+	}
+
+	return peers
+}
+
+func getPeer(c echo.Context) (*peerResponse, error) {
+	publicKey, err := parsePeerPublicKeyParam(c)
+	if err != nil {
+		return nil, err
+	}
+
+	knownPeer, err := knownPeerByPublicKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return peerResponseFromKnownPeer(knownPeer), nil
+}
+
+func addPeer(c echo.Context) (*peerResponse, error) {
+	request := &manualpeering.KnownPeerToAdd{}
+	if err := c.Bind(request); err != nil {
+		return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid peer, error: %s", err)
+	}
+
+	if request.PublicKey == (ed25519.PublicKey{}) {
+		return nil, errors.WithMessage(httpserver.ErrInvalidParameter, "invalid peer, error: public key missing")
+	}
+
+	if request.Address == "" {
+		return nil, errors.WithMessage(httpserver.ErrInvalidParameter, "invalid peer, error: address missing")
+	}
+
+	if err := deps.ManualPeeringMgr.AddPersistentPeer(request); err != nil {
+		return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "failed to add peer, error: %s", err)
+	}
+
+	knownPeer, err := knownPeerByPublicKey(request.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return peerResponseFromKnownPeer(knownPeer), nil
+}
+
+func removePeer(c echo.Context) error {
+	publicKey, err := parsePeerPublicKeyParam(c)
+	if err != nil {
+		return err
+	}
+
+	if _, err = knownPeerByPublicKey(publicKey); err != nil {
+		return err
+	}
+
+	if err = deps.ManualPeeringMgr.RemovePeer(publicKey); err != nil {
+		return errors.WithMessagef(echo.ErrInternalServerError, "failed to remove peer, error: %s", err)
+	}
+
+	return nil
+}
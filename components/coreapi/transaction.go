@@ -1,11 +1,16 @@
 package coreapi
 
 import (
+	"io"
+
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
 
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/serializer/v2/serix"
 	"github.com/iotaledger/inx-app/pkg/httpserver"
 	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledger"
 	restapipkg "github.com/iotaledger/iota-core/pkg/restapi"
 	iotago "github.com/iotaledger/iota.go/v4"
 )
@@ -48,14 +53,79 @@ func blockMetadataFromTransactionID(c echo.Context) (*blockMetadataResponse, err
 		return nil, err
 	}
 
-	// TODO: fill in blockReason, TxState, TxReason.
-	bmResponse := &blockMetadataResponse{
-		BlockID:            block.ID().ToHex(),
-		StrongParents:      block.Block().StrongParents.ToHex(),
-		WeakParents:        block.Block().WeakParents.ToHex(),
-		ShallowLikeParents: block.Block().ShallowLikeParents.ToHex(),
-		BlockState:         blockStatePending.String(),
+	return blockMetadataResponseFromBlock(block)
+}
+
+func simulateTransaction(c echo.Context) (*transactionSimulationResponse, error) {
+	mimeType, err := httpserver.GetRequestContentType(c, httpserver.MIMEApplicationVendorIOTASerializerV1, echo.MIMEApplicationJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Request().Body == nil {
+		return nil, errors.WithMessage(httpserver.ErrInvalidParameter, "invalid transaction, error: request body missing")
+	}
+
+	bytes, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid transaction, error: %s", err)
+	}
+
+	tx := &iotago.Transaction{}
+
+	switch mimeType {
+	case echo.MIMEApplicationJSON:
+		if err := deps.Protocol.API().JSONDecode(bytes, tx, serix.WithValidation()); err != nil {
+			return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid transaction, error: %s", err)
+		}
+
+	case httpserver.MIMEApplicationVendorIOTASerializerV1:
+		if _, err := deps.Protocol.API().Decode(bytes, tx, serix.WithValidation()); err != nil {
+			return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid transaction, error: %s", err)
+		}
+
+	default:
+		return nil, echo.ErrUnsupportedMediaType
+	}
+
+	simulation, err := deps.Protocol.MainEngineInstance().Ledger.SimulateTransaction(tx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to simulate transaction")
+	}
+
+	resp := &transactionSimulationResponse{
+		TransactionID: simulation.TransactionID.ToHex(),
+		Inputs: lo.Map(simulation.Inputs, func(input *ledger.SimulatedInput) *simulatedInputResponse {
+			return &simulatedInputResponse{
+				OutputID:    input.OutputID.ToHex(),
+				IsKnown:     input.Exists,
+				IsSpent:     input.Spent,
+				IsContested: input.IsContested(),
+				ConflictingTransactionIDs: lo.Map(input.ConflictingTransactionIDs, func(transactionID iotago.TransactionID) string {
+					return transactionID.ToHex()
+				}),
+			}
+		}),
+	}
+
+	if simulation.Error != nil {
+		resp.Error = simulation.Error.Error()
+
+		return resp, nil
+	}
+
+	resp.Outputs = make([]*simulatedOutputResponse, 0, len(simulation.Outputs))
+	for _, output := range simulation.Outputs {
+		outputJSON, err := deps.Protocol.API().JSONEncode(output.Output())
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to encode output %s", output.OutputID().ToHex())
+		}
+
+		resp.Outputs = append(resp.Outputs, &simulatedOutputResponse{
+			OutputID: output.OutputID().ToHex(),
+			Output:   outputJSON,
+		})
 	}
 
-	return bmResponse, nil
+	return resp, nil
 }
@@ -8,31 +8,37 @@ import (
 	iotago "github.com/iotaledger/iota.go/v4"
 )
 
-//nolint:unused // transactions are currently unused
 type txState int
 
-//nolint:unused // transactions are currently unused
 const (
 	txStatePending txState = iota
-	txStateConfirmed
-	txStateFinalized
-	txStateRejected
+	txStateSolid
+	txStateExecuted
+	txStateInvalid
 	txStateConflicting
+	txStateAccepted
+	txStateRejected
+	txStateOrphaned
 )
 
-//nolint:unused // transactions are currently unused
 func (t txState) String() string {
 	switch t {
 	case txStatePending:
 		return "pending"
-	case txStateConfirmed:
-		return "confirmed"
-	case txStateFinalized:
-		return "finalized"
-	case txStateRejected:
-		return "rejected"
+	case txStateSolid:
+		return "solid"
+	case txStateExecuted:
+		return "executed"
+	case txStateInvalid:
+		return "invalid"
 	case txStateConflicting:
 		return "conflicting"
+	case txStateAccepted:
+		return "accepted"
+	case txStateRejected:
+		return "rejected"
+	case txStateOrphaned:
+		return "orphaned"
 	default:
 		return "unknown"
 	}
@@ -42,18 +48,24 @@ type blockState int
 
 const (
 	blockStatePending blockState = iota
+	blockStateAccepted
 	blockStateConfirmed
 	blockStateFinalized
+	blockStateOrphaned
 )
 
 func (b blockState) String() string {
 	switch b {
 	case blockStatePending:
 		return "pending"
+	case blockStateAccepted:
+		return "accepted"
 	case blockStateConfirmed:
 		return "confirmed"
 	case blockStateFinalized:
 		return "finalized"
+	case blockStateOrphaned:
+		return "orphaned"
 	default:
 		return "unknown"
 	}
@@ -146,9 +158,9 @@ type blockMetadataResponse struct {
 	WeakParents []string `json:"weakParents"`
 	// ShallowLikeParents are the shallow like parents of the block.
 	ShallowLikeParents []string `json:"shallowLikeParents"`
-	// BlockState might be pending, confirmed, finalized.
+	// BlockState might be pending, accepted, confirmed, finalized, orphaned.
 	BlockState string `json:"blockState"`
-	// TxState might be pending, conflicting, confirmed, finalized, rejected.
+	// TxState might be pending, solid, executed, invalid, conflicting, accepted, rejected, orphaned.
 	TxState string `json:"txState,omitempty"`
 	// BlockStateReason if applicable indicates the error that occurred during the block processing.
 	BlockStateReason string `json:"blockStateReason,omitempty"`
@@ -185,3 +197,179 @@ type outputMetadataResponse struct {
 	IncludedCommitmentID string `json:"includedCommitmentId"`
 	LatestCommitmentID   string `json:"latestCommitmentId"`
 }
+
+// transactionSimulationResponse defines the response of a POST transaction simulation REST API call.
+type transactionSimulationResponse struct {
+	// The hex encoded ID of the simulated transaction.
+	TransactionID string `json:"transactionId"`
+	// The state of the inputs of the transaction.
+	Inputs []*simulatedInputResponse `json:"inputs"`
+	// The outputs that the transaction would create.
+	Outputs []*simulatedOutputResponse `json:"outputs,omitempty"`
+	// The reason why the transaction is invalid.
+	Error string `json:"error,omitempty"`
+}
+
+// simulatedInputResponse defines the state of an input of a simulated transaction.
+type simulatedInputResponse struct {
+	// The hex encoded ID of the consumed output.
+	OutputID string `json:"outputId"`
+	// Whether the output is known to the node.
+	IsKnown bool `json:"isKnown"`
+	// Whether the output was already spent by a committed or an accepted transaction.
+	IsSpent bool `json:"isSpent"`
+	// Whether other transactions in the conflict DAG already spend the output.
+	IsContested bool `json:"isContested"`
+	// The hex encoded IDs of the transactions that already spend the output.
+	ConflictingTransactionIDs []string `json:"conflictingTransactionIds,omitempty"`
+}
+
+// simulatedOutputResponse defines an output that a simulated transaction would create.
+type simulatedOutputResponse struct {
+	// The hex encoded ID of the output.
+	OutputID string `json:"outputId"`
+	// The output in its JSON representation.
+	Output json.RawMessage `json:"output"`
+}
+
+// blockInclusionProofResponse defines the response of a GET block inclusion proof REST API call.
+type blockInclusionProofResponse struct {
+	// The hex encoded ID of the proven block.
+	BlockID string `json:"blockId"`
+	// The commitment of the slot of the block whose tangle root the block is proven against.
+	Commitment json.RawMessage `json:"commitment"`
+	// The roots of the commitment (the preimage of its roots ID).
+	Roots json.RawMessage `json:"roots"`
+	// The hex encoded non-empty sibling nodes on the path from the leaf to the tangle root.
+	SideNodes []string `json:"sideNodes"`
+}
+
+// outputProofResponse defines the response of a GET output proof REST API call.
+type outputProofResponse struct {
+	// The hex encoded ID of the proven output.
+	OutputID string `json:"outputId"`
+	// The latest commitment whose state root the output is proven against.
+	Commitment json.RawMessage `json:"commitment"`
+	// The roots of the commitment (the preimage of its roots ID).
+	Roots json.RawMessage `json:"roots"`
+	// The proven output, whose serialized form is committed to in the leaf of the output in the state tree.
+	Output json.RawMessage `json:"output"`
+	// The creation time of the output in nanoseconds.
+	TimestampCreated int64 `json:"timestampCreated,string"`
+	// The hex encoded non-empty sibling nodes on the path from the leaf to the state root.
+	SideNodes []string `json:"sideNodes"`
+}
+
+// accountResponse defines the response of a GET account REST API call.
+type accountResponse struct {
+	// The hex encoded ID of the account.
+	AccountID string `json:"accountId"`
+	// The hex encoded ID of the latest output of the account.
+	OutputID string `json:"outputId"`
+	// The amount of base tokens held by the latest output of the account.
+	Deposit uint64 `json:"deposit"`
+	// The block issuance credits of the account (including the credits that were generated up to the latest committed slot).
+	BlockIssuanceCredits int64 `json:"blockIssuanceCredits"`
+	// The slot at which the block issuance credits were last stored.
+	CreditsUpdateSlotIndex iotago.SlotIndex `json:"creditsUpdateSlotIndex"`
+	// The Mana of the account at the time of its last update.
+	StoredMana uint64 `json:"storedMana"`
+	// The slot at which the stored Mana was last updated.
+	StoredManaUpdateSlotIndex iotago.SlotIndex `json:"storedManaUpdateSlotIndex"`
+	// The index of the latest committed slot that the account state is based on.
+	LatestCommittedSlot iotago.SlotIndex `json:"latestCommittedSlot"`
+}
+
+// accountManaResponse defines the response of a GET account mana REST API call.
+type accountManaResponse struct {
+	// The hex encoded ID of the account.
+	AccountID string `json:"accountId"`
+	// The Mana of the account at the given slot.
+	Mana uint64 `json:"mana"`
+	// The slot at which the Mana was calculated.
+	SlotIndex iotago.SlotIndex `json:"slotIndex"`
+}
+
+// equivocationResponse defines the evidence of a single equivocation.
+type equivocationResponse struct {
+	// The hex encoded ID of the validator that equivocated.
+	IssuerID string `json:"issuerId"`
+	// The two conflicting blocks of the validator.
+	Blocks []json.RawMessage `json:"blocks"`
+}
+
+// equivocationsResponse defines the response of a GET equivocations REST API call.
+type equivocationsResponse struct {
+	// The index of the requested slot.
+	Index iotago.SlotIndex `json:"index"`
+	// The equivocations that were detected in the slot.
+	Equivocations []*equivocationResponse `json:"equivocations"`
+}
+
+// pruneDatabaseRequest defines the request of a POST prune database REST API call.
+type pruneDatabaseRequest struct {
+	// The slot index until which the database should be pruned.
+	Index *iotago.SlotIndex `json:"index,omitempty"`
+	// The amount of slots below the latest finalized slot that should be kept.
+	Depth *iotago.SlotIndex `json:"depth,omitempty"`
+}
+
+// pruneDatabaseResult defines the result of a finished prune database job.
+type pruneDatabaseResult struct {
+	// The index of the last pruned slot.
+	Index iotago.SlotIndex `json:"index"`
+}
+
+// createSnapshotRequest defines the request of a POST create snapshot REST API call.
+type createSnapshotRequest struct {
+	// The slot index of the snapshot (defaults to the latest commitment).
+	Index *iotago.SlotIndex `json:"index,omitempty"`
+}
+
+// createSnapshotResult defines the result of a finished create snapshot job.
+type createSnapshotResult struct {
+	// The slot index of the snapshot.
+	Index iotago.SlotIndex `json:"index"`
+	// The file path of the snapshot file.
+	FilePath string `json:"filePath"`
+	// The size of the snapshot file in bytes.
+	FileSize int64 `json:"fileSize"`
+	// The hex encoded BLAKE2b-256 hash of the snapshot file.
+	FileHash string `json:"fileHash"`
+}
+
+// controlJobResponse defines the response of the control REST API calls that run as background jobs.
+type controlJobResponse struct {
+	// The ID of the job.
+	JobID uint64 `json:"jobId"`
+	// The type of the job ("pruning" or "snapshot").
+	Type string `json:"type"`
+	// The status of the job ("running", "succeeded" or "failed").
+	Status string `json:"status"`
+	// The error message if the job failed.
+	Error string `json:"error,omitempty"`
+	// The time at which the job was started.
+	StartedAt int64 `json:"startedAt"`
+	// The time at which the job finished.
+	FinishedAt int64 `json:"finishedAt,omitempty"`
+	// The result of the job if it succeeded.
+	Result any `json:"result,omitempty"`
+}
+
+// peerResponse defines the response of a GET peer REST API call.
+type peerResponse struct {
+	// The base58 encoded identity ID of the peer.
+	ID string `json:"id"`
+	// The base58 encoded public key of the peer.
+	PublicKey string `json:"publicKey"`
+	// The address of the peer.
+	Address string `json:"address"`
+	// The direction of the connection ("inbound" or "outbound").
+	ConnectionDirection string `json:"connectionDirection"`
+	// The status of the connection ("connected" or "disconnected").
+	ConnectionStatus string `json:"connectionStatus"`
+	// The amount of packets received from the peer.
+	PacketsRead uint64 `json:"packetsRead"`
+	// The amount of packets sent to the peer.
+	PacketsWritten uint64 `json:"packetsWritten"`
+}
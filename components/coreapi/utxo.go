@@ -2,10 +2,14 @@ package coreapi
 
 import (
 	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
 
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/inx-app/pkg/httpserver"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate"
 	restapipkg "github.com/iotaledger/iota-core/pkg/restapi"
+	iotago "github.com/iotaledger/iota.go/v4"
 )
 
 func getOutput(c echo.Context) (*ledgerstate.Output, error) {
@@ -50,3 +54,73 @@ func getOutputMetadata(c echo.Context) (*outputMetadataResponse, error) {
 		LatestCommitmentID:   slotCommitment.ID().ToHex(),
 	}, nil
 }
+
+func getOutputProof(c echo.Context) (*outputProofResponse, error) {
+	outputID, err := httpserver.ParseOutputIDParam(c, restapipkg.ParameterOutputID)
+	if err != nil {
+		return nil, err
+	}
+
+	engineInstance := deps.Protocol.MainEngineInstance()
+	latestCommitment := engineInstance.Storage.Settings().LatestCommitment()
+
+	proof, stateRoot, err := engineInstance.Ledger.StateTreeProof(outputID)
+	if err != nil {
+		if errors.Is(err, ledgerstate.ErrOutputNotInStateTree) {
+			return nil, errors.WithMessagef(echo.ErrNotFound, "output %s is not unspent", outputID.ToHex())
+		}
+
+		return nil, errors.Wrapf(err, "failed to create proof for output %s", outputID.ToHex())
+	}
+
+	rootsStorage := engineInstance.Storage.Roots(latestCommitment.Index())
+	if rootsStorage == nil {
+		return nil, errors.WithMessagef(echo.ErrNotFound, "roots of slot %d are not available", latestCommitment.Index())
+	}
+
+	roots, err := rootsStorage.Load()
+	if err != nil {
+		// nodes that were started from a snapshot only know the roots of the slots that they committed themselves.
+		if errors.Is(err, kvstore.ErrKeyNotFound) {
+			return nil, errors.WithMessagef(echo.ErrServiceUnavailable, "roots of slot %d are not available yet", latestCommitment.Index())
+		}
+
+		return nil, errors.Wrapf(err, "failed to load roots of slot %d", latestCommitment.Index())
+	}
+
+	// the ledger state might already contain the changes of a slot that is being committed.
+	if roots.StateRoot != stateRoot {
+		return nil, errors.WithMessagef(echo.ErrServiceUnavailable, "the ledger state does not match the latest commitment %s", latestCommitment.ID().ToHex())
+	}
+
+	commitmentJSON, err := deps.Protocol.API().JSONEncode(latestCommitment.Commitment())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode commitment")
+	}
+
+	rootsJSON, err := deps.Protocol.API().JSONEncode(roots)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode roots")
+	}
+
+	var output iotago.TxEssenceOutput
+	if _, err = deps.Protocol.API().Decode(proof.Output, &output); err != nil {
+		return nil, errors.Wrap(err, "failed to decode output")
+	}
+
+	outputJSON, err := deps.Protocol.API().JSONEncode(output)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode output")
+	}
+
+	return &outputProofResponse{
+		OutputID:         outputID.ToHex(),
+		Commitment:       commitmentJSON,
+		Roots:            rootsJSON,
+		Output:           outputJSON,
+		TimestampCreated: proof.TimestampCreated,
+		SideNodes: lo.Map(proof.SideNodes, func(sideNode iotago.Identifier) string {
+			return sideNode.ToHex()
+		}),
+	}, nil
+}
@@ -119,4 +119,16 @@ func configureComponentCountersEvents() {
 	deps.Protocol.Events.Engine.Booker.BlockBooked.Hook(func(b *blocks.Block) {
 		incComponentCounter(Booked)
 	})
+
+	deps.Protocol.Events.Engine.Scheduler.BlockScheduled.Hook(func(b *blocks.Block) {
+		incComponentCounter(Scheduled)
+	})
+
+	deps.Protocol.Events.Engine.Scheduler.BlockDropped.Hook(func(b *blocks.Block, _ error) {
+		incComponentCounter(SchedulerDropped)
+	})
+
+	deps.Protocol.Events.Engine.Scheduler.BlockSkipped.Hook(func(b *blocks.Block) {
+		incComponentCounter(SchedulerSkipped)
+	})
 }
@@ -0,0 +1,106 @@
+package debugapi
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/restapi"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func blockByID(c echo.Context) (*blockResponse, error) {
+	blockID, err := httpserver.ParseBlockIDParam(c, restapi.ParameterBlockID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse block ID: %s", c.Param(restapi.ParameterBlockID))
+	}
+
+	block, exists := deps.Protocol.MainEngineInstance().BlockFromCache(blockID)
+	if !exists {
+		return nil, errors.WithMessagef(echo.ErrNotFound, "block not found in cache: %s", blockID.ToHex())
+	}
+
+	resp := &blockResponse{
+		ID:                  block.ID().ToHex(),
+		StrongParents:       make([]string, 0),
+		WeakParents:         make([]string, 0),
+		ShallowLikeParents:  make([]string, 0),
+		StrongChildren:      toHex(blockIDs(block.StrongChildren())),
+		WeakChildren:        toHex(blockIDs(block.WeakChildren())),
+		ShallowLikeChildren: toHex(blockIDs(block.ShallowLikeChildren())),
+		IsRootBlock:         block.IsRootBlock(),
+		IsMissing:           block.IsMissing(),
+		IsSolid:             block.IsSolid(),
+		IsInvalid:           block.IsInvalid(),
+		IsFuture:            block.IsFuture(),
+		IsBooked:            block.IsBooked(),
+		IsScheduled:         block.IsScheduled(),
+		IsSkipped:           block.IsSkipped(),
+		IsDropped:           block.IsDropped(),
+		IsAccepted:          block.IsAccepted(),
+		IsRatifiedAccepted:  block.IsRatifiedAccepted(),
+		IsConfirmed:         block.IsConfirmed(),
+		IsOrphaned:          block.IsOrphaned(),
+		Witnesses:           toHex(block.Witnesses()),
+		Ratifiers:           toHex(block.Ratifiers()),
+		ConflictIDs:         make([]string, 0),
+		PayloadConflictIDs:  make([]string, 0),
+	}
+
+	if block.ModelBlock() != nil {
+		block.ForEachParent(func(parent model.Parent) {
+			switch parent.Type {
+			case model.StrongParentType:
+				resp.StrongParents = append(resp.StrongParents, parent.ID.ToHex())
+			case model.WeakParentType:
+				resp.WeakParents = append(resp.WeakParents, parent.ID.ToHex())
+			case model.ShallowLikeParentType:
+				resp.ShallowLikeParents = append(resp.ShallowLikeParents, parent.ID.ToHex())
+			}
+		})
+	}
+
+	if conflictIDs := block.ConflictIDs(); conflictIDs != nil {
+		resp.ConflictIDs = toHex(conflictIDs.Slice())
+	}
+
+	if payloadConflictIDs := block.PayloadConflictIDs(); payloadConflictIDs != nil {
+		resp.PayloadConflictIDs = toHex(payloadConflictIDs.Slice())
+	}
+
+	return resp, nil
+}
+
+func rootBlocks() *rootBlocksResponse {
+	resp := &rootBlocksResponse{
+		RootBlocks: make([]*rootBlockResponse, 0),
+	}
+
+	for blockID, commitmentID := range deps.Protocol.MainEngineInstance().EvictionState.ActiveRootBlocks() {
+		resp.RootBlocks = append(resp.RootBlocks, &rootBlockResponse{
+			BlockID:      blockID.ToHex(),
+			CommitmentID: commitmentID.ToHex(),
+		})
+	}
+
+	return resp
+}
+
+func blockIDs[T interface{ ID() iotago.BlockID }](blocks []T) []iotago.BlockID {
+	ids := make([]iotago.BlockID, 0, len(blocks))
+	for _, block := range blocks {
+		ids = append(ids, block.ID())
+	}
+
+	return ids
+}
+
+func toHex[T interface{ ToHex() string }](ids []T) []string {
+	hexIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		hexIDs = append(hexIDs, id.ToHex())
+	}
+
+	return hexIDs
+}
@@ -0,0 +1,44 @@
+package debugapi
+
+import (
+	"github.com/iotaledger/iota-core/pkg/protocol/chainmanager"
+)
+
+func chains() *chainsResponse {
+	resp := &chainsResponse{
+		Chains: make([]*chainResponse, 0),
+	}
+
+	var mainChain *chainmanager.Chain
+	if rootCommitment := deps.Protocol.ChainManager.RootCommitment(); rootCommitment != nil {
+		mainChain = rootCommitment.Chain()
+	}
+
+	for _, chain := range deps.Protocol.ChainManager.Chains() {
+		resp.Chains = append(resp.Chains, &chainResponse{
+			ForkingPoint:     chain.ForkingPoint.ID().ToHex(),
+			LatestCommitment: chain.LatestCommitment().ID().ToHex(),
+			Size:             chain.Size(),
+			IsSolid:          chain.IsSolid(),
+			IsMainChain:      chain == mainChain,
+		})
+	}
+
+	return resp
+}
+
+func forks() *forksResponse {
+	resp := &forksResponse{
+		Forks: make([]*forkResponse, 0),
+	}
+
+	for _, fork := range deps.Protocol.ChainManager.Forks() {
+		resp.Forks = append(resp.Forks, &forkResponse{
+			Source:       fork.Source.String(),
+			Commitment:   fork.Commitment.ID().ToHex(),
+			ForkingPoint: fork.ForkingPoint.ID().ToHex(),
+		})
+	}
+
+	return resp
+}
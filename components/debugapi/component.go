@@ -0,0 +1,156 @@
+package debugapi
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/hive.go/app"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/components/restapi"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	restapipkg "github.com/iotaledger/iota-core/pkg/restapi"
+)
+
+const (
+	// RouteBlock is the route for getting the engine internal metadata of a block by its blockID.
+	// GET returns the flags, the witnesses and the conflicts of the block.
+	RouteBlock = "/blocks/:" + restapipkg.ParameterBlockID
+
+	// RouteRootBlocks is the route for getting the active root blocks of the eviction state.
+	// GET returns the root blocks together with the commitment they belong to.
+	RouteRootBlocks = "/root-blocks"
+
+	// RouteConflict is the route for getting a conflict of the ConflictDAG by its transactionID.
+	// GET returns the conflict sets, the parents, the children, the weight and the voters of the conflict.
+	RouteConflict = "/conflicts/:" + restapipkg.ParameterTransactionID
+
+	// RouteConflictSet is the route for getting a conflict set of the ConflictDAG by its outputID.
+	// GET returns the members of the conflict set.
+	RouteConflictSet = "/conflict-sets/:" + restapipkg.ParameterOutputID
+
+	// RouteMemPoolTransactions is the route for getting the transactions of the mempool.
+	// GET returns the metadata of the transactions ordered by their ID.
+	// Query parameters: "cursor" to request the next page and "pageSize" to limit the amount of transactions.
+	RouteMemPoolTransactions = "/mempool/transactions"
+
+	// RouteMemPoolTransaction is the route for getting a transaction of the mempool by its transactionID.
+	// GET returns the metadata of the transaction.
+	RouteMemPoolTransaction = "/mempool/transactions/:" + restapipkg.ParameterTransactionID
+
+	// RouteMemPoolStateRequests is the route for getting the pending state requests of the mempool.
+	// GET returns the outputIDs of the requested states that are not available yet.
+	RouteMemPoolStateRequests = "/mempool/state-requests"
+
+	// RouteChains is the route for getting the chains that are known to the chain manager.
+	// GET returns the chains.
+	RouteChains = "/chains"
+
+	// RouteForks is the route for getting the forks that were detected by the chain manager.
+	// GET returns the forks.
+	RouteForks = "/forks"
+
+	// RouteWorkers is the route for getting the worker pools of the protocol.
+	// GET returns the queue sizes of the worker pools.
+	RouteWorkers = "/workers"
+)
+
+func init() {
+	Component = &app.Component{
+		Name:      "DebugAPIV1",
+		DepsFunc:  func(cDeps dependencies) { deps = cDeps },
+		Configure: configure,
+		IsEnabled: func(c *dig.Container) bool {
+			return restapi.ParamsRestAPI.Enabled
+		},
+	}
+}
+
+var (
+	Component *app.Component
+	deps      dependencies
+)
+
+type dependencies struct {
+	dig.In
+
+	Protocol         *protocol.Protocol
+	RestRouteManager *restapi.RestRouteManager
+}
+
+func configure() error {
+	// check if RestAPI plugin is disabled
+	if !Component.App().IsComponentEnabled(restapi.Component.Identifier()) {
+		Component.LogPanic("RestAPI plugin needs to be enabled to use the DebugAPIV1 plugin")
+	}
+
+	routeGroup := deps.RestRouteManager.AddRoute("debug/v1")
+
+	routeGroup.GET(RouteBlock, func(c echo.Context) error {
+		resp, err := blockByID(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteRootBlocks, func(c echo.Context) error {
+		return httpserver.JSONResponse(c, http.StatusOK, rootBlocks())
+	})
+
+	routeGroup.GET(RouteConflict, func(c echo.Context) error {
+		resp, err := conflictByID(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteConflictSet, func(c echo.Context) error {
+		resp, err := conflictSetByID(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteMemPoolTransactions, func(c echo.Context) error {
+		resp, err := memPoolTransactions(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteMemPoolTransaction, func(c echo.Context) error {
+		resp, err := memPoolTransactionByID(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteMemPoolStateRequests, func(c echo.Context) error {
+		return httpserver.JSONResponse(c, http.StatusOK, memPoolStateRequests())
+	})
+
+	routeGroup.GET(RouteChains, func(c echo.Context) error {
+		return httpserver.JSONResponse(c, http.StatusOK, chains())
+	})
+
+	routeGroup.GET(RouteForks, func(c echo.Context) error {
+		return httpserver.JSONResponse(c, http.StatusOK, forks())
+	})
+
+	routeGroup.GET(RouteWorkers, func(c echo.Context) error {
+		return httpserver.JSONResponse(c, http.StatusOK, workers())
+	})
+
+	return nil
+}
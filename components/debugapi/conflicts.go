@@ -0,0 +1,74 @@
+package debugapi
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/ds/advancedset"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/pkg/restapi"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func conflictByID(c echo.Context) (*conflictResponse, error) {
+	conflictID, err := httpserver.ParseTransactionIDParam(c, restapi.ParameterTransactionID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse transaction ID: %s", c.Param(restapi.ParameterTransactionID))
+	}
+
+	conflictDAG := deps.Protocol.MainEngineInstance().Ledger.ConflictDAG()
+
+	conflictSets, exists := conflictDAG.ConflictSets(conflictID)
+	if !exists {
+		return nil, errors.WithMessagef(echo.ErrNotFound, "conflict not found: %s", conflictID.ToHex())
+	}
+
+	parents, _ := conflictDAG.ConflictParents(conflictID)
+	children, _ := conflictDAG.ConflictChildren(conflictID)
+	conflictingConflicts, _ := conflictDAG.ConflictingConflicts(conflictID)
+
+	resp := &conflictResponse{
+		ID:                   conflictID.ToHex(),
+		ConflictSets:         toHex(conflictSets.Slice()),
+		Parents:              toHex(setSlice(parents)),
+		Children:             toHex(setSlice(children)),
+		ConflictingConflicts: toHex(setSlice(conflictingConflicts)),
+		Weight:               conflictDAG.ConflictWeight(conflictID),
+		AcceptanceState:      conflictDAG.AcceptanceState(advancedset.New(conflictID)).String(),
+		Voters:               make([]*voterResponse, 0),
+	}
+
+	for accountID, weight := range conflictDAG.ConflictVoters(conflictID) {
+		resp.Voters = append(resp.Voters, &voterResponse{
+			AccountID: accountID.ToHex(),
+			Weight:    weight,
+		})
+	}
+
+	return resp, nil
+}
+
+func conflictSetByID(c echo.Context) (*conflictSetResponse, error) {
+	conflictSetID, err := httpserver.ParseOutputIDParam(c, restapi.ParameterOutputID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse output ID: %s", c.Param(restapi.ParameterOutputID))
+	}
+
+	members, exists := deps.Protocol.MainEngineInstance().Ledger.ConflictDAG().ConflictSetMembers(conflictSetID)
+	if !exists {
+		return nil, errors.WithMessagef(echo.ErrNotFound, "conflict set not found: %s", conflictSetID.ToHex())
+	}
+
+	return &conflictSetResponse{
+		ID:      conflictSetID.ToHex(),
+		Members: toHex(members.Slice()),
+	}, nil
+}
+
+func setSlice(set *advancedset.AdvancedSet[iotago.TransactionID]) []iotago.TransactionID {
+	if set == nil {
+		return nil
+	}
+
+	return set.Slice()
+}
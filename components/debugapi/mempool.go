@@ -0,0 +1,149 @@
+package debugapi
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/components/restapi"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool/conflictdag"
+	restapipkg "github.com/iotaledger/iota-core/pkg/restapi"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+const (
+	// QueryParameterCursor is used to request the next page of results.
+	QueryParameterCursor = "cursor"
+
+	// QueryParameterPageSize is used to define the page size for the results.
+	QueryParameterPageSize = "pageSize"
+)
+
+func memPoolTransactions(c echo.Context) (*transactionsResponse, error) {
+	cursor, pageSize, err := parsePagination(c, restapi.ParamsRestAPI.Limits.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+
+	return transactionsPage(deps.Protocol.MainEngineInstance().Ledger.MemPool(), cursor, pageSize), nil
+}
+
+// parsePagination parses the cursor and the page size of a paginated request, where the page size defaults to (and is
+// limited by) the given maximum amount of results.
+func parsePagination(c echo.Context, maxResults int) (cursor *iotago.TransactionID, pageSize int, err error) {
+	pageSize = maxResults
+
+	if len(c.QueryParam(QueryParameterCursor)) > 0 {
+		transactionID, err := iotago.IdentifierFromHexString(c.QueryParam(QueryParameterCursor))
+		if err != nil {
+			return nil, 0, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid cursor, error: %s", err)
+		}
+		cursor = &transactionID
+	}
+
+	if len(c.QueryParam(QueryParameterPageSize)) > 0 {
+		requestedPageSize, err := httpserver.ParseUint32QueryParam(c, QueryParameterPageSize, uint32(maxResults))
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if requestedPageSize > 0 {
+			pageSize = int(requestedPageSize)
+		}
+	}
+
+	return cursor, pageSize, nil
+}
+
+// transactionsPage returns the transactions of the mempool that follow the given cursor, ordered by their ID. The cursor
+// of the response is set to the last returned transaction if there are more transactions to request.
+func transactionsPage[VotePower conflictdag.VotePowerType[VotePower]](memPool mempool.MemPool[VotePower], cursor *iotago.TransactionID, pageSize int) *transactionsResponse {
+	transactions := make([]mempool.TransactionMetadata, 0)
+	memPool.ForEachTransaction(func(transaction mempool.TransactionMetadata) bool {
+		if transactionID := transaction.ID(); cursor == nil || bytes.Compare(transactionID[:], cursor[:]) > 0 {
+			transactions = append(transactions, transaction)
+		}
+
+		return true
+	})
+
+	sort.Slice(transactions, func(i, j int) bool {
+		a, b := transactions[i].ID(), transactions[j].ID()
+		return bytes.Compare(a[:], b[:]) < 0
+	})
+
+	resp := &transactionsResponse{
+		PageSize:     pageSize,
+		Transactions: make([]*transactionResponse, 0),
+	}
+
+	if len(transactions) > pageSize {
+		transactions = transactions[:pageSize]
+		resp.Cursor = transactions[pageSize-1].ID().ToHex()
+	}
+
+	for _, transaction := range transactions {
+		resp.Transactions = append(resp.Transactions, newTransactionResponse(transaction))
+	}
+
+	return resp
+}
+
+func memPoolTransactionByID(c echo.Context) (*transactionResponse, error) {
+	txID, err := httpserver.ParseTransactionIDParam(c, restapipkg.ParameterTransactionID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse transaction ID: %s", c.Param(restapipkg.ParameterTransactionID))
+	}
+
+	transaction, exists := deps.Protocol.MainEngineInstance().Ledger.MemPool().TransactionMetadata(txID)
+	if !exists {
+		return nil, errors.WithMessagef(echo.ErrNotFound, "transaction not found in mempool: %s", txID.ToHex())
+	}
+
+	return newTransactionResponse(transaction), nil
+}
+
+func memPoolStateRequests() *stateRequestsResponse {
+	return &stateRequestsResponse{
+		OutputIDs: toHex(deps.Protocol.MainEngineInstance().Ledger.MemPool().PendingStateRequests()),
+	}
+}
+
+func newTransactionResponse(transaction mempool.TransactionMetadata) *transactionResponse {
+	resp := &transactionResponse{
+		ID:            transaction.ID().ToHex(),
+		Inputs:        make([]string, 0),
+		Outputs:       make([]string, 0),
+		ConflictIDs:   toHex(transaction.ConflictIDs().Get().Slice()),
+		IsSolid:       transaction.IsSolid(),
+		IsExecuted:    transaction.IsExecuted(),
+		IsInvalid:     transaction.IsInvalid(),
+		IsBooked:      transaction.IsBooked(),
+		IsConflicting: transaction.IsConflicting(),
+		IsPending:     transaction.IsPending(),
+		IsAccepted:    transaction.IsAccepted(),
+		IsCommitted:   transaction.IsCommitted(),
+		IsRejected:    transaction.IsRejected(),
+		IsOrphaned:    transaction.IsOrphaned(),
+	}
+
+	_ = transaction.Inputs().ForEach(func(input mempool.StateMetadata) error {
+		resp.Inputs = append(resp.Inputs, input.ID().ToHex())
+		return nil
+	})
+
+	_ = transaction.Outputs().ForEach(func(output mempool.StateMetadata) error {
+		resp.Outputs = append(resp.Outputs, output.ID().ToHex())
+		return nil
+	})
+
+	if earliestIncludedAttachment := transaction.EarliestIncludedAttachment(); earliestIncludedAttachment != iotago.EmptyBlockID() {
+		resp.EarliestIncludedAttachment = earliestIncludedAttachment.ToHex()
+	}
+
+	return resp
+}
@@ -0,0 +1,115 @@
+package debugapi
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/core/account"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/pkg/core/promise"
+	"github.com/iotaledger/iota-core/pkg/core/vote"
+	ledgertests "github.com/iotaledger/iota-core/pkg/protocol/engine/ledger/tests"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool/conflictdag/conflictdagv1"
+	mempooltests "github.com/iotaledger/iota-core/pkg/protocol/engine/mempool/tests"
+	mempoolv1 "github.com/iotaledger/iota-core/pkg/protocol/engine/mempool/v1"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestParsePagination(t *testing.T) {
+	cursor := iotago.TransactionID{1, 2, 3}
+
+	// the page size defaults to the maximum amount of results.
+	parsedCursor, pageSize, err := parsePagination(newTestContext(""), 10)
+	require.NoError(t, err)
+	require.Nil(t, parsedCursor)
+	require.Equal(t, 10, pageSize)
+
+	parsedCursor, pageSize, err = parsePagination(newTestContext(fmt.Sprintf("cursor=%s&pageSize=5", cursor.ToHex())), 10)
+	require.NoError(t, err)
+	require.Equal(t, cursor, *parsedCursor)
+	require.Equal(t, 5, pageSize)
+
+	// page sizes above the maximum amount of results and malformed cursors are rejected.
+	_, _, err = parsePagination(newTestContext("pageSize=11"), 10)
+	require.ErrorIs(t, err, httpserver.ErrInvalidParameter)
+
+	_, _, err = parsePagination(newTestContext("cursor=0x1234"), 10)
+	require.ErrorIs(t, err, httpserver.ErrInvalidParameter)
+}
+
+func TestTransactionsPage(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+
+	ledgerState := ledgertests.New(ledgertests.NewMockedState(iotago.TransactionID{}, 0))
+	conflictDAG := conflictdagv1.New[iotago.TransactionID, iotago.OutputID, vote.MockedPower](account.NewAccounts[iotago.AccountID, *iotago.AccountID](mapdb.NewMapDB()).SelectAccounts())
+	memPool := mempoolv1.New[vote.MockedPower](mempooltests.VM, func(reference iotago.IndexedUTXOReferencer) *promise.Promise[mempool.State] {
+		return ledgerState.ResolveState(reference.Ref())
+	}, workers, conflictDAG)
+
+	tf := mempooltests.NewTestFramework(t, memPool, conflictDAG, ledgerState, workers)
+	defer tf.Cleanup()
+
+	transactionAliases := []string{"tx1", "tx2", "tx3", "tx4", "tx5"}
+	previousStateAlias := "genesis"
+	for _, alias := range transactionAliases {
+		tf.CreateTransaction(alias, []string{previousStateAlias}, 1)
+		previousStateAlias = alias + ":0"
+	}
+	require.NoError(t, tf.AttachTransactions(transactionAliases...))
+	tf.RequireBooked(transactionAliases...)
+
+	// the transactions are returned in pages that are ordered by their ID.
+	var cursor *iotago.TransactionID
+	pagedTransactionIDs := make([]string, 0)
+	for pageCount := 1; ; pageCount++ {
+		page := transactionsPage[vote.MockedPower](memPool, cursor, 2)
+		require.Equal(t, 2, page.PageSize)
+		require.LessOrEqual(t, len(page.Transactions), 2)
+
+		for _, transaction := range page.Transactions {
+			pagedTransactionIDs = append(pagedTransactionIDs, transaction.ID)
+		}
+
+		if page.Cursor == "" {
+			require.Equal(t, 3, pageCount)
+			break
+		}
+
+		require.Equal(t, page.Transactions[len(page.Transactions)-1].ID, page.Cursor)
+		nextCursor, err := iotago.IdentifierFromHexString(page.Cursor)
+		require.NoError(t, err)
+		cursor = &nextCursor
+	}
+
+	expectedTransactionIDs := make([]string, 0)
+	for _, alias := range transactionAliases {
+		expectedTransactionIDs = append(expectedTransactionIDs, tf.TransactionID(alias).ToHex())
+	}
+	require.ElementsMatch(t, expectedTransactionIDs, pagedTransactionIDs)
+
+	for i := 1; i < len(pagedTransactionIDs); i++ {
+		previousID, err := iotago.IdentifierFromHexString(pagedTransactionIDs[i-1])
+		require.NoError(t, err)
+		currentID, err := iotago.IdentifierFromHexString(pagedTransactionIDs[i])
+		require.NoError(t, err)
+		require.Negative(t, bytes.Compare(previousID[:], currentID[:]))
+	}
+
+	// a page that is large enough for all remaining transactions has no cursor.
+	page := transactionsPage[vote.MockedPower](memPool, nil, 5)
+	require.Len(t, page.Transactions, 5)
+	require.Empty(t, page.Cursor)
+}
+
+func newTestContext(query string) echo.Context {
+	return echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/?"+query, nil), httptest.NewRecorder())
+}
@@ -0,0 +1,207 @@
+package debugapi
+
+// blockResponse defines the response of a GET debug block REST API call.
+type blockResponse struct {
+	// ID is the hex encoded ID of the block.
+	ID string `json:"id"`
+	// StrongParents are the hex encoded IDs of the strong parents of the block.
+	StrongParents []string `json:"strongParents"`
+	// WeakParents are the hex encoded IDs of the weak parents of the block.
+	WeakParents []string `json:"weakParents"`
+	// ShallowLikeParents are the hex encoded IDs of the shallow like parents of the block.
+	ShallowLikeParents []string `json:"shallowLikeParents"`
+	// StrongChildren are the hex encoded IDs of the strong children of the block.
+	StrongChildren []string `json:"strongChildren"`
+	// WeakChildren are the hex encoded IDs of the weak children of the block.
+	WeakChildren []string `json:"weakChildren"`
+	// ShallowLikeChildren are the hex encoded IDs of the shallow like children of the block.
+	ShallowLikeChildren []string `json:"shallowLikeChildren"`
+	// IsRootBlock tells whether the block is a root block.
+	IsRootBlock bool `json:"isRootBlock"`
+	// IsMissing tells whether the block is missing.
+	IsMissing bool `json:"isMissing"`
+	// IsSolid tells whether the block is solid.
+	IsSolid bool `json:"isSolid"`
+	// IsInvalid tells whether the block is invalid.
+	IsInvalid bool `json:"isInvalid"`
+	// IsFuture tells whether the block commits to a slot that is not committed by the node yet.
+	IsFuture bool `json:"isFuture"`
+	// IsBooked tells whether the block is booked.
+	IsBooked bool `json:"isBooked"`
+	// IsScheduled tells whether the block is scheduled.
+	IsScheduled bool `json:"isScheduled"`
+	// IsSkipped tells whether the block was skipped by the scheduler.
+	IsSkipped bool `json:"isSkipped"`
+	// IsDropped tells whether the block was dropped by the scheduler.
+	IsDropped bool `json:"isDropped"`
+	// IsAccepted tells whether the block is accepted.
+	IsAccepted bool `json:"isAccepted"`
+	// IsRatifiedAccepted tells whether the block is ratified accepted.
+	IsRatifiedAccepted bool `json:"isRatifiedAccepted"`
+	// IsConfirmed tells whether the block is confirmed.
+	IsConfirmed bool `json:"isConfirmed"`
+	// IsOrphaned tells whether the block is orphaned.
+	IsOrphaned bool `json:"isOrphaned"`
+	// Witnesses are the hex encoded account IDs of the witnesses of the block.
+	Witnesses []string `json:"witnesses"`
+	// Ratifiers are the hex encoded account IDs of the ratifiers of the block.
+	Ratifiers []string `json:"ratifiers"`
+	// ConflictIDs are the hex encoded IDs of the conflicts the block is booked into.
+	ConflictIDs []string `json:"conflictIDs"`
+	// PayloadConflictIDs are the hex encoded IDs of the conflicts created by the payload of the block.
+	PayloadConflictIDs []string `json:"payloadConflictIDs"`
+}
+
+// rootBlockResponse defines a root block of the eviction state.
+type rootBlockResponse struct {
+	// BlockID is the hex encoded ID of the root block.
+	BlockID string `json:"blockID"`
+	// CommitmentID is the hex encoded ID of the commitment the root block belongs to.
+	CommitmentID string `json:"commitmentID"`
+}
+
+// rootBlocksResponse defines the response of a GET debug root blocks REST API call.
+type rootBlocksResponse struct {
+	// RootBlocks are the active root blocks of the eviction state.
+	RootBlocks []*rootBlockResponse `json:"rootBlocks"`
+}
+
+// voterResponse defines a voter of a conflict.
+type voterResponse struct {
+	// AccountID is the hex encoded account ID of the voter.
+	AccountID string `json:"accountID"`
+	// Weight is the weight of the voter.
+	Weight int64 `json:"weight"`
+}
+
+// conflictResponse defines the response of a GET debug conflict REST API call.
+type conflictResponse struct {
+	// ID is the hex encoded ID of the conflict.
+	ID string `json:"id"`
+	// ConflictSets are the hex encoded IDs of the conflict sets the conflict is part of.
+	ConflictSets []string `json:"conflictSets"`
+	// Parents are the hex encoded IDs of the parent conflicts.
+	Parents []string `json:"parents"`
+	// Children are the hex encoded IDs of the child conflicts.
+	Children []string `json:"children"`
+	// ConflictingConflicts are the hex encoded IDs of the conflicts that conflict with the conflict.
+	ConflictingConflicts []string `json:"conflictingConflicts"`
+	// Weight is the validator weight of the conflict.
+	Weight int64 `json:"weight"`
+	// AcceptanceState is the acceptance state of the conflict.
+	AcceptanceState string `json:"acceptanceState"`
+	// Voters are the voters of the conflict.
+	Voters []*voterResponse `json:"voters"`
+}
+
+// conflictSetResponse defines the response of a GET debug conflict set REST API call.
+type conflictSetResponse struct {
+	// ID is the hex encoded ID of the conflict set.
+	ID string `json:"id"`
+	// Members are the hex encoded IDs of the conflicts of the conflict set.
+	Members []string `json:"members"`
+}
+
+// transactionResponse defines the response of a GET debug mempool transaction REST API call.
+type transactionResponse struct {
+	// ID is the hex encoded ID of the transaction.
+	ID string `json:"id"`
+	// Inputs are the hex encoded IDs of the inputs of the transaction.
+	Inputs []string `json:"inputs"`
+	// Outputs are the hex encoded IDs of the outputs of the transaction.
+	Outputs []string `json:"outputs"`
+	// ConflictIDs are the hex encoded IDs of the conflicts the transaction is booked into.
+	ConflictIDs []string `json:"conflictIDs"`
+	// EarliestIncludedAttachment is the hex encoded ID of the earliest included attachment of the transaction.
+	EarliestIncludedAttachment string `json:"earliestIncludedAttachment,omitempty"`
+	// IsSolid tells whether the transaction is solid.
+	IsSolid bool `json:"isSolid"`
+	// IsExecuted tells whether the transaction is executed.
+	IsExecuted bool `json:"isExecuted"`
+	// IsInvalid tells whether the transaction is invalid.
+	IsInvalid bool `json:"isInvalid"`
+	// IsBooked tells whether the transaction is booked.
+	IsBooked bool `json:"isBooked"`
+	// IsConflicting tells whether the transaction is conflicting.
+	IsConflicting bool `json:"isConflicting"`
+	// IsPending tells whether the transaction is pending.
+	IsPending bool `json:"isPending"`
+	// IsAccepted tells whether the transaction is accepted.
+	IsAccepted bool `json:"isAccepted"`
+	// IsCommitted tells whether the transaction is committed.
+	IsCommitted bool `json:"isCommitted"`
+	// IsRejected tells whether the transaction is rejected.
+	IsRejected bool `json:"isRejected"`
+	// IsOrphaned tells whether the transaction is orphaned.
+	IsOrphaned bool `json:"isOrphaned"`
+}
+
+// transactionsResponse defines the response of a GET debug mempool transactions REST API call.
+type transactionsResponse struct {
+	// The maximum amount of transactions returned in one call.
+	PageSize int `json:"pageSize"`
+	// Transactions are the transactions of the mempool.
+	Transactions []*transactionResponse `json:"transactions"`
+	// The cursor to request the next page of results (empty if there are no more results).
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// stateRequestsResponse defines the response of a GET debug mempool state requests REST API call.
+type stateRequestsResponse struct {
+	// OutputIDs are the hex encoded IDs of the requested states that are not available yet.
+	OutputIDs []string `json:"outputIDs"`
+}
+
+// chainResponse defines a chain of the chain manager.
+type chainResponse struct {
+	// ForkingPoint is the hex encoded ID of the first commitment of the chain.
+	ForkingPoint string `json:"forkingPoint"`
+	// LatestCommitment is the hex encoded ID of the latest commitment of the chain.
+	LatestCommitment string `json:"latestCommitment"`
+	// Size is the amount of commitments of the chain.
+	Size int `json:"size"`
+	// IsSolid tells whether the chain is solid.
+	IsSolid bool `json:"isSolid"`
+	// IsMainChain tells whether the chain is the main chain.
+	IsMainChain bool `json:"isMainChain"`
+}
+
+// chainsResponse defines the response of a GET debug chains REST API call.
+type chainsResponse struct {
+	// Chains are the chains that are known to the chain manager.
+	Chains []*chainResponse `json:"chains"`
+}
+
+// forkResponse defines a fork detected by the chain manager.
+type forkResponse struct {
+	// Source is the ID of the peer the fork was received from.
+	Source string `json:"source"`
+	// Commitment is the hex encoded ID of the latest commitment of the fork.
+	Commitment string `json:"commitment"`
+	// ForkingPoint is the hex encoded ID of the commitment where the fork diverges from the main chain.
+	ForkingPoint string `json:"forkingPoint"`
+}
+
+// forksResponse defines the response of a GET debug forks REST API call.
+type forksResponse struct {
+	// Forks are the forks that were detected by the chain manager.
+	Forks []*forkResponse `json:"forks"`
+}
+
+// workerPoolResponse defines a worker pool of the protocol.
+type workerPoolResponse struct {
+	// Name is the name of the worker pool.
+	Name string `json:"name"`
+	// WorkerCount is the amount of workers of the worker pool.
+	WorkerCount int `json:"workerCount"`
+	// PendingTasks is the amount of tasks that were submitted but not finished yet.
+	PendingTasks int `json:"pendingTasks"`
+	// QueueSize is the amount of tasks that are waiting to be executed.
+	QueueSize int `json:"queueSize"`
+}
+
+// workersResponse defines the response of a GET debug workers REST API call.
+type workersResponse struct {
+	// WorkerPools are the worker pools of the protocol.
+	WorkerPools []*workerPoolResponse `json:"workerPools"`
+}
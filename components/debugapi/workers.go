@@ -0,0 +1,26 @@
+package debugapi
+
+import (
+	"sort"
+)
+
+func workers() *workersResponse {
+	resp := &workersResponse{
+		WorkerPools: make([]*workerPoolResponse, 0),
+	}
+
+	for name, pool := range deps.Protocol.Workers.Pools() {
+		resp.WorkerPools = append(resp.WorkerPools, &workerPoolResponse{
+			Name:         name,
+			WorkerCount:  pool.WorkerCount(),
+			PendingTasks: pool.PendingTasksCounter.Get(),
+			QueueSize:    pool.Queue.Size(),
+		})
+	}
+
+	sort.Slice(resp.WorkerPools, func(i, j int) bool {
+		return resp.WorkerPools[i].Name < resp.WorkerPools[j].Name
+	})
+
+	return resp
+}
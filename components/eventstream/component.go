@@ -0,0 +1,194 @@
+package eventstream
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/hive.go/app"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/components/restapi"
+	"github.com/iotaledger/iota-core/pkg/daemon"
+	"github.com/iotaledger/iota-core/pkg/eventstream"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/notarization"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+const (
+	// RouteWebSocket is the route for streaming events over a websocket connection.
+	// GET upgrades the connection and streams the events of the requested topics.
+	RouteWebSocket = "/ws"
+
+	// RouteServerSentEvents is the route for streaming events as Server-Sent Events.
+	// GET streams the events of the requested topics.
+	RouteServerSentEvents = "/sse"
+
+	// QueryParameterTopics is used to define the comma separated topic filters of a stream.
+	QueryParameterTopics = "topics"
+
+	// QueryParameterFromSlot is used to replay the events of all committed slots starting at the given slot index.
+	QueryParameterFromSlot = "fromSlot"
+
+	// headerLastEventID is the header that is sent by reconnecting Server-Sent Events clients.
+	headerLastEventID = "Last-Event-ID"
+)
+
+func init() {
+	Component = &app.Component{
+		Name:      "EventStream",
+		DepsFunc:  func(cDeps dependencies) { deps = cDeps },
+		Params:    params,
+		Provide:   provide,
+		Configure: configure,
+		Run:       run,
+		IsEnabled: func(c *dig.Container) bool {
+			return ParamsEventStream.Enabled && restapi.ParamsRestAPI.Enabled
+		},
+	}
+}
+
+var (
+	Component *app.Component
+	deps      dependencies
+)
+
+type dependencies struct {
+	dig.In
+
+	Protocol         *protocol.Protocol
+	RestRouteManager *restapi.RestRouteManager
+	Broker           *eventstream.Broker
+}
+
+func provide(c *dig.Container) error {
+	return c.Provide(eventstream.NewBroker)
+}
+
+func configure() error {
+	routeGroup := deps.RestRouteManager.AddRoute("eventstream/v1")
+
+	routeGroup.GET(RouteWebSocket, func(c echo.Context) error {
+		request, err := parseStreamRequest(c)
+		if err != nil {
+			return err
+		}
+
+		return streamWebSocket(c, request)
+	})
+
+	routeGroup.GET(RouteServerSentEvents, func(c echo.Context) error {
+		request, err := parseStreamRequest(c)
+		if err != nil {
+			return err
+		}
+
+		return streamServerSentEvents(c, request)
+	})
+
+	return nil
+}
+
+func run() error {
+	if err := Component.Daemon().BackgroundWorker(Component.Name, func(ctx context.Context) {
+		Component.LogInfo("Starting EventStream ... done")
+
+		// a single worker makes sure that the events are published in the order they were triggered.
+		workerPool := workerpool.New(Component.Name, 1).Start()
+
+		unhook := lo.Batch(
+			deps.Protocol.Events.Engine.BlockGadget.BlockAccepted.Hook(func(block *blocks.Block) {
+				publishBlock(eventstream.TopicBlockAccepted, block)
+			}, event.WithWorkerPool(workerPool)).Unhook,
+			deps.Protocol.Events.Engine.BlockGadget.BlockConfirmed.Hook(func(block *blocks.Block) {
+				publishBlock(eventstream.TopicBlockConfirmed, block)
+			}, event.WithWorkerPool(workerPool)).Unhook,
+			deps.Protocol.Events.Engine.Notarization.SlotCommitted.Hook(func(details *notarization.SlotCommittedDetails) {
+				publishSlotCommitted(deps.Protocol.MainEngineInstance(), details)
+			}, event.WithWorkerPool(workerPool)).Unhook,
+			deps.Protocol.Events.Engine.SlotGadget.SlotFinalized.Hook(func(index iotago.SlotIndex) {
+				publishSlotFinalized(index)
+			}, event.WithWorkerPool(workerPool)).Unhook,
+			deps.Protocol.Events.MainEngineSwitched.Hook(func(engineInstance *engine.Engine) {
+				hookMemPool(engineInstance, workerPool)
+			}, event.WithWorkerPool(workerPool)).Unhook,
+		)
+
+		hookMemPool(deps.Protocol.MainEngineInstance(), workerPool)
+
+		<-ctx.Done()
+		Component.LogInfo("Stopping EventStream ...")
+
+		unhook()
+		workerPool.Shutdown()
+		deps.Broker.Shutdown()
+
+		Component.LogInfo("Stopping EventStream ... done")
+	}, daemon.PriorityEventStream); err != nil {
+		Component.LogPanicf("failed to start worker: %s", err)
+	}
+
+	return nil
+}
+
+// streamRequest contains the parsed parameters of a stream request.
+type streamRequest struct {
+	filters     []eventstream.TopicFilter
+	fromSlot    iotago.SlotIndex
+	replaySlots bool
+}
+
+func parseStreamRequest(c echo.Context) (*streamRequest, error) {
+	request := &streamRequest{
+		filters: make([]eventstream.TopicFilter, 0),
+	}
+
+	for _, topics := range c.QueryParams()[QueryParameterTopics] {
+		for _, topic := range strings.Split(topics, ",") {
+			if topic = strings.TrimSpace(topic); topic != "" {
+				request.filters = append(request.filters, eventstream.TopicFilter(topic))
+			}
+		}
+	}
+
+	if len(request.filters) == 0 {
+		return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "at least one topic needs to be given in the query parameter %s", QueryParameterTopics)
+	}
+
+	if len(c.QueryParam(QueryParameterFromSlot)) > 0 {
+		fromSlot, err := strconv.ParseUint(c.QueryParam(QueryParameterFromSlot), 10, 64)
+		if err != nil {
+			return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid value for %s, error: %s", QueryParameterFromSlot, err)
+		}
+
+		request.fromSlot = iotago.SlotIndex(fromSlot)
+		request.replaySlots = true
+	} else if lastEventID := c.Request().Header.Get(headerLastEventID); len(lastEventID) > 0 {
+		// reconnecting Server-Sent Events clients resume at the slot of the last event they received.
+		fromSlot, err := strconv.ParseUint(lastEventID, 10, 64)
+		if err != nil {
+			return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid value for header %s, error: %s", headerLastEventID, err)
+		}
+
+		request.fromSlot = iotago.SlotIndex(fromSlot)
+		request.replaySlots = true
+	}
+
+	return request, nil
+}
+
+func hookMemPool(engineInstance *engine.Engine, workerPool *workerpool.WorkerPool) {
+	engineInstance.Ledger.MemPool().OnTransactionAttached(func(transaction mempool.TransactionMetadata) {
+		publishTransactionStateChanges(engineInstance, transaction, workerPool)
+	}, event.WithWorkerPool(workerPool))
+}
@@ -0,0 +1,25 @@
+package eventstream
+
+import (
+	"time"
+
+	"github.com/iotaledger/hive.go/app"
+)
+
+// ParametersEventStream contains the definition of the parameters used by the EventStream.
+type ParametersEventStream struct {
+	// Enabled defines whether the EventStream plugin is enabled.
+	Enabled bool `default:"true" usage:"whether the EventStream plugin is enabled"`
+	// ClientBufferSize defines the maximum amount of events that are buffered for a client before it is disconnected.
+	ClientBufferSize int `default:"1000" usage:"the maximum amount of events that are buffered for a client before it is disconnected"`
+	// KeepAliveInterval defines the interval in which keep-alive messages are sent to the clients.
+	KeepAliveInterval time.Duration `default:"30s" usage:"the interval in which keep-alive messages are sent to the clients"`
+}
+
+var ParamsEventStream = &ParametersEventStream{}
+
+var params = &app.ComponentParams{
+	Params: map[string]any{
+		"eventStream": ParamsEventStream,
+	},
+}
@@ -0,0 +1,165 @@
+package eventstream
+
+import (
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/iota-core/pkg/eventstream"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/notarization"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func publishBlock(topic string, block *blocks.Block) {
+	if block.ModelBlock() == nil || !deps.Broker.HasSubscribers(topic) {
+		return
+	}
+
+	deps.Broker.Publish(eventstream.NewEvent(topic, block.ID().Index(), newBlockPayload(block.ModelBlock())))
+}
+
+func publishSlotCommitted(engineInstance *engine.Engine, details *notarization.SlotCommittedDetails) {
+	if deps.Broker.SubscriptionCount() == 0 {
+		return
+	}
+
+	deps.Broker.Publish(eventstream.NewEvent(eventstream.TopicSlotCommitted, details.Commitment.Index(), newCommitmentPayload(details.Commitment)))
+
+	// the outputs are published once the slot that created or spent them was committed.
+	diff, err := engineInstance.Ledger.StateDiffs(details.Commitment.Index())
+	if err != nil {
+		Component.LogWarnf("failed to load state diff of slot %d: %s", details.Commitment.Index(), err)
+		return
+	}
+
+	if err = forEachOutputEvent(engineInstance, diff, deps.Broker.HasSubscribers, func(event *eventstream.Event) error {
+		deps.Broker.Publish(event)
+		return nil
+	}); err != nil {
+		Component.LogWarnf("failed to publish outputs of slot %d: %s", details.Commitment.Index(), err)
+	}
+}
+
+func publishSlotFinalized(index iotago.SlotIndex) {
+	if !deps.Broker.HasSubscribers(eventstream.TopicSlotFinalized) {
+		return
+	}
+
+	deps.Broker.Publish(eventstream.NewEvent(eventstream.TopicSlotFinalized, index, &slotFinalizedPayload{Index: uint64(index)}))
+}
+
+func publishTransactionStateChanges(engineInstance *engine.Engine, transaction mempool.TransactionMetadata, workerPool *workerpool.WorkerPool) {
+	publish := func(state string, err error) {
+		// the transactions of engines that are not the main engine anymore are not relevant.
+		if engineInstance != deps.Protocol.MainEngineInstance() {
+			return
+		}
+
+		topic := eventstream.TopicTransaction(transaction.ID())
+		if !deps.Broker.HasSubscribers(topic) {
+			return
+		}
+
+		payload := &transactionPayload{
+			TransactionID: transaction.ID().ToHex(),
+			State:         state,
+		}
+		if err != nil {
+			payload.Error = err.Error()
+		}
+
+		deps.Broker.Publish(eventstream.NewEvent(topic, transaction.EarliestIncludedAttachment().Index(), payload))
+	}
+
+	// the callbacks are executed by the worker pool to keep the order of the events.
+	inWorkerPool := func(state string) func() {
+		return func() {
+			workerPool.Submit(func() { publish(state, nil) })
+		}
+	}
+
+	transaction.OnSolid(inWorkerPool(transactionStateSolid))
+	transaction.OnExecuted(inWorkerPool(transactionStateExecuted))
+	transaction.OnBooked(inWorkerPool(transactionStateBooked))
+	transaction.OnConflicting(inWorkerPool(transactionStateConflicting))
+	transaction.OnAccepted(inWorkerPool(transactionStateAccepted))
+	transaction.OnRejected(inWorkerPool(transactionStateRejected))
+	transaction.OnCommitted(inWorkerPool(transactionStateCommitted))
+	transaction.OnOrphaned(inWorkerPool(transactionStateOrphaned))
+	transaction.OnInvalid(func(err error) {
+		workerPool.Submit(func() { publish(transactionStateInvalid, err) })
+	})
+}
+
+// forEachOutputEvent creates the events of the outputs that were created or spent in the given slot diff for all
+// addresses whose topic is relevant.
+func forEachOutputEvent(engineInstance *engine.Engine, diff *ledgerstate.SlotDiff, isRelevant func(topic string) bool, consumer func(event *eventstream.Event) error) error {
+	hrp := engineInstance.Storage.Settings().ProtocolParameters().Bech32HRP
+
+	forEachAddress := func(output *ledgerstate.Output, payloadFunc func(bech32Address string) *outputPayload) error {
+		for _, address := range output.UnlockAddresses() {
+			bech32Address := address.Bech32(hrp)
+
+			if topic := eventstream.TopicAddressOutputs(bech32Address); isRelevant(topic) {
+				if err := consumer(eventstream.NewEvent(topic, diff.Index, payloadFunc(bech32Address))); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+
+	for _, output := range diff.Outputs {
+		if err := forEachAddress(output, func(bech32Address string) *outputPayload {
+			return &outputPayload{
+				OutputID: output.OutputID().ToHex(),
+				Address:  bech32Address,
+			}
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, spent := range diff.Spents {
+		if err := forEachAddress(spent.Output(), func(bech32Address string) *outputPayload {
+			return &outputPayload{
+				OutputID:           spent.OutputID().ToHex(),
+				Address:            bech32Address,
+				IsSpent:            true,
+				TransactionIDSpent: spent.TransactionIDSpent().ToHex(),
+			}
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func newBlockPayload(block *model.Block) *blockPayload {
+	payload := &blockPayload{
+		BlockID:  block.ID().ToHex(),
+		IssuerID: block.Block().IssuerID.ToHex(),
+	}
+
+	if transaction, isTransaction := block.Block().Payload.(*iotago.Transaction); isTransaction {
+		if transactionID, err := transaction.ID(); err == nil {
+			payload.TransactionID = transactionID.ToHex()
+		}
+	}
+
+	return payload
+}
+
+func newCommitmentPayload(commitment *model.Commitment) *commitmentPayload {
+	return &commitmentPayload{
+		CommitmentID:     commitment.ID().ToHex(),
+		Index:            uint64(commitment.Index()),
+		PrevID:           commitment.PrevID().ToHex(),
+		RootsID:          commitment.RootsID().ToHex(),
+		CumulativeWeight: commitment.CumulativeWeight(),
+	}
+}
@@ -0,0 +1,194 @@
+package eventstream
+
+import (
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/ads"
+	"github.com/iotaledger/iota-core/pkg/eventstream"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// replayChunkSize is the maximum amount of slots that are replayed before the latest commitment is checked again.
+const replayChunkSize iotago.SlotIndex = 10
+
+// replayableTopic returns true if the events of the given topic are replayed together with their committed slot.
+func replayableTopic(topic string) bool {
+	switch topic {
+	case eventstream.TopicBlockAccepted, eventstream.TopicSlotCommitted:
+		return true
+	default:
+		return strings.HasPrefix(topic, eventstream.TopicPrefixAddressOutputs)
+	}
+}
+
+// validateReplay checks if the events starting at the given slot can still be replayed.
+func validateReplay(engineInstance *engine.Engine, fromSlot iotago.SlotIndex) error {
+	if lastPrunedSlot, hasPruned := engineInstance.Storage.LastPrunedSlot(); hasPruned && fromSlot <= lastPrunedSlot {
+		return errors.WithMessagef(echo.ErrBadRequest, "slot %d was already pruned (last pruned slot %d)", fromSlot, lastPrunedSlot)
+	}
+
+	return nil
+}
+
+// replayCursor keeps track of the events that were already replayed, so that their live events can be skipped.
+type replayCursor struct {
+	// nextSlot is the first committed slot whose events were not replayed yet.
+	nextSlot iotago.SlotIndex
+	// nextFinalizedSlot is the first slot whose finalization was not replayed yet. It lags behind nextSlot if slots were
+	// committed but not finalized yet while they were replayed.
+	nextFinalizedSlot iotago.SlotIndex
+}
+
+// newReplayCursor creates a new replayCursor that starts replaying at the given slot.
+func newReplayCursor(fromSlot iotago.SlotIndex) *replayCursor {
+	return &replayCursor{
+		nextSlot:          fromSlot,
+		nextFinalizedSlot: fromSlot,
+	}
+}
+
+// replayed returns true if the given live event was already sent during the replay.
+func (r *replayCursor) replayed(event *eventstream.Event) bool {
+	if event.Topic == eventstream.TopicSlotFinalized {
+		return event.Slot < r.nextFinalizedSlot
+	}
+
+	return event.Slot < r.nextSlot && replayableTopic(event.Topic)
+}
+
+// catchUp replays the committed slots in chunks of at most replayChunkSize slots until it caught up with the latest
+// committed slot. The finalization of the replayed slots is replayed once they are finalized.
+func (r *replayCursor) catchUp(latestCommittedSlot func() iotago.SlotIndex, latestFinalizedSlot func() iotago.SlotIndex, replaySlots func(fromSlot iotago.SlotIndex, toSlot iotago.SlotIndex) error, replayFinalized func(slot iotago.SlotIndex) error) error {
+	for {
+		for finalizedSlot := latestFinalizedSlot(); r.nextFinalizedSlot < r.nextSlot && r.nextFinalizedSlot <= finalizedSlot; r.nextFinalizedSlot++ {
+			if err := replayFinalized(r.nextFinalizedSlot); err != nil {
+				return err
+			}
+		}
+
+		committedSlot := latestCommittedSlot()
+		if r.nextSlot > committedSlot {
+			return nil
+		}
+
+		toSlot := r.nextSlot + replayChunkSize - 1
+		if toSlot > committedSlot {
+			toSlot = committedSlot
+		}
+
+		if err := replaySlots(r.nextSlot, toSlot); err != nil {
+			return err
+		}
+
+		r.nextSlot = toSlot + 1
+	}
+}
+
+// replayCommittedSlots replays the events of the committed slots that were not replayed by the given cursor yet.
+func replayCommittedSlots(engineInstance *engine.Engine, cursor *replayCursor, filters []eventstream.TopicFilter, send func(event *eventstream.Event) error) error {
+	settings := engineInstance.Storage.Settings()
+
+	return cursor.catchUp(func() iotago.SlotIndex {
+		return settings.LatestCommitment().Index()
+	}, settings.LatestFinalizedSlot, func(fromSlot iotago.SlotIndex, toSlot iotago.SlotIndex) error {
+		return replay(engineInstance, fromSlot, toSlot, filters, send)
+	}, func(slot iotago.SlotIndex) error {
+		if !matchesAny(filters, eventstream.TopicSlotFinalized) {
+			return nil
+		}
+
+		return send(eventstream.NewEvent(eventstream.TopicSlotFinalized, slot, &slotFinalizedPayload{Index: uint64(slot)}))
+	})
+}
+
+// replay sends the events of the committed slots in the given range that match the given filters. Only the blocks that
+// the commitment of a slot commits to are replayed as accepted blocks, confirmed blocks and state changes of
+// transactions are not replayed at all (and finalized slots are replayed by the replayCursor).
+func replay(engineInstance *engine.Engine, fromSlot iotago.SlotIndex, toSlot iotago.SlotIndex, filters []eventstream.TopicFilter, send func(event *eventstream.Event) error) (err error) {
+	isRelevant := func(topic string) bool {
+		return matchesAny(filters, topic)
+	}
+
+	hasAddressOutputsFilter := false
+	for _, filter := range filters {
+		hasAddressOutputsFilter = hasAddressOutputsFilter || filter.MatchesPrefix(eventstream.TopicPrefixAddressOutputs)
+	}
+
+	for slot := fromSlot; slot <= toSlot; slot++ {
+		if isRelevant(eventstream.TopicBlockAccepted) {
+			if err = replayBlocks(engineInstance, slot, send); err != nil {
+				return err
+			}
+		}
+
+		if isRelevant(eventstream.TopicSlotCommitted) {
+			commitment, err := engineInstance.Storage.Commitments().Load(slot)
+			if err != nil {
+				return errors.Wrapf(err, "failed to load commitment of slot %d", slot)
+			}
+
+			if err = send(eventstream.NewEvent(eventstream.TopicSlotCommitted, slot, newCommitmentPayload(commitment))); err != nil {
+				return err
+			}
+		}
+
+		if hasAddressOutputsFilter {
+			diff, err := engineInstance.Ledger.StateDiffs(slot)
+			if err != nil {
+				return errors.Wrapf(err, "failed to load state diff of slot %d", slot)
+			}
+
+			if err = forEachOutputEvent(engineInstance, diff, isRelevant, send); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// matchesAny returns true if the given topic matches any of the given filters.
+func matchesAny(filters []eventstream.TopicFilter, topic string) bool {
+	for _, filter := range filters {
+		if filter.Matches(topic) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// replayBlocks sends the ratified accepted blocks of the given slot, as the block storage also contains the blocks that
+// were accepted after the slot was committed.
+func replayBlocks(engineInstance *engine.Engine, slot iotago.SlotIndex, send func(event *eventstream.Event) error) error {
+	store := engineInstance.Storage.Blocks(slot)
+	ratifiedAcceptedBlocksStore := engineInstance.Storage.RatifiedAcceptedBlocks(slot)
+	if store == nil || ratifiedAcceptedBlocksStore == nil {
+		return errors.Errorf("blocks of slot %d are not available", slot)
+	}
+
+	blockIDs := make(iotago.BlockIDs, 0)
+	if err := ads.NewSet[iotago.BlockID](ratifiedAcceptedBlocksStore).Stream(func(blockID iotago.BlockID) bool {
+		blockIDs = append(blockIDs, blockID)
+		return true
+	}); err != nil {
+		return errors.Wrapf(err, "failed to load ratified accepted blocks of slot %d", slot)
+	}
+
+	for _, blockID := range blockIDs {
+		block, err := store.Load(blockID)
+		if err != nil {
+			return errors.Wrapf(err, "failed to load block %s", blockID.ToHex())
+		}
+
+		if err = send(eventstream.NewEvent(eventstream.TopicBlockAccepted, slot, newBlockPayload(block))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
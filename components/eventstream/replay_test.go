@@ -0,0 +1,58 @@
+package eventstream
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/iota-core/pkg/eventstream"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestReplayCursor(t *testing.T) {
+	latestCommittedSlot, latestFinalizedSlot := iotago.SlotIndex(25), iotago.SlotIndex(12)
+
+	var replayedChunks [][2]iotago.SlotIndex
+	var finalizedSlots []iotago.SlotIndex
+	catchUp := func(cursor *replayCursor) {
+		require.NoError(t, cursor.catchUp(func() iotago.SlotIndex {
+			return latestCommittedSlot
+		}, func() iotago.SlotIndex {
+			return latestFinalizedSlot
+		}, func(fromSlot iotago.SlotIndex, toSlot iotago.SlotIndex) error {
+			replayedChunks = append(replayedChunks, [2]iotago.SlotIndex{fromSlot, toSlot})
+
+			return nil
+		}, func(slot iotago.SlotIndex) error {
+			finalizedSlots = append(finalizedSlots, slot)
+
+			return nil
+		}))
+	}
+
+	cursor := newReplayCursor(5)
+	catchUp(cursor)
+
+	require.Equal(t, [][2]iotago.SlotIndex{{5, 14}, {15, 24}, {25, 25}}, replayedChunks)
+	require.Equal(t, []iotago.SlotIndex{5, 6, 7, 8, 9, 10, 11, 12}, finalizedSlots)
+
+	// the live events of replayed slots are skipped.
+	require.True(t, cursor.replayed(eventstream.NewEvent(eventstream.TopicSlotCommitted, 25, nil)))
+	require.True(t, cursor.replayed(eventstream.NewEvent(eventstream.TopicBlockAccepted, 20, nil)))
+	require.False(t, cursor.replayed(eventstream.NewEvent(eventstream.TopicSlotCommitted, 26, nil)))
+	require.True(t, cursor.replayed(eventstream.NewEvent(eventstream.TopicSlotFinalized, 12, nil)))
+
+	// slots that were committed but not finalized during the replay still receive their live finalization event.
+	require.False(t, cursor.replayed(eventstream.NewEvent(eventstream.TopicSlotFinalized, 13, nil)))
+	require.False(t, cursor.replayed(eventstream.NewEvent(eventstream.TopicSlotFinalized, 25, nil)))
+
+	// slots that were finalized before subscribing are caught up without replaying their committed slots again.
+	replayedChunks, finalizedSlots = nil, nil
+	latestFinalizedSlot = 15
+	catchUp(cursor)
+
+	require.Empty(t, replayedChunks)
+	require.Equal(t, []iotago.SlotIndex{13, 14, 15}, finalizedSlots)
+	require.True(t, cursor.replayed(eventstream.NewEvent(eventstream.TopicSlotFinalized, 15, nil)))
+	require.False(t, cursor.replayed(eventstream.NewEvent(eventstream.TopicSlotFinalized, 16, nil)))
+}
@@ -0,0 +1,179 @@
+package eventstream
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/iota-core/pkg/eventstream"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+var (
+	// webSocketWriteTimeout is the time a client has to receive an event before the connection is closed.
+	webSocketWriteTimeout = 3 * time.Second
+
+	upgrader = websocket.Upgrader{
+		HandshakeTimeout:  webSocketWriteTimeout,
+		CheckOrigin:       func(r *http.Request) bool { return true },
+		EnableCompression: true,
+	}
+)
+
+func streamWebSocket(c echo.Context, request *streamRequest) error {
+	engineInstance := deps.Protocol.MainEngineInstance()
+	if request.replaySlots {
+		if err := validateReplay(engineInstance, request.fromSlot); err != nil {
+			return err
+		}
+	}
+
+	ws, err := upgrader.Upgrade(c.Response(), c.Request(), nil)
+	if err != nil {
+		return err
+	}
+	defer ws.Close()
+
+	// the connection needs to be read to process the control messages of the client.
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+
+		for {
+			if _, _, err := ws.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	write := func(messageType int, data []byte) error {
+		if err := ws.SetWriteDeadline(time.Now().Add(webSocketWriteTimeout)); err != nil {
+			return err
+		}
+
+		return ws.WriteMessage(messageType, data)
+	}
+
+	if err = stream(request, closed, func(event *eventstream.Event) error {
+		eventBytes, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+
+		return write(websocket.TextMessage, eventBytes)
+	}, func() error {
+		return write(websocket.PingMessage, nil)
+	}); err != nil {
+		Component.LogDebugf("closing websocket stream: %s", err)
+
+		_ = write(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseTryAgainLater, err.Error()))
+	}
+
+	return nil
+}
+
+func streamServerSentEvents(c echo.Context, request *streamRequest) error {
+	engineInstance := deps.Protocol.MainEngineInstance()
+	if request.replaySlots {
+		if err := validateReplay(engineInstance, request.fromSlot); err != nil {
+			return err
+		}
+	}
+
+	response := c.Response()
+	response.Header().Set(echo.HeaderContentType, "text/event-stream")
+	response.Header().Set(echo.HeaderCacheControl, "no-cache")
+	response.Header().Set(echo.HeaderConnection, "keep-alive")
+	response.WriteHeader(http.StatusOK)
+	response.Flush()
+
+	write := func(message string) error {
+		if _, err := response.Write([]byte(message)); err != nil {
+			return err
+		}
+		response.Flush()
+
+		return nil
+	}
+
+	// the slot is used as the ID of the event, so that reconnecting clients resume at the slot of the last event.
+	writeEvent := func(eventType string, id iotago.SlotIndex, data any) error {
+		dataBytes, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+
+		return write(fmt.Sprintf("id: %d\nevent: %s\ndata: %s\n\n", id, eventType, dataBytes))
+	}
+
+	if err := stream(request, c.Request().Context().Done(), func(event *eventstream.Event) error {
+		return writeEvent(event.Topic, event.Slot, event)
+	}, func() error {
+		return write(":keep-alive\n\n")
+	}); err != nil {
+		Component.LogDebugf("closing server-sent events stream: %s", err)
+
+		_ = write(fmt.Sprintf("event: error\ndata: %s\n\n", err.Error()))
+	}
+
+	return nil
+}
+
+// stream replays the events of the committed slots (if requested) and afterwards sends the live events of the
+// subscribed topics until the client disconnects or is not able to keep up with the events.
+func stream(request *streamRequest, closed <-chan struct{}, send func(event *eventstream.Event) error, keepAlive func() error) error {
+	engineInstance := deps.Protocol.MainEngineInstance()
+
+	// the committed slots are replayed before subscribing, so that a long replay can not overflow the buffer of the
+	// subscription.
+	cursor := newReplayCursor(request.fromSlot)
+	if request.replaySlots {
+		if err := replayCommittedSlots(engineInstance, cursor, request.filters, send); err != nil {
+			return errors.Wrap(err, "failed to replay events")
+		}
+	}
+
+	subscription := deps.Broker.Subscribe(request.filters, ParamsEventStream.ClientBufferSize)
+	defer subscription.Close()
+
+	// the slots that were committed or finalized before the subscription was created are replayed as well to not miss
+	// their events.
+	if request.replaySlots {
+		if err := replayCommittedSlots(engineInstance, cursor, request.filters, send); err != nil {
+			return errors.Wrap(err, "failed to replay events")
+		}
+	}
+
+	keepAliveTicker := time.NewTicker(ParamsEventStream.KeepAliveInterval)
+	defer keepAliveTicker.Stop()
+
+	for {
+		select {
+		case <-closed:
+			return nil
+
+		case <-subscription.Done():
+			return subscription.Err()
+
+		case event := <-subscription.Events():
+			// skip the events of the slots that were already replayed.
+			if request.replaySlots && cursor.replayed(event) {
+				continue
+			}
+
+			if err := send(event); err != nil {
+				return err
+			}
+
+		case <-keepAliveTicker.C:
+			if err := keepAlive(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package eventstream
+
+const (
+	transactionStateSolid       = "solid"
+	transactionStateExecuted    = "executed"
+	transactionStateInvalid     = "invalid"
+	transactionStateBooked      = "booked"
+	transactionStateConflicting = "conflicting"
+	transactionStateAccepted    = "accepted"
+	transactionStateRejected    = "rejected"
+	transactionStateCommitted   = "committed"
+	transactionStateOrphaned    = "orphaned"
+)
+
+// blockPayload is the payload of the events of the block topics.
+type blockPayload struct {
+	// BlockID is the hex encoded ID of the block.
+	BlockID string `json:"blockID"`
+	// IssuerID is the hex encoded account ID of the issuer of the block.
+	IssuerID string `json:"issuerID"`
+	// TransactionID is the hex encoded ID of the transaction contained in the block.
+	TransactionID string `json:"transactionID,omitempty"`
+}
+
+// commitmentPayload is the payload of the events of the committed slots topic.
+type commitmentPayload struct {
+	// CommitmentID is the hex encoded ID of the commitment.
+	CommitmentID string `json:"commitmentID"`
+	// Index is the index of the committed slot.
+	Index uint64 `json:"index"`
+	// PrevID is the hex encoded ID of the previous commitment.
+	PrevID string `json:"prevID"`
+	// RootsID is the hex encoded ID of the roots of the commitment.
+	RootsID string `json:"rootsID"`
+	// CumulativeWeight is the cumulative weight of the commitment.
+	CumulativeWeight uint64 `json:"cumulativeWeight"`
+}
+
+// slotFinalizedPayload is the payload of the events of the finalized slots topic.
+type slotFinalizedPayload struct {
+	// Index is the index of the finalized slot.
+	Index uint64 `json:"index"`
+}
+
+// transactionPayload is the payload of the events of the transaction topics.
+type transactionPayload struct {
+	// TransactionID is the hex encoded ID of the transaction.
+	TransactionID string `json:"transactionID"`
+	// State is the state the transaction transitioned to.
+	State string `json:"state"`
+	// Error is the reason why the transaction is invalid.
+	Error string `json:"error,omitempty"`
+}
+
+// outputPayload is the payload of the events of the address output topics.
+type outputPayload struct {
+	// OutputID is the hex encoded ID of the output.
+	OutputID string `json:"outputID"`
+	// Address is the bech32 encoded address the output belongs to.
+	Address string `json:"address"`
+	// IsSpent tells whether the output was spent (or created otherwise).
+	IsSpent bool `json:"isSpent"`
+	// TransactionIDSpent is the hex encoded ID of the transaction that spent the output.
+	TransactionIDSpent string `json:"transactionIDSpent,omitempty"`
+}
@@ -0,0 +1,312 @@
+package indexer
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/hive.go/app"
+	"github.com/iotaledger/hive.go/kvstore"
+	hivedb "github.com/iotaledger/hive.go/kvstore/database"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/components/restapi"
+	"github.com/iotaledger/iota-core/pkg/daemon"
+	"github.com/iotaledger/iota-core/pkg/indexer"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/notarization"
+	"github.com/iotaledger/iota-core/pkg/storage/database"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+const (
+	// RouteOutputs is the route for getting the IDs of all unspent outputs.
+	// GET returns the output IDs (filtered by the query parameters).
+	RouteOutputs = "/outputs"
+
+	// RouteOutputsBasic is the route for getting the IDs of the unspent basic outputs.
+	// GET returns the output IDs (filtered by the query parameters).
+	RouteOutputsBasic = "/outputs/basic"
+
+	// RouteOutputsAlias is the route for getting the IDs of the unspent alias outputs.
+	// GET returns the output IDs (filtered by the query parameters).
+	RouteOutputsAlias = "/outputs/alias"
+
+	// RouteOutputsFoundry is the route for getting the IDs of the unspent foundry outputs.
+	// GET returns the output IDs (filtered by the query parameters).
+	RouteOutputsFoundry = "/outputs/foundry"
+
+	// RouteOutputsNFT is the route for getting the IDs of the unspent NFT outputs.
+	// GET returns the output IDs (filtered by the query parameters).
+	RouteOutputsNFT = "/outputs/nft"
+
+	// RouteBlocksTaggedData is the route for getting the IDs of the accepted blocks with tagged data.
+	// GET returns the block IDs (filtered by the query parameters).
+	RouteBlocksTaggedData = "/blocks/tagged-data"
+)
+
+func init() {
+	Component = &app.Component{
+		Name:      "Indexer",
+		DepsFunc:  func(cDeps dependencies) { deps = cDeps },
+		Params:    params,
+		Provide:   provide,
+		Configure: configure,
+		Run:       run,
+		IsEnabled: func(c *dig.Container) bool {
+			return ParamsIndexer.Enabled && restapi.ParamsRestAPI.Enabled
+		},
+	}
+}
+
+var (
+	Component *app.Component
+	deps      dependencies
+
+	// syncMutex makes sure that the index is only updated by a single goroutine at a time.
+	syncMutex sync.Mutex
+	// isStopped is set once the indexer database was closed.
+	isStopped bool
+
+	// taggedDataIndexer is the index of the accepted blocks with tagged data (nil if it is disabled).
+	taggedDataIndexer *indexer.TaggedDataIndexer
+)
+
+type dependencies struct {
+	dig.In
+
+	Protocol         *protocol.Protocol
+	RestRouteManager *restapi.RestRouteManager
+	Indexer          *indexer.Indexer
+	IndexerStore     kvstore.KVStore `name:"indexerStore"`
+}
+
+func provide(c *dig.Container) error {
+	type storeDeps struct {
+		dig.In
+
+		DatabaseEngine hivedb.Engine `name:"databaseEngine"`
+	}
+
+	type storeResult struct {
+		dig.Out
+
+		IndexerStore kvstore.KVStore `name:"indexerStore"`
+	}
+
+	if err := c.Provide(func(deps storeDeps) storeResult {
+		store, err := database.StoreWithDefaultSettings(ParamsIndexer.Path, true, deps.DatabaseEngine)
+		if err != nil {
+			Component.LogFatalfAndExit("unable to create indexer database: %s", err)
+		}
+
+		return storeResult{
+			IndexerStore: store,
+		}
+	}); err != nil {
+		return err
+	}
+
+	type indexerDeps struct {
+		dig.In
+
+		IndexerStore kvstore.KVStore `name:"indexerStore"`
+	}
+
+	return c.Provide(func(deps indexerDeps) *indexer.Indexer {
+		return indexer.New(deps.IndexerStore)
+	})
+}
+
+func configure() error {
+	routeGroup := deps.RestRouteManager.AddRoute("indexer/v1")
+
+	routeGroup.GET(RouteOutputs, func(c echo.Context) error {
+		return respondWithOutputs(c, nil)
+	}, checkIndexerSynced())
+
+	for route, outputType := range map[string]iotago.OutputType{
+		RouteOutputsBasic:   iotago.OutputBasic,
+		RouteOutputsAlias:   iotago.OutputAlias,
+		RouteOutputsFoundry: iotago.OutputFoundry,
+		RouteOutputsNFT:     iotago.OutputNFT,
+	} {
+		outputType := outputType
+
+		routeGroup.GET(route, func(c echo.Context) error {
+			return respondWithOutputs(c, &outputType)
+		}, checkIndexerSynced())
+	}
+
+	if ParamsIndexer.TaggedData.Enabled {
+		// the index is kept in the prunable storage of the main engine, so that it is pruned together with the blocks.
+		taggedDataIndexer = indexer.NewTaggedDataIndexer(func(slot iotago.SlotIndex, createIfMissing bool) kvstore.KVStore {
+			if !createIfMissing {
+				return deps.Protocol.MainEngineInstance().Storage.TaggedDataIfExists(slot)
+			}
+
+			return deps.Protocol.MainEngineInstance().Storage.TaggedData(slot)
+		})
+
+		routeGroup.GET(RouteBlocksTaggedData, func(c echo.Context) error {
+			resp, err := taggedDataBlocksByFilter(c)
+			if err != nil {
+				return err
+			}
+
+			return httpserver.JSONResponse(c, http.StatusOK, resp)
+		})
+	}
+
+	return nil
+}
+
+func run() error {
+	if err := Component.Daemon().BackgroundWorker(Component.Name, func(ctx context.Context) {
+		Component.LogInfo("Starting Indexer ... done")
+
+		unhook := lo.Batch(
+			deps.Protocol.Events.Engine.Notarization.SlotCommitted.Hook(func(details *notarization.SlotCommittedDetails) {
+				if err := syncIndexer(deps.Protocol.MainEngineInstance(), false); err != nil {
+					Component.LogErrorf("failed to update index for slot %d: %s", details.Commitment.Index(), err)
+				}
+			}, event.WithWorkerPool(Component.WorkerPool)).Unhook,
+			deps.Protocol.Events.MainEngineSwitched.Hook(func(engineInstance *engine.Engine) {
+				// the index might contain state of the old chain, so it has to be rebuilt from the new ledger.
+				if err := syncIndexer(engineInstance, true); err != nil {
+					Component.LogErrorf("failed to rebuild index after engine switch: %s", err)
+				}
+			}, event.WithWorkerPool(Component.WorkerPool)).Unhook,
+		)
+
+		if err := syncIndexer(deps.Protocol.MainEngineInstance(), false); err != nil {
+			Component.LogErrorf("failed to sync index: %s", err)
+		}
+
+		unhookTaggedData := hookTaggedDataIndexer()
+
+		<-ctx.Done()
+		Component.LogInfo("Stopping Indexer ...")
+
+		unhook()
+		unhookTaggedData()
+
+		syncMutex.Lock()
+		defer syncMutex.Unlock()
+
+		isStopped = true
+		if err := database.FlushAndClose(deps.IndexerStore); err != nil {
+			Component.LogErrorf("failed to close indexer database: %s", err)
+		}
+
+		Component.LogInfo("Stopping Indexer ... done")
+	}, daemon.PriorityIndexer); err != nil {
+		Component.LogPanicf("failed to start worker: %s", err)
+	}
+
+	return nil
+}
+
+// syncIndexer brings the index up to date with the latest commitment of the given engine, by applying the diffs of
+// the missing slots. The index is rebuilt from the unspent outputs of the ledger if it is empty, ahead of the engine,
+// if the diffs are not available anymore, or if a rebuild was requested explicitly.
+func syncIndexer(engineInstance *engine.Engine, forceRebuild bool) error {
+	syncMutex.Lock()
+	defer syncMutex.Unlock()
+
+	if isStopped {
+		return nil
+	}
+
+	latestCommittedSlot := engineInstance.Storage.Settings().LatestCommitment().Index()
+
+	ledgerIndex, isSynced, err := deps.Indexer.LedgerIndex()
+	if err != nil {
+		return err
+	}
+
+	if !forceRebuild && isSynced && ledgerIndex <= latestCommittedSlot {
+		for slot := ledgerIndex + 1; slot <= latestCommittedSlot; slot++ {
+			diff, err := engineInstance.Ledger.StateDiffs(slot)
+			if err != nil {
+				Component.LogWarnf("diff of slot %d is not available (%s), rebuilding index", slot, err)
+				return rebuildIndexer(engineInstance)
+			}
+
+			if err = deps.Indexer.ApplySlotDiff(diff); err != nil {
+				return errors.Wrapf(err, "failed to apply diff of slot %d", slot)
+			}
+		}
+
+		return nil
+	}
+
+	return rebuildIndexer(engineInstance)
+}
+
+func rebuildIndexer(engineInstance *engine.Engine) error {
+	// outputs of slots that are committed during the rebuild are indexed again once their diff is applied, which is
+	// fine as applying a diff to the index is idempotent.
+	latestCommittedSlot := engineInstance.Storage.Settings().LatestCommitment().Index()
+
+	Component.LogInfof("Rebuilding index from ledger state at slot %d ...", latestCommittedSlot)
+	if err := deps.Indexer.Rebuild(latestCommittedSlot, engineInstance.Ledger.ForEachUnspentOutput); err != nil {
+		return errors.Wrap(err, "failed to rebuild index")
+	}
+	Component.LogInfof("Rebuilding index from ledger state at slot %d ... done", latestCommittedSlot)
+
+	return nil
+}
+
+// hookTaggedDataIndexer adds the accepted blocks with tagged data to the index and keeps their state up to date.
+func hookTaggedDataIndexer() (unhook func()) {
+	if taggedDataIndexer == nil {
+		return func() {}
+	}
+
+	// a single worker makes sure that blocks are confirmed after they were added.
+	workerPool := workerpool.New("TaggedDataIndexer", 1).Start()
+
+	return lo.Batch(
+		deps.Protocol.Events.Engine.BlockGadget.BlockAccepted.Hook(func(block *blocks.Block) {
+			if err := taggedDataIndexer.AddAcceptedBlock(block.ID(), block.Block()); err != nil {
+				Component.LogErrorf("failed to index tagged data of block %s: %s", block.ID(), err)
+			}
+		}, event.WithWorkerPool(workerPool)).Unhook,
+		deps.Protocol.Events.Engine.BlockGadget.BlockConfirmed.Hook(func(block *blocks.Block) {
+			if err := taggedDataIndexer.MarkConfirmed(block.ID(), block.Block()); err != nil {
+				Component.LogErrorf("failed to update tagged data index for block %s: %s", block.ID(), err)
+			}
+		}, event.WithWorkerPool(workerPool)).Unhook,
+		func() { workerPool.Shutdown() },
+	)
+}
+
+func checkIndexerSynced() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if _, isSynced, err := deps.Indexer.LedgerIndex(); err != nil || !isSynced {
+				return errors.WithMessage(echo.ErrServiceUnavailable, "indexer is not synced")
+			}
+
+			return next(c)
+		}
+	}
+}
+
+func respondWithOutputs(c echo.Context, outputType *iotago.OutputType) error {
+	resp, err := outputsByFilter(c, outputType)
+	if err != nil {
+		return err
+	}
+
+	return httpserver.JSONResponse(c, http.StatusOK, resp)
+}
@@ -0,0 +1,115 @@
+package indexer
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/components/restapi"
+	"github.com/iotaledger/iota-core/pkg/indexer"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+const (
+	// QueryParameterAddress is used to filter for outputs that can be unlocked by a certain bech32 address.
+	QueryParameterAddress = "address"
+
+	// QueryParameterHasStorageDepositReturn is used to filter for outputs with (or without) a storage deposit return unlock condition.
+	QueryParameterHasStorageDepositReturn = "hasStorageDepositReturn"
+
+	// QueryParameterHasExpiration is used to filter for outputs with (or without) an expiration unlock condition.
+	QueryParameterHasExpiration = "hasExpiration"
+
+	// QueryParameterHasTimelock is used to filter for outputs with (or without) a timelock unlock condition.
+	QueryParameterHasTimelock = "hasTimelock"
+
+	// QueryParameterCursor is used to request the next page of results.
+	QueryParameterCursor = "cursor"
+
+	// QueryParameterPageSize is used to define the page size for the results.
+	QueryParameterPageSize = "pageSize"
+)
+
+func outputsByFilter(c echo.Context, outputType *iotago.OutputType) (*outputsResponse, error) {
+	filter, err := parseFilter(c)
+	if err != nil {
+		return nil, err
+	}
+	filter.OutputType = outputType
+
+	result, err := deps.Indexer.Outputs(filter)
+	if err != nil {
+		if errors.Is(err, indexer.ErrNotSynced) {
+			return nil, errors.WithMessage(echo.ErrServiceUnavailable, err.Error())
+		}
+
+		return nil, errors.WithMessagef(echo.ErrInternalServerError, "failed to query indexer, error: %s", err)
+	}
+
+	resp := &outputsResponse{
+		LedgerIndex: uint64(result.LedgerIndex),
+		PageSize:    filter.PageSize,
+		Items:       make([]string, 0, len(result.OutputIDs)),
+	}
+
+	for _, outputID := range result.OutputIDs {
+		resp.Items = append(resp.Items, outputID.ToHex())
+	}
+
+	if result.Cursor != nil {
+		resp.Cursor = result.Cursor.ToHex()
+	}
+
+	return resp, nil
+}
+
+func parseFilter(c echo.Context) (*indexer.Filter, error) {
+	filter := &indexer.Filter{
+		PageSize: restapi.ParamsRestAPI.Limits.MaxResults,
+	}
+
+	if len(c.QueryParam(QueryParameterAddress)) > 0 {
+		address, err := httpserver.ParseBech32AddressQueryParam(c, deps.Protocol.MainEngineInstance().Storage.Settings().ProtocolParameters().Bech32HRP, QueryParameterAddress)
+		if err != nil {
+			return nil, err
+		}
+		filter.Address = address
+	}
+
+	for queryParameter, target := range map[string]**bool{
+		QueryParameterHasStorageDepositReturn: &filter.HasStorageDepositReturn,
+		QueryParameterHasExpiration:           &filter.HasExpiration,
+		QueryParameterHasTimelock:             &filter.HasTimelock,
+	} {
+		if len(c.QueryParam(queryParameter)) == 0 {
+			continue
+		}
+
+		value, err := httpserver.ParseBoolQueryParam(c, queryParameter)
+		if err != nil {
+			return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid value for %s, error: %s", queryParameter, err)
+		}
+		*target = &value
+	}
+
+	if len(c.QueryParam(QueryParameterCursor)) > 0 {
+		cursor, err := iotago.OutputIDFromHex(c.QueryParam(QueryParameterCursor))
+		if err != nil {
+			return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid cursor, error: %s", err)
+		}
+		filter.Cursor = &cursor
+	}
+
+	if len(c.QueryParam(QueryParameterPageSize)) > 0 {
+		pageSize, err := httpserver.ParseUint32QueryParam(c, QueryParameterPageSize, uint32(restapi.ParamsRestAPI.Limits.MaxResults))
+		if err != nil {
+			return nil, err
+		}
+
+		if pageSize > 0 {
+			filter.PageSize = int(pageSize)
+		}
+	}
+
+	return filter, nil
+}
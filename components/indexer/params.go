@@ -0,0 +1,28 @@
+package indexer
+
+import (
+	"github.com/iotaledger/hive.go/app"
+)
+
+// ParametersIndexer contains the definition of the parameters used by the Indexer.
+type ParametersIndexer struct {
+	// Enabled defines whether the Indexer plugin is enabled.
+	Enabled bool `default:"true" usage:"whether the Indexer plugin is enabled"`
+	// Path defines the path to the indexer database.
+	Path string `default:"testnet/indexer" usage:"the path to the indexer database folder"`
+
+	TaggedData struct {
+		// Enabled defines whether accepted blocks with tagged data are indexed by their tag.
+		Enabled bool `default:"true" usage:"whether accepted blocks with tagged data are indexed by their tag"`
+		// MaxSlots defines the maximum amount of slots that are searched by a single query.
+		MaxSlots int `default:"100" usage:"the maximum amount of slots that are searched by a single query"`
+	} `name:"taggedData"`
+}
+
+var ParamsIndexer = &ParametersIndexer{}
+
+var params = &app.ComponentParams{
+	Params: map[string]any{
+		"indexer": ParamsIndexer,
+	},
+}
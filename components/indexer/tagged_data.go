@@ -0,0 +1,137 @@
+package indexer
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/components/restapi"
+	"github.com/iotaledger/iota-core/pkg/indexer"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+const (
+	// QueryParameterTag is used to filter for blocks whose tag starts with the given hex encoded prefix.
+	QueryParameterTag = "tag"
+
+	// QueryParameterIssuedAfter is used to filter for blocks that were issued at or after the given unix timestamp.
+	QueryParameterIssuedAfter = "issuedAfter"
+
+	// QueryParameterIssuedBefore is used to filter for blocks that were issued before the given unix timestamp.
+	QueryParameterIssuedBefore = "issuedBefore"
+
+	// QueryParameterBlockState is used to filter for blocks with the given acceptance state (accepted, confirmed or finalized).
+	QueryParameterBlockState = "blockState"
+
+	// taggedDataTagMaxLength is the maximum length of the tag of tagged data.
+	taggedDataTagMaxLength = 64
+)
+
+func taggedDataBlocksByFilter(c echo.Context) (*taggedDataBlocksResponse, error) {
+	filter, err := parseTaggedDataFilter(c)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := taggedDataIndexer.TaggedDataBlocks(filter)
+	if err != nil {
+		return nil, errors.WithMessagef(echo.ErrInternalServerError, "failed to query tagged data index, error: %s", err)
+	}
+
+	resp := &taggedDataBlocksResponse{
+		PageSize: filter.PageSize,
+		Items:    make([]*taggedDataBlockResponse, 0, len(result.Blocks)),
+	}
+
+	for _, block := range result.Blocks {
+		resp.Items = append(resp.Items, &taggedDataBlockResponse{
+			BlockID:     block.BlockID.ToHex(),
+			Tag:         iotago.EncodeHex(block.Tag),
+			IssuingTime: block.IssuingTime.UnixNano(),
+			BlockState:  block.State.String(),
+		})
+	}
+
+	if result.Cursor != nil {
+		resp.Cursor = iotago.EncodeHex(result.Cursor)
+	}
+
+	return resp, nil
+}
+
+func parseTaggedDataFilter(c echo.Context) (*indexer.TaggedDataFilter, error) {
+	engineInstance := deps.Protocol.MainEngineInstance()
+	slotTimeProvider := engineInstance.API().SlotTimeProvider()
+
+	filter := &indexer.TaggedDataFilter{
+		EndSlot:             slotTimeProvider.IndexFromTime(engineInstance.Clock.Accepted().Time()),
+		LatestFinalizedSlot: engineInstance.Storage.Settings().LatestFinalizedSlot(),
+		PageSize:            restapi.ParamsRestAPI.Limits.MaxResults,
+		MaxSlots:            iotago.SlotIndex(ParamsIndexer.TaggedData.MaxSlots),
+	}
+
+	if lastPrunedSlot, hasPruned := engineInstance.Storage.LastPrunedSlot(); hasPruned {
+		filter.StartSlot = lastPrunedSlot + 1
+	}
+
+	if len(c.QueryParam(QueryParameterTag)) > 0 {
+		tagPrefix, err := httpserver.ParseHexQueryParam(c, QueryParameterTag, taggedDataTagMaxLength)
+		if err != nil {
+			return nil, err
+		}
+		filter.TagPrefix = tagPrefix
+	}
+
+	if len(c.QueryParam(QueryParameterIssuedAfter)) > 0 {
+		issuedAfter, err := httpserver.ParseUnixTimestampQueryParam(c, QueryParameterIssuedAfter)
+		if err != nil {
+			return nil, err
+		}
+		filter.IssuedAfter = issuedAfter
+
+		if startSlot := slotTimeProvider.IndexFromTime(issuedAfter); startSlot > filter.StartSlot {
+			filter.StartSlot = startSlot
+		}
+	}
+
+	if len(c.QueryParam(QueryParameterIssuedBefore)) > 0 {
+		issuedBefore, err := httpserver.ParseUnixTimestampQueryParam(c, QueryParameterIssuedBefore)
+		if err != nil {
+			return nil, err
+		}
+		filter.IssuedBefore = issuedBefore
+
+		if endSlot := slotTimeProvider.IndexFromTime(issuedBefore); endSlot < filter.EndSlot {
+			filter.EndSlot = endSlot
+		}
+	}
+
+	if len(c.QueryParam(QueryParameterBlockState)) > 0 {
+		state, err := indexer.TaggedDataBlockStateFromString(c.QueryParam(QueryParameterBlockState))
+		if err != nil {
+			return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid value for %s, error: %s", QueryParameterBlockState, err)
+		}
+		filter.State = &state
+	}
+
+	if len(c.QueryParam(QueryParameterCursor)) > 0 {
+		cursor, err := iotago.DecodeHex(c.QueryParam(QueryParameterCursor))
+		if err != nil {
+			return nil, errors.WithMessagef(httpserver.ErrInvalidParameter, "invalid cursor, error: %s", err)
+		}
+		filter.Cursor = cursor
+	}
+
+	if len(c.QueryParam(QueryParameterPageSize)) > 0 {
+		pageSize, err := httpserver.ParseUint32QueryParam(c, QueryParameterPageSize, uint32(restapi.ParamsRestAPI.Limits.MaxResults))
+		if err != nil {
+			return nil, err
+		}
+
+		if pageSize > 0 {
+			filter.PageSize = int(pageSize)
+		}
+	}
+
+	return filter, nil
+}
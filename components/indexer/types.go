@@ -0,0 +1,35 @@
+package indexer
+
+// outputsResponse defines the response of a GET outputs REST API call.
+type outputsResponse struct {
+	// The index of the slot that the response is based on.
+	LedgerIndex uint64 `json:"ledgerIndex"`
+	// The maximum amount of items returned in one call.
+	PageSize int `json:"pageSize"`
+	// The hex encoded output IDs.
+	Items []string `json:"items"`
+	// The cursor to request the next page of results (empty if there are no more results).
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// taggedDataBlocksResponse defines the response of a GET tagged data blocks REST API call.
+type taggedDataBlocksResponse struct {
+	// The maximum amount of items returned in one call.
+	PageSize int `json:"pageSize"`
+	// The blocks with tagged data that matched the filter.
+	Items []*taggedDataBlockResponse `json:"items"`
+	// The cursor to request the next page of results (empty if there are no more results).
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// taggedDataBlockResponse defines an indexed block with tagged data.
+type taggedDataBlockResponse struct {
+	// The hex encoded ID of the block.
+	BlockID string `json:"blockId"`
+	// The hex encoded tag of the tagged data.
+	Tag string `json:"tag"`
+	// The issuing time of the block in nanoseconds.
+	IssuingTime int64 `json:"issuingTime,string"`
+	// The acceptance state of the block.
+	BlockState string `json:"blockState"`
+}
@@ -0,0 +1,52 @@
+package inx
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	inx "github.com/iotaledger/inx/go"
+)
+
+func (s *Server) RegisterAPIRoute(_ context.Context, req *inx.APIRouteRequest) (*inx.NoParams, error) {
+	if deps.RestRouteManager == nil {
+		return nil, status.Error(codes.Unavailable, "the REST API is disabled")
+	}
+
+	if len(req.GetRoute()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "route can not be empty")
+	}
+
+	if len(req.GetHost()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "host can not be empty")
+	}
+
+	if req.GetPort() == 0 || req.GetPort() > 65535 {
+		return nil, status.Errorf(codes.InvalidArgument, "port %d is invalid", req.GetPort())
+	}
+
+	if err := deps.RestRouteManager.AddProxyRoute(req.GetRoute(), req.GetHost(), req.GetPort()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to add route %s: %s", req.GetRoute(), err)
+	}
+
+	Component.LogInfof("registered API route %s for %s:%d", req.GetRoute(), req.GetHost(), req.GetPort())
+
+	return &inx.NoParams{}, nil
+}
+
+func (s *Server) UnregisterAPIRoute(_ context.Context, req *inx.APIRouteRequest) (*inx.NoParams, error) {
+	if deps.RestRouteManager == nil {
+		return nil, status.Error(codes.Unavailable, "the REST API is disabled")
+	}
+
+	if len(req.GetRoute()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "route can not be empty")
+	}
+
+	deps.RestRouteManager.RemoveRoute(req.GetRoute())
+
+	Component.LogInfof("unregistered API route %s", req.GetRoute())
+
+	return &inx.NoParams{}, nil
+}
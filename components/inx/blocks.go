@@ -0,0 +1,164 @@
+package inx
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/runtime/contextutils"
+	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	inx "github.com/iotaledger/inx/go"
+	"github.com/iotaledger/iota-core/pkg/blockissuer"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// ListenToBlocks sends the blocks of the main engine as soon as they are accepted.
+func (s *Server) ListenToBlocks(_ *inx.NoParams, srv inx.INX_ListenToBlocksServer) error {
+	return streamEvents(srv.Context(), "ListenToBlocks", func(workerPool *workerpool.WorkerPool, abort func(err error)) (unhook func()) {
+		return deps.Protocol.Events.Engine.BlockGadget.BlockAccepted.Hook(func(block *blocks.Block) {
+			// root blocks do not have a model block and are not sent to the extensions.
+			if block.ModelBlock() == nil {
+				return
+			}
+
+			if err := srv.Send(newBlock(block.ModelBlock())); err != nil {
+				abort(err)
+			}
+		}, event.WithWorkerPool(workerPool)).Unhook
+	})
+}
+
+// ListenToSolidBlocks sends the metadata of the blocks of the main engine as soon as they are solid.
+func (s *Server) ListenToSolidBlocks(_ *inx.NoParams, srv inx.INX_ListenToSolidBlocksServer) error {
+	return streamEvents(srv.Context(), "ListenToSolidBlocks", func(workerPool *workerpool.WorkerPool, abort func(err error)) (unhook func()) {
+		return deps.Protocol.Events.Engine.BlockDAG.BlockSolid.Hook(func(block *blocks.Block) {
+			if err := srv.Send(blockMetadata(deps.Protocol.MainEngineInstance(), block)); err != nil {
+				abort(err)
+			}
+		}, event.WithWorkerPool(workerPool)).Unhook
+	})
+}
+
+// ListenToReferencedBlocks sends the metadata of the blocks of the main engine as soon as they are confirmed.
+func (s *Server) ListenToReferencedBlocks(_ *inx.NoParams, srv inx.INX_ListenToReferencedBlocksServer) error {
+	return streamEvents(srv.Context(), "ListenToReferencedBlocks", func(workerPool *workerpool.WorkerPool, abort func(err error)) (unhook func()) {
+		return deps.Protocol.Events.Engine.BlockGadget.BlockConfirmed.Hook(func(block *blocks.Block) {
+			if err := srv.Send(blockMetadata(deps.Protocol.MainEngineInstance(), block)); err != nil {
+				abort(err)
+			}
+		}, event.WithWorkerPool(workerPool)).Unhook
+	})
+}
+
+func (s *Server) SubmitBlock(ctx context.Context, rawBlock *inx.RawBlock) (*inx.BlockId, error) {
+	block := new(iotago.Block)
+	if _, err := deps.Protocol.API().Decode(rawBlock.GetData(), block); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse block: %s", err)
+	}
+
+	mergedCtx, mergedCtxCancel := contextutils.MergeContexts(ctx, Component.Daemon().ContextStopped())
+	defer mergedCtxCancel()
+
+	blockID, err := deps.BlockIssuer.AttachBlock(mergedCtx, block)
+	if err != nil {
+		switch {
+		case errors.Is(err, blockissuer.ErrBlockAttacherInvalidBlock):
+			return nil, status.Errorf(codes.InvalidArgument, "failed to attach block: %s", err)
+
+		case errors.Is(err, blockissuer.ErrBlockAttacherPoWNotAvailable):
+			return nil, status.Errorf(codes.Unavailable, "failed to attach block: %s", err)
+
+		default:
+			return nil, status.Errorf(codes.Internal, "failed to attach block: %s", err)
+		}
+	}
+
+	return newBlockID(blockID), nil
+}
+
+func (s *Server) ReadBlock(_ context.Context, req *inx.BlockId) (*inx.RawBlock, error) {
+	blockID, valid := unwrapBlockID(req)
+	if !valid {
+		return nil, status.Error(codes.InvalidArgument, "invalid block ID")
+	}
+
+	block, exists := deps.Protocol.MainEngineInstance().Block(blockID)
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "block %s not found", blockID)
+	}
+
+	return &inx.RawBlock{
+		Data: block.Data(),
+	}, nil
+}
+
+// ReadBlockMetadata returns the metadata of a block of the block cache, or of a block that was already committed.
+func (s *Server) ReadBlockMetadata(_ context.Context, req *inx.BlockId) (*inx.BlockMetadata, error) {
+	blockID, valid := unwrapBlockID(req)
+	if !valid {
+		return nil, status.Error(codes.InvalidArgument, "invalid block ID")
+	}
+
+	engineInstance := deps.Protocol.MainEngineInstance()
+	if block, exists := engineInstance.BlockFromCache(blockID); exists && !block.IsMissing() && !block.IsRootBlock() {
+		return blockMetadata(engineInstance, block), nil
+	}
+
+	block, exists := engineInstance.Block(blockID)
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "block %s not found", blockID)
+	}
+
+	committedMetadata, err := committedBlockMetadata(engineInstance, block)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read metadata of block %s: %s", blockID, err)
+	}
+
+	return committedMetadata, nil
+}
+
+func blockMetadata(engineInstance *engine.Engine, block *blocks.Block) *inx.BlockMetadata {
+	transactionMetadata, exists := engineInstance.Ledger.TransactionMetadataByAttachment(block.ID())
+
+	return newBlockMetadata(block, exists && transactionMetadata.IsAccepted())
+}
+
+// committedBlockMetadata returns the metadata of a block that was evicted from the block cache. Such a block is only
+// kept in the storage if it was accepted before its slot was committed, so it is referenced by the commitment.
+func committedBlockMetadata(engineInstance *engine.Engine, block *model.Block) (*inx.BlockMetadata, error) {
+	metadata := &inx.BlockMetadata{
+		BlockId:                    newBlockID(block.ID()),
+		Parents:                    lo.Map(block.Parents(), newBlockID),
+		Solid:                      true,
+		ReferencedByMilestoneIndex: uint32(block.ID().Index()),
+		LedgerInclusionState:       inx.BlockMetadata_LEDGER_INCLUSION_STATE_NO_TRANSACTION,
+	}
+
+	if transaction, isTransaction := block.Block().Payload.(*iotago.Transaction); isTransaction {
+		transactionID, err := transaction.ID()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to compute transaction ID")
+		}
+
+		committed, err := engineInstance.Ledger.TransactionCommitted(transactionID)
+		if err != nil {
+			return nil, err
+		}
+
+		if committed {
+			metadata.LedgerInclusionState = inx.BlockMetadata_LEDGER_INCLUSION_STATE_INCLUDED
+		} else {
+			metadata.LedgerInclusionState = inx.BlockMetadata_LEDGER_INCLUSION_STATE_CONFLICTING
+			metadata.ConflictReason = inx.BlockMetadata_CONFLICT_REASON_SEMANTIC_VALIDATION_FAILED
+		}
+	}
+
+	return metadata, nil
+}
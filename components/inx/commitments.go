@@ -0,0 +1,93 @@
+package inx
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	inx "github.com/iotaledger/inx/go"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/notarization"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// ReadMilestone returns the commitment of the requested slot, which is either given by its index or its commitment ID.
+func (s *Server) ReadMilestone(_ context.Context, req *inx.MilestoneRequest) (*inx.Milestone, error) {
+	slot := iotago.SlotIndex(req.GetMilestoneIndex())
+
+	commitmentID, hasCommitmentID := unwrapMilestoneID(req.GetMilestoneId())
+	if req.GetMilestoneId() != nil {
+		if !hasCommitmentID {
+			return nil, status.Error(codes.InvalidArgument, "invalid commitment ID")
+		}
+
+		slot = commitmentID.Index()
+	}
+
+	engineInstance := deps.Protocol.MainEngineInstance()
+	if slot > engineInstance.Storage.Settings().LatestCommitment().Index() {
+		return nil, status.Errorf(codes.NotFound, "slot %d is not committed yet", slot)
+	}
+
+	commitment, err := engineInstance.Storage.Commitments().Load(slot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load commitment of slot %d: %s", slot, err)
+	}
+
+	if hasCommitmentID && commitment.ID() != commitmentID {
+		return nil, status.Errorf(codes.NotFound, "commitment %s is not part of the chain of the node", commitmentID)
+	}
+
+	milestone, err := newMilestone(commitment, engineInstance.API().SlotTimeProvider())
+	if err != nil {
+		return nil, status.Error(codes.OutOfRange, err.Error())
+	}
+
+	return milestone, nil
+}
+
+// ListenToLatestMilestones sends the commitments of the main engine as soon as they are created.
+func (s *Server) ListenToLatestMilestones(_ *inx.NoParams, srv inx.INX_ListenToLatestMilestonesServer) error {
+	return streamEvents(srv.Context(), "ListenToLatestMilestones", func(workerPool *workerpool.WorkerPool, abort func(err error)) (unhook func()) {
+		return deps.Protocol.Events.Engine.Notarization.SlotCommitted.Hook(func(details *notarization.SlotCommittedDetails) {
+			milestone, err := newMilestone(details.Commitment, deps.Protocol.API().SlotTimeProvider())
+			if err != nil {
+				abort(err)
+				return
+			}
+
+			if err = srv.Send(milestone); err != nil {
+				abort(err)
+			}
+		}, event.WithWorkerPool(workerPool)).Unhook
+	})
+}
+
+// ListenToConfirmedMilestones sends the commitments of the requested slot range in order.
+func (s *Server) ListenToConfirmedMilestones(req *inx.MilestoneRangeRequest, srv inx.INX_ListenToConfirmedMilestonesServer) error {
+	return streamSlots(srv.Context(), "ListenToConfirmedMilestones", iotago.SlotIndex(req.GetStartMilestoneIndex()), iotago.SlotIndex(req.GetEndMilestoneIndex()), func(engineInstance *engine.Engine, slot iotago.SlotIndex) error {
+		commitment, err := engineInstance.Storage.Commitments().Load(slot)
+		if err != nil {
+			return errors.Wrapf(err, "failed to load commitment of slot %d", slot)
+		}
+
+		milestone, err := newMilestone(commitment, engineInstance.API().SlotTimeProvider())
+		if err != nil {
+			return err
+		}
+
+		protocolParameters, err := newProtocolParameters(slot)
+		if err != nil {
+			return errors.Wrap(err, "failed to encode protocol parameters")
+		}
+
+		return srv.Send(&inx.MilestoneAndProtocolParameters{
+			Milestone:                 milestone,
+			CurrentProtocolParameters: protocolParameters,
+		})
+	})
+}
@@ -0,0 +1,62 @@
+package inx
+
+import (
+	"context"
+
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/hive.go/app"
+	"github.com/iotaledger/iota-core/components/restapi"
+	"github.com/iotaledger/iota-core/pkg/blockissuer"
+	"github.com/iotaledger/iota-core/pkg/daemon"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+)
+
+func init() {
+	Component = &app.Component{
+		Name:     "INX",
+		DepsFunc: func(cDeps dependencies) { deps = cDeps },
+		Params:   params,
+		Provide:  provide,
+		Run:      run,
+		IsEnabled: func(c *dig.Container) bool {
+			return ParamsINX.Enabled
+		},
+	}
+}
+
+var (
+	Component *app.Component
+	deps      dependencies
+)
+
+type dependencies struct {
+	dig.In
+
+	Protocol         *protocol.Protocol
+	BlockIssuer      *blockissuer.BlockIssuer
+	RestRouteManager *restapi.RestRouteManager `optional:"true"`
+	INXServer        *Server
+}
+
+func provide(c *dig.Container) error {
+	return c.Provide(newServer)
+}
+
+func run() error {
+	if err := Component.Daemon().BackgroundWorker(Component.Name, func(ctx context.Context) {
+		Component.LogInfo("Starting INX ... done")
+		deps.INXServer.Start()
+
+		<-ctx.Done()
+		Component.LogInfo("Stopping INX ...")
+
+		deps.INXServer.Stop()
+
+		Component.LogInfo("Stopping INX ... done")
+	}, daemon.PriorityINX); err != nil {
+		Component.LogPanicf("failed to start worker: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package inx
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	inx "github.com/iotaledger/inx/go"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// ReadUnspentOutputs sends all unspent outputs of the latest committed ledger state.
+func (s *Server) ReadUnspentOutputs(_ *inx.NoParams, srv inx.INX_ReadUnspentOutputsServer) error {
+	engineInstance := deps.Protocol.MainEngineInstance()
+	slotTimeProvider := engineInstance.API().SlotTimeProvider()
+	ledgerIndex := uint32(engineInstance.Storage.Settings().LatestCommitment().Index())
+
+	var sendErr error
+	if err := engineInstance.Ledger.ForEachUnspentOutput(func(output *ledgerstate.Output) bool {
+		sendErr = srv.Send(&inx.UnspentOutput{
+			LedgerIndex: ledgerIndex,
+			Output:      newLedgerOutput(output, slotTimeProvider),
+		})
+
+		return sendErr == nil
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to iterate unspent outputs: %s", err)
+	}
+
+	return sendErr
+}
+
+// ListenToLedgerUpdates sends the created and consumed outputs of the committed slots of the requested range in order.
+func (s *Server) ListenToLedgerUpdates(req *inx.MilestoneRangeRequest, srv inx.INX_ListenToLedgerUpdatesServer) error {
+	return streamSlots(srv.Context(), "ListenToLedgerUpdates", iotago.SlotIndex(req.GetStartMilestoneIndex()), iotago.SlotIndex(req.GetEndMilestoneIndex()), func(engineInstance *engine.Engine, slot iotago.SlotIndex) error {
+		diff, err := engineInstance.Ledger.StateDiffs(slot)
+		if err != nil {
+			return errors.Wrapf(err, "failed to load state diff of slot %d", slot)
+		}
+
+		return sendLedgerUpdate(diff, engineInstance.API().SlotTimeProvider(), srv.Send)
+	})
+}
+
+// ReadOutput returns an output that is known to the ledger of the main engine.
+func (s *Server) ReadOutput(_ context.Context, req *inx.OutputId) (*inx.OutputResponse, error) {
+	outputID, valid := unwrapOutputID(req)
+	if !valid {
+		return nil, status.Error(codes.InvalidArgument, "invalid output ID")
+	}
+
+	engineInstance := deps.Protocol.MainEngineInstance()
+
+	output, err := engineInstance.Ledger.Output(outputID.UTXOInput())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "output %s not found: %s", outputID, err)
+	}
+
+	return &inx.OutputResponse{
+		LedgerIndex: uint32(engineInstance.Storage.Settings().LatestCommitment().Index()),
+		Payload: &inx.OutputResponse_Output{
+			Output: newLedgerOutput(output, engineInstance.API().SlotTimeProvider()),
+		},
+	}, nil
+}
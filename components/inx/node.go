@@ -0,0 +1,162 @@
+package inx
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	inx "github.com/iotaledger/inx/go"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/notarization"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func (s *Server) ReadNodeStatus(context.Context, *inx.NoParams) (*inx.NodeStatus, error) {
+	nodeStatus, err := newNodeStatus()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read node status: %s", err)
+	}
+
+	return nodeStatus, nil
+}
+
+// ListenToNodeStatus sends the node status whenever a slot was committed or finalized. Changes that happen within the
+// requested cooldown after the last sent status are skipped.
+func (s *Server) ListenToNodeStatus(req *inx.NodeStatusRequest, srv inx.INX_ListenToNodeStatusServer) error {
+	cooldown := time.Duration(req.GetCooldownInMilliseconds()) * time.Millisecond
+
+	return streamEvents(srv.Context(), "ListenToNodeStatus", func(workerPool *workerpool.WorkerPool, abort func(err error)) (unhook func()) {
+		var lastSent time.Time
+
+		sendNodeStatus := func() {
+			if !lastSent.IsZero() && time.Since(lastSent) < cooldown {
+				return
+			}
+
+			nodeStatus, err := newNodeStatus()
+			if err != nil {
+				abort(status.Errorf(codes.Internal, "failed to read node status: %s", err))
+				return
+			}
+
+			if err = srv.Send(nodeStatus); err != nil {
+				abort(err)
+				return
+			}
+
+			lastSent = time.Now()
+		}
+
+		unhook = lo.Batch(
+			deps.Protocol.Events.Engine.Notarization.SlotCommitted.Hook(func(*notarization.SlotCommittedDetails) {
+				sendNodeStatus()
+			}, event.WithWorkerPool(workerPool)).Unhook,
+			deps.Protocol.Events.Engine.SlotGadget.SlotFinalized.Hook(func(iotago.SlotIndex) {
+				sendNodeStatus()
+			}, event.WithWorkerPool(workerPool)).Unhook,
+		)
+
+		workerPool.Submit(sendNodeStatus)
+
+		return unhook
+	})
+}
+
+func (s *Server) ReadNodeConfiguration(context.Context, *inx.NoParams) (*inx.NodeConfiguration, error) {
+	return &inx.NodeConfiguration{
+		SupportedProtocolVersions: deps.Protocol.SupportedVersions(),
+	}, nil
+}
+
+// ReadProtocolParameters returns the protocol parameters that are valid for the requested slot, which is either given by
+// its index or a commitment ID.
+func (s *Server) ReadProtocolParameters(_ context.Context, req *inx.MilestoneRequest) (*inx.RawProtocolParameters, error) {
+	slot := iotago.SlotIndex(req.GetMilestoneIndex())
+	if req.GetMilestoneId() != nil {
+		commitmentID, hasCommitmentID := unwrapMilestoneID(req.GetMilestoneId())
+		if !hasCommitmentID {
+			return nil, status.Error(codes.InvalidArgument, "invalid commitment ID")
+		}
+
+		slot = commitmentID.Index()
+	}
+
+	protocolParameters, err := newProtocolParameters(slot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode protocol parameters: %s", err)
+	}
+
+	return protocolParameters, nil
+}
+
+func newNodeStatus() (*inx.NodeStatus, error) {
+	engineInstance := deps.Protocol.MainEngineInstance()
+	slotTimeProvider := engineInstance.API().SlotTimeProvider()
+	syncStatus := deps.Protocol.SyncManager.SyncStatus()
+	latestCommitment := engineInstance.Storage.Settings().LatestCommitment()
+
+	// the finalized slot can not be ahead of the latest commitment of the node.
+	finalizedSlot := engineInstance.Storage.Settings().LatestFinalizedSlot()
+	if finalizedSlot > latestCommitment.Index() {
+		finalizedSlot = latestCommitment.Index()
+	}
+
+	finalizedCommitment, err := engineInstance.Storage.Commitments().Load(finalizedSlot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load commitment of finalized slot %d", finalizedSlot)
+	}
+
+	latestMilestone, err := newMilestone(latestCommitment, slotTimeProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	confirmedMilestone, err := newMilestone(finalizedCommitment, slotTimeProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	// the current protocol parameters are the ones that are valid for the slot following the latest commitment.
+	protocolParameters, err := newProtocolParameters(latestCommitment.Index() + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeStatus := &inx.NodeStatus{
+		IsHealthy:                 syncStatus.NodeSynced && deps.Protocol.NextPendingSupported(),
+		IsSynced:                  syncStatus.NodeSynced,
+		IsAlmostSynced:            syncStatus.NodeSynced,
+		LatestMilestone:           latestMilestone,
+		ConfirmedMilestone:        confirmedMilestone,
+		CurrentProtocolParameters: protocolParameters,
+		LedgerIndex:               latestMilestone.GetMilestoneInfo().GetMilestoneIndex(),
+	}
+
+	if lastPrunedSlot, hasPruned := engineInstance.Storage.LastPrunedSlot(); hasPruned {
+		nodeStatus.TanglePruningIndex = uint32(lastPrunedSlot)
+		nodeStatus.LedgerPruningIndex = uint32(lastPrunedSlot)
+	}
+
+	return nodeStatus, nil
+}
+
+// newProtocolParameters returns the encoded protocol parameters that are valid for the given slot.
+func newProtocolParameters(slot iotago.SlotIndex) (*inx.RawProtocolParameters, error) {
+	settings := deps.Protocol.MainEngineInstance().Storage.Settings()
+	protocolParameters := settings.ProtocolParametersForSlot(slot)
+
+	protocolParametersBytes, err := settings.API(slot).JSONEncode(protocolParameters)
+	if err != nil {
+		return nil, err
+	}
+
+	return &inx.RawProtocolParameters{
+		ProtocolVersion: uint32(protocolParameters.Version),
+		Params:          protocolParametersBytes,
+	}, nil
+}
@@ -0,0 +1,48 @@
+package inx
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	inx "github.com/iotaledger/inx/go"
+)
+
+// Server implements the INX gRPC interface that is used by the extensions. Slot commitments take the place of the
+// milestones of the interface, the milestone specific calls (white flag, milestone cones, treasury and receipts) as well
+// as the tip selection and the API request forwarding are not supported.
+type Server struct {
+	inx.UnimplementedINXServer
+
+	grpcServer *grpc.Server
+}
+
+func newServer() *Server {
+	s := &Server{
+		grpcServer: grpc.NewServer(),
+	}
+	inx.RegisterINXServer(s.grpcServer, s)
+
+	return s
+}
+
+// Start starts serving the INX interface on the configured bind address.
+func (s *Server) Start() {
+	go func() {
+		listener, err := net.Listen("tcp", ParamsINX.BindAddress)
+		if err != nil {
+			Component.LogFatalfAndExit("failed to listen on %s: %s", ParamsINX.BindAddress, err)
+		}
+		defer listener.Close()
+
+		if err = s.grpcServer.Serve(listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			Component.LogFatalfAndExit("failed to serve INX: %s", err)
+		}
+	}()
+}
+
+// Stop stops the server and closes all open streams.
+func (s *Server) Stop() {
+	s.grpcServer.Stop()
+}
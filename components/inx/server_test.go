@@ -0,0 +1,51 @@
+package inx
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	inx "github.com/iotaledger/inx/go"
+)
+
+// TestServer_UpstreamClient checks that the server can be used by the clients of the upstream INX interface.
+func TestServer_UpstreamClient(t *testing.T) {
+	listener := bufconn.Listen(1024 * 1024)
+
+	server := newServer()
+	go func() { _ = server.grpcServer.Serve(listener) }()
+	defer server.Stop()
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet", grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return listener.DialContext(ctx)
+	}), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client := inx.NewINXClient(conn)
+
+	// the milestone specific calls are not supported.
+	_, err = client.ComputeWhiteFlag(context.Background(), &inx.WhiteFlagRequest{})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+
+	// the REST API is not available in this test.
+	_, err = client.RegisterAPIRoute(context.Background(), &inx.APIRouteRequest{Route: "indexer/v1", Host: "localhost", Port: 9091})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	_, err = client.UnregisterAPIRoute(context.Background(), &inx.APIRouteRequest{Route: "indexer/v1"})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	// invalid IDs are rejected before the node is accessed.
+	_, err = client.ReadBlock(context.Background(), &inx.BlockId{Id: []byte{1, 2, 3}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.ReadOutput(context.Background(), &inx.OutputId{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
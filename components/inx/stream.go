@@ -0,0 +1,96 @@
+package inx
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/notarization"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// streamEvents hooks to events for the lifetime of a stream. The hooked callbacks are executed in order by a dedicated
+// worker pool and can end the stream by calling abort. It returns once the client closed the stream or the stream was
+// aborted, with the error that was passed to abort.
+func streamEvents(ctx context.Context, name string, hook func(workerPool *workerpool.WorkerPool, abort func(err error)) (unhook func())) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		abortOnce sync.Once
+		abortErr  error
+	)
+
+	abort := func(err error) {
+		abortOnce.Do(func() {
+			abortErr = err
+			cancel()
+		})
+	}
+
+	workerPool := workerpool.New(name, 1).Start()
+	unhook := hook(workerPool, abort)
+
+	<-ctx.Done()
+
+	unhook()
+	workerPool.Shutdown(true).ShutdownComplete.Wait()
+
+	return abortErr
+}
+
+// streamSlots calls send for every committed slot in the requested range in order (a start slot of 0 starts after the
+// latest commitment, an end slot of 0 streams until the client closes the stream). Slots that were committed before the
+// request are sent from storage first, the stream ends after the end slot was sent or if the main engine was switched,
+// as the slots of the new chain might conflict with the slots that were already sent.
+func streamSlots(ctx context.Context, name string, startSlot iotago.SlotIndex, endSlot iotago.SlotIndex, send func(engineInstance *engine.Engine, slot iotago.SlotIndex) error) error {
+	if endSlot != 0 && startSlot > endSlot {
+		return status.Errorf(codes.InvalidArgument, "start slot %d is bigger than end slot %d", startSlot, endSlot)
+	}
+
+	engineInstance := deps.Protocol.MainEngineInstance()
+	if lastPrunedSlot, hasPruned := engineInstance.Storage.LastPrunedSlot(); hasPruned && startSlot != 0 && startSlot <= lastPrunedSlot {
+		return status.Errorf(codes.InvalidArgument, "start slot %d was already pruned (last pruned slot %d)", startSlot, lastPrunedSlot)
+	}
+
+	nextSlot := startSlot
+	if nextSlot == 0 {
+		nextSlot = engineInstance.Storage.Settings().LatestCommitment().Index() + 1
+	}
+
+	return streamEvents(ctx, name, func(workerPool *workerpool.WorkerPool, abort func(err error)) (unhook func()) {
+		sendUntil := func(slot iotago.SlotIndex) {
+			for ; nextSlot <= slot && (endSlot == 0 || nextSlot <= endSlot); nextSlot++ {
+				if err := send(engineInstance, nextSlot); err != nil {
+					abort(err)
+					return
+				}
+			}
+
+			if endSlot != 0 && nextSlot > endSlot {
+				abort(nil)
+			}
+		}
+
+		unhook = lo.Batch(
+			deps.Protocol.Events.Engine.Notarization.SlotCommitted.Hook(func(details *notarization.SlotCommittedDetails) {
+				sendUntil(details.Commitment.Index())
+			}, event.WithWorkerPool(workerPool)).Unhook,
+			deps.Protocol.Events.MainEngineSwitched.Hook(func(*engine.Engine) {
+				abort(status.Error(codes.Unavailable, "main engine was switched"))
+			}).Unhook,
+		)
+
+		// slots that were committed before the hook was registered are sent by the worker pool, to keep them in order.
+		latestCommittedSlot := engineInstance.Storage.Settings().LatestCommitment().Index()
+		workerPool.Submit(func() { sendUntil(latestCommittedSlot) })
+
+		return unhook
+	})
+}
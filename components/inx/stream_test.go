@@ -0,0 +1,42 @@
+package inx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+)
+
+func TestStreamEvents(t *testing.T) {
+	t.Run("abort", func(t *testing.T) {
+		abortErr := errors.New("send failed")
+		unhooked := false
+
+		require.ErrorIs(t, streamEvents(context.Background(), "test", func(workerPool *workerpool.WorkerPool, abort func(err error)) (unhook func()) {
+			workerPool.Submit(func() {
+				abort(abortErr)
+
+				// only the first error is returned.
+				abort(errors.New("second error"))
+			})
+
+			return func() { unhooked = true }
+		}), abortErr)
+		require.True(t, unhooked)
+	})
+
+	t.Run("closed by client", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		unhooked := false
+
+		require.NoError(t, streamEvents(ctx, "test", func(workerPool *workerpool.WorkerPool, abort func(err error)) (unhook func()) {
+			workerPool.Submit(cancel)
+
+			return func() { unhooked = true }
+		}))
+		require.True(t, unhooked)
+	})
+}
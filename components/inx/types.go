@@ -0,0 +1,198 @@
+package inx
+
+import (
+	"math"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/lo"
+	inx "github.com/iotaledger/inx/go"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// The INX interface was designed for milestones, which are replaced by slot commitments: milestone indexes are slot
+// indexes, milestone IDs are commitment IDs, raw milestones are serialized commitments and milestone timestamps are the
+// end times of the slots.
+
+func newBlockID(blockID iotago.BlockID) *inx.BlockId {
+	return &inx.BlockId{
+		Id: blockID[:],
+	}
+}
+
+func unwrapBlockID(blockID *inx.BlockId) (iotago.BlockID, bool) {
+	var id iotago.BlockID
+	if len(blockID.GetId()) != len(id) {
+		return id, false
+	}
+	copy(id[:], blockID.GetId())
+
+	return id, true
+}
+
+func newOutputID(outputID iotago.OutputID) *inx.OutputId {
+	return &inx.OutputId{
+		Id: outputID[:],
+	}
+}
+
+func unwrapOutputID(outputID *inx.OutputId) (iotago.OutputID, bool) {
+	var id iotago.OutputID
+	if len(outputID.GetId()) != len(id) {
+		return id, false
+	}
+	copy(id[:], outputID.GetId())
+
+	return id, true
+}
+
+func newTransactionID(transactionID iotago.TransactionID) *inx.TransactionId {
+	return &inx.TransactionId{
+		Id: transactionID[:],
+	}
+}
+
+func newMilestoneID(commitmentID iotago.CommitmentID) *inx.MilestoneId {
+	return &inx.MilestoneId{
+		Id: commitmentID[:],
+	}
+}
+
+func unwrapMilestoneID(milestoneID *inx.MilestoneId) (iotago.CommitmentID, bool) {
+	var id iotago.CommitmentID
+	if len(milestoneID.GetId()) != len(id) {
+		return id, false
+	}
+	copy(id[:], milestoneID.GetId())
+
+	return id, true
+}
+
+// milestoneIndex converts the given slot index to a milestone index, which only has 32 bits.
+func milestoneIndex(index iotago.SlotIndex) (uint32, error) {
+	if index > math.MaxUint32 {
+		return 0, errors.Errorf("slot %d exceeds the range of milestone indexes", index)
+	}
+
+	return uint32(index), nil
+}
+
+func newMilestone(commitment *model.Commitment, slotTimeProvider *iotago.SlotTimeProvider) (*inx.Milestone, error) {
+	index, err := milestoneIndex(commitment.Index())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to convert commitment %s", commitment.ID())
+	}
+
+	return &inx.Milestone{
+		MilestoneInfo: &inx.MilestoneInfo{
+			MilestoneId:        newMilestoneID(commitment.ID()),
+			MilestoneIndex:     index,
+			MilestoneTimestamp: unixSeconds(slotTimeProvider.EndTime(commitment.Index())),
+		},
+		Milestone: &inx.RawMilestone{
+			Data: commitment.Data(),
+		},
+	}, nil
+}
+
+func newBlock(block *model.Block) *inx.Block {
+	return &inx.Block{
+		BlockId: newBlockID(block.ID()),
+		Block: &inx.RawBlock{
+			Data: block.Data(),
+		},
+	}
+}
+
+// newBlockMetadata converts the metadata of a block of the block cache. Blocks are referenced once they are confirmed,
+// and the ledger inclusion state of a transaction is only known once the transaction was accepted or rejected.
+func newBlockMetadata(block *blocks.Block, transactionAccepted bool) *inx.BlockMetadata {
+	metadata := &inx.BlockMetadata{
+		BlockId:              newBlockID(block.ID()),
+		Parents:              lo.Map(block.Parents(), newBlockID),
+		Solid:                block.IsSolid(),
+		LedgerInclusionState: inx.BlockMetadata_LEDGER_INCLUSION_STATE_NO_TRANSACTION,
+	}
+
+	if block.IsConfirmed() {
+		metadata.ReferencedByMilestoneIndex = uint32(block.ID().Index())
+	}
+
+	if _, isTransaction := block.Transaction(); isTransaction && block.IsConfirmed() {
+		if transactionAccepted {
+			metadata.LedgerInclusionState = inx.BlockMetadata_LEDGER_INCLUSION_STATE_INCLUDED
+		} else {
+			metadata.LedgerInclusionState = inx.BlockMetadata_LEDGER_INCLUSION_STATE_CONFLICTING
+			metadata.ConflictReason = inx.BlockMetadata_CONFLICT_REASON_SEMANTIC_VALIDATION_FAILED
+		}
+	}
+
+	return metadata
+}
+
+func newLedgerOutput(output *ledgerstate.Output, slotTimeProvider *iotago.SlotTimeProvider) *inx.LedgerOutput {
+	return &inx.LedgerOutput{
+		OutputId:                 newOutputID(output.OutputID()),
+		BlockId:                  newBlockID(output.BlockID()),
+		MilestoneIndexBooked:     uint32(output.SlotIndexBooked()),
+		MilestoneTimestampBooked: unixSeconds(slotTimeProvider.EndTime(output.SlotIndexBooked())),
+		Output: &inx.RawOutput{
+			Data: output.Bytes(),
+		},
+	}
+}
+
+func newLedgerSpent(spent *ledgerstate.Spent, slotTimeProvider *iotago.SlotTimeProvider) *inx.LedgerSpent {
+	return &inx.LedgerSpent{
+		Output:                  newLedgerOutput(spent.Output(), slotTimeProvider),
+		TransactionIdSpent:      newTransactionID(spent.TransactionIDSpent()),
+		MilestoneIndexSpent:     uint32(spent.SlotIndexSpent()),
+		MilestoneTimestampSpent: unixSeconds(spent.TimestampSpent()),
+	}
+}
+
+// sendLedgerUpdate sends the changes of a slot as a batch that is enclosed by a BEGIN and an END marker.
+func sendLedgerUpdate(diff *ledgerstate.SlotDiff, slotTimeProvider *iotago.SlotTimeProvider, send func(*inx.LedgerUpdate) error) error {
+	newMarker := func(markerType inx.LedgerUpdate_Marker_MarkerType) *inx.LedgerUpdate {
+		return &inx.LedgerUpdate{
+			Op: &inx.LedgerUpdate_BatchMarker{
+				BatchMarker: &inx.LedgerUpdate_Marker{
+					MilestoneIndex: uint32(diff.Index),
+					MarkerType:     markerType,
+					ConsumedCount:  uint32(len(diff.Spents)),
+					CreatedCount:   uint32(len(diff.Outputs)),
+				},
+			},
+		}
+	}
+
+	if err := send(newMarker(inx.LedgerUpdate_Marker_BEGIN)); err != nil {
+		return err
+	}
+
+	for _, spent := range diff.Spents {
+		if err := send(&inx.LedgerUpdate{Op: &inx.LedgerUpdate_Consumed{Consumed: newLedgerSpent(spent, slotTimeProvider)}}); err != nil {
+			return err
+		}
+	}
+
+	for _, output := range diff.Outputs {
+		if err := send(&inx.LedgerUpdate{Op: &inx.LedgerUpdate_Created{Created: newLedgerOutput(output, slotTimeProvider)}}); err != nil {
+			return err
+		}
+	}
+
+	return send(newMarker(inx.LedgerUpdate_Marker_END))
+}
+
+func unixSeconds(t time.Time) uint32 {
+	if t.Unix() <= 0 {
+		return 0
+	}
+
+	return uint32(t.Unix())
+}
@@ -0,0 +1,129 @@
+package inx
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	inx "github.com/iotaledger/inx/go"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate/tpkg"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestIDConversions(t *testing.T) {
+	blockID := tpkg.RandBlockID()
+	unwrappedBlockID, valid := unwrapBlockID(newBlockID(blockID))
+	require.True(t, valid)
+	require.Equal(t, blockID, unwrappedBlockID)
+
+	outputID := tpkg.RandOutputID(3)
+	unwrappedOutputID, valid := unwrapOutputID(newOutputID(outputID))
+	require.True(t, valid)
+	require.Equal(t, outputID, unwrappedOutputID)
+
+	commitmentID := iotago.NewSlotIdentifier(7, iotago.Identifier{7})
+	unwrappedCommitmentID, valid := unwrapMilestoneID(newMilestoneID(commitmentID))
+	require.True(t, valid)
+	require.Equal(t, commitmentID, unwrappedCommitmentID)
+
+	_, valid = unwrapBlockID(&inx.BlockId{Id: blockID[:len(blockID)-1]})
+	require.False(t, valid)
+
+	_, valid = unwrapOutputID(nil)
+	require.False(t, valid)
+
+	_, valid = unwrapMilestoneID(&inx.MilestoneId{Id: append(commitmentID[:], 0)})
+	require.False(t, valid)
+}
+
+func TestNewMilestone(t *testing.T) {
+	api := tpkg.API()
+
+	commitment, err := model.CommitmentFromCommitment(iotago.NewCommitment(5, iotago.NewEmptyCommitment().MustID(), iotago.Identifier{1}, 10), api)
+	require.NoError(t, err)
+
+	milestone, err := newMilestone(commitment, api.SlotTimeProvider())
+	require.NoError(t, err)
+	require.Equal(t, uint32(5), milestone.GetMilestoneInfo().GetMilestoneIndex())
+	require.Equal(t, uint32(api.SlotTimeProvider().EndTime(5).Unix()), milestone.GetMilestoneInfo().GetMilestoneTimestamp())
+
+	commitmentID, valid := unwrapMilestoneID(milestone.GetMilestoneInfo().GetMilestoneId())
+	require.True(t, valid)
+	require.Equal(t, commitment.ID(), commitmentID)
+
+	decodedCommitment, err := model.CommitmentFromBytes(milestone.GetMilestone().GetData(), api)
+	require.NoError(t, err)
+	require.Equal(t, commitment.ID(), decodedCommitment.ID())
+
+	// slots that do not fit into a milestone index are rejected instead of being truncated.
+	commitment, err = model.CommitmentFromCommitment(iotago.NewCommitment(math.MaxUint32+1, iotago.NewEmptyCommitment().MustID(), iotago.Identifier{1}, 10), api)
+	require.NoError(t, err)
+
+	_, err = newMilestone(commitment, api.SlotTimeProvider())
+	require.Error(t, err)
+}
+
+func TestSendLedgerUpdate(t *testing.T) {
+	slotTimeProvider := tpkg.API().SlotTimeProvider()
+
+	created := ledgerstate.Outputs{tpkg.RandLedgerStateOutput(), tpkg.RandLedgerStateOutput()}
+	consumed := ledgerstate.Spents{tpkg.RandLedgerStateSpent(9, time.Unix(1000, 0))}
+	diff := &ledgerstate.SlotDiff{
+		Index:   9,
+		Outputs: created,
+		Spents:  consumed,
+	}
+
+	var updates []*inx.LedgerUpdate
+	require.NoError(t, sendLedgerUpdate(diff, slotTimeProvider, func(update *inx.LedgerUpdate) error {
+		updates = append(updates, update)
+		return nil
+	}))
+	require.Len(t, updates, 5)
+
+	for i, markerType := range map[int]inx.LedgerUpdate_Marker_MarkerType{0: inx.LedgerUpdate_Marker_BEGIN, 4: inx.LedgerUpdate_Marker_END} {
+		marker := updates[i].GetBatchMarker()
+		require.NotNil(t, marker)
+		require.Equal(t, markerType, marker.GetMarkerType())
+		require.Equal(t, uint32(9), marker.GetMilestoneIndex())
+		require.Equal(t, uint32(1), marker.GetConsumedCount())
+		require.Equal(t, uint32(2), marker.GetCreatedCount())
+	}
+
+	spent := updates[1].GetConsumed()
+	require.NotNil(t, spent)
+	spentOutputID, valid := unwrapOutputID(spent.GetOutput().GetOutputId())
+	require.True(t, valid)
+	require.Equal(t, consumed[0].OutputID(), spentOutputID)
+	require.Equal(t, uint32(9), spent.GetMilestoneIndexSpent())
+	require.Equal(t, uint32(1000), spent.GetMilestoneTimestampSpent())
+
+	for i, output := range created {
+		ledgerOutput := updates[2+i].GetCreated()
+		require.NotNil(t, ledgerOutput)
+
+		outputID, valid := unwrapOutputID(ledgerOutput.GetOutputId())
+		require.True(t, valid)
+		require.Equal(t, output.OutputID(), outputID)
+		require.Equal(t, uint32(output.SlotIndexBooked()), ledgerOutput.GetMilestoneIndexBooked())
+		require.Equal(t, output.Bytes(), ledgerOutput.GetOutput().GetData())
+	}
+
+	// the batch is aborted as soon as sending fails.
+	sendErr := errors.New("stream closed")
+	sentUpdates := 0
+	require.ErrorIs(t, sendLedgerUpdate(diff, slotTimeProvider, func(*inx.LedgerUpdate) error {
+		sentUpdates++
+		if sentUpdates == 2 {
+			return sendErr
+		}
+
+		return nil
+	}), sendErr)
+	require.Equal(t, 2, sentUpdates)
+}
@@ -34,6 +34,9 @@ func init() {
 	}
 }
 
+// manualPeersRealm is the realm of the peer database in which the peers that were added at runtime are stored.
+const manualPeersRealm = "manual:"
+
 var (
 	Component *app.Component
 	deps      dependencies
@@ -55,12 +58,19 @@ func provide(c *dig.Container) error {
 	type manualPeeringDeps struct {
 		dig.In
 
-		LocalPeer  *peer.Local
-		P2PManager *p2p.Manager
+		LocalPeer     *peer.Local
+		P2PManager    *p2p.Manager
+		PeerDBKVSTore kvstore.KVStore `name:"peerDBKVStore"`
 	}
 
 	if err := c.Provide(func(deps manualPeeringDeps) *manualpeering.Manager {
-		return manualpeering.NewManager(deps.P2PManager, deps.LocalPeer, Component.WorkerPool, Component.Logger())
+		// the peers that are added at runtime are stored next to the peer database, so they survive a restart.
+		persistentPeersStore, err := deps.PeerDBKVSTore.WithRealm([]byte(manualPeersRealm))
+		if err != nil {
+			Component.LogFatalfAndExit("unable to create store for manual peers: %s", err)
+		}
+
+		return manualpeering.NewManager(deps.P2PManager, deps.LocalPeer, Component.WorkerPool, Component.Logger(), manualpeering.WithPersistentPeersStore(persistentPeersStore))
 	}); err != nil {
 		return err
 	}
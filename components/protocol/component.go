@@ -2,6 +2,7 @@ package protocol
 
 import (
 	"context"
+	"os"
 	"time"
 
 	"go.uber.org/dig"
@@ -10,20 +11,28 @@ import (
 	"github.com/iotaledger/hive.go/autopeering/peer"
 	hivedb "github.com/iotaledger/hive.go/kvstore/database"
 	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/runtime/module"
 	"github.com/iotaledger/hive.go/runtime/workerpool"
 	"github.com/iotaledger/iota-core/pkg/daemon"
 	"github.com/iotaledger/iota-core/pkg/model"
 	"github.com/iotaledger/iota-core/pkg/network"
 	"github.com/iotaledger/iota-core/pkg/network/p2p"
 	"github.com/iotaledger/iota-core/pkg/protocol"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/congestioncontrol/scheduler/drr"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/filter"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/filter/blockfilter"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/notarization"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/notarization/slotnotarization"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/orphanage"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/sybilprotection"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/sybilprotection/poa"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/sybilprotection/pos"
+	"github.com/iotaledger/iota-core/pkg/protocol/tipmanager/conflicttipmanager"
 	"github.com/iotaledger/iota-core/pkg/storage"
 	"github.com/iotaledger/iota-core/pkg/storage/database"
+	"github.com/iotaledger/iota-core/pkg/storage/permanent"
 	"github.com/iotaledger/iota-core/pkg/storage/prunable"
 	iotago "github.com/iotaledger/iota.go/v4"
 )
@@ -85,12 +94,6 @@ func provide(c *dig.Container) error {
 	}
 
 	return c.Provide(func(deps protocolDeps) *protocol.Protocol {
-		validators := make(map[iotago.AccountID]int64)
-		for _, validator := range ParamsProtocol.SybilProtection.Committee {
-			hex := lo.PanicOnErr(iotago.DecodeHex(validator.Identity))
-			validators[iotago.AccountID(hex[:])] = validator.Weight
-		}
-
 		return protocol.New(
 			workerpool.NewGroup("Protocol"),
 			deps.P2PManager,
@@ -104,14 +107,30 @@ func provide(c *dig.Container) error {
 				),
 			),
 			protocol.WithSnapshotPath(ParamsProtocol.Snapshot.Path),
-			protocol.WithSybilProtectionProvider(
-				poa.NewProvider(validators),
+			protocol.WithProtocolUpgrades(protocolUpgrades()...),
+			protocol.WithEngineOptions(
+				engine.WithOrphanageOptions(
+					orphanage.WithAcceptanceHorizon(ParamsProtocol.Orphanage.AcceptanceHorizon),
+					orphanage.WithMaxUnreferencedTime(ParamsProtocol.Orphanage.MaxUnreferencedTime),
+				),
 			),
+			protocol.WithSybilProtectionProvider(sybilProtectionProvider()),
 			protocol.WithNotarizationProvider(
 				slotnotarization.NewProvider(
 					slotnotarization.WithMinCommittableSlotAge(iotago.SlotIndex(ParamsProtocol.Notarization.MinSlotCommittableAge)),
 				),
 			),
+			protocol.WithSchedulerProvider(
+				drr.NewProvider(
+					drr.WithRate(ParamsProtocol.Scheduler.Rate),
+					drr.WithMaxBufferSize(ParamsProtocol.Scheduler.MaxBufferSize),
+				),
+			),
+			protocol.WithTipManagerProvider(
+				conflicttipmanager.NewProvider(
+					conflicttipmanager.WithTimeSinceConfirmationThreshold(ParamsProtocol.TipManager.TimeSinceConfirmationThreshold),
+				),
+			),
 			protocol.WithFilterProvider(
 				blockfilter.NewProvider(
 					blockfilter.WithMinCommittableSlotAge(iotago.SlotIndex(ParamsProtocol.Notarization.MinSlotCommittableAge)),
@@ -164,6 +183,10 @@ func configure() error {
 		Component.LogInfof("BlockConfirmed: %s", block.ID())
 	})
 
+	deps.Protocol.Events.Engine.Orphanage.BlockOrphaned.Hook(func(block *blocks.Block) {
+		Component.LogInfof("BlockOrphaned: %s", block.ID())
+	})
+
 	deps.Protocol.Events.Engine.Clock.AcceptedTimeUpdated.Hook(func(time time.Time) {
 		Component.LogInfof("AcceptedTimeUpdated: Slot %d @ %s", deps.Protocol.API().SlotTimeProvider().IndexFromTime(time), time.String())
 	})
@@ -208,3 +231,39 @@ func run() error {
 		deps.Protocol.Shutdown()
 	}, daemon.PriorityProtocol)
 }
+
+func protocolUpgrades() []permanent.ProtocolUpgrade {
+	upgrades := make([]permanent.ProtocolUpgrade, 0, len(ParamsProtocol.ProtocolUpgrades))
+	for _, upgrade := range ParamsProtocol.ProtocolUpgrades {
+		parametersBytes, err := os.ReadFile(upgrade.ParametersPath)
+		if err != nil {
+			Component.LogPanicf("failed to read protocol parameters of upgrade at epoch %d: %s", upgrade.StartEpoch, err)
+		}
+
+		var parameters iotago.ProtocolParameters
+		if err = iotago.V3API(&parameters).JSONDecode(parametersBytes, &parameters); err != nil {
+			Component.LogPanicf("failed to parse protocol parameters of upgrade at epoch %d: %s", upgrade.StartEpoch, err)
+		}
+
+		upgrades = append(upgrades, permanent.ProtocolUpgrade{
+			StartEpoch: upgrade.StartEpoch,
+			Parameters: parameters,
+		})
+	}
+
+	return upgrades
+}
+
+func sybilProtectionProvider() module.Provider[*engine.Engine, sybilprotection.SybilProtection] {
+	if ParamsProtocol.SybilProtection.ProofOfStake.Enabled {
+		return pos.NewProvider()
+	}
+
+	validators := make(map[iotago.AccountID]int64)
+	for _, validator := range ParamsProtocol.SybilProtection.Committee {
+		hex := lo.PanicOnErr(iotago.DecodeHex(validator.Identity))
+		validators[iotago.AccountID(hex[:])] = validator.Weight
+	}
+
+	return poa.NewProvider(validators)
+}
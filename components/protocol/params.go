@@ -26,8 +26,36 @@ type ParametersProtocol struct {
 		MaxAllowedClockDrift time.Duration `default:"5s" usage:"the maximum drift our wall clock can have to future blocks being received from the network"`
 	}
 
+	Scheduler struct {
+		// Rate defines the interval at which blocks are scheduled.
+		Rate time.Duration `default:"5ms" usage:"the interval at which blocks are scheduled"`
+		// MaxBufferSize defines the maximum amount of blocks that are buffered before the scheduler starts to drop blocks.
+		MaxBufferSize int `default:"300" usage:"the maximum amount of blocks that are buffered before the scheduler starts to drop blocks"`
+	}
+
+	TipManager struct {
+		// TimeSinceConfirmationThreshold defines the maximum age of the accepted past cone of a tip relative to the accepted time.
+		TimeSinceConfirmationThreshold time.Duration `default:"1m" usage:"the maximum age of the accepted past cone of a tip relative to the accepted time"`
+	}
+
+	Orphanage struct {
+		// AcceptanceHorizon defines the maximum age of a block relative to the accepted time after which it is orphaned if it was not accepted.
+		AcceptanceHorizon time.Duration `default:"1m" usage:"the maximum age of a block relative to the accepted time after which it is orphaned if it was not accepted"`
+		// MaxUnreferencedTime defines the maximum age of a block relative to the accepted time after which it is orphaned if it was not referenced.
+		MaxUnreferencedTime time.Duration `default:"30s" usage:"the maximum age of a block relative to the accepted time after which it is orphaned if it was not referenced"`
+	}
+
+	// ProtocolUpgrades contains the protocol upgrades that are scheduled when the node starts.
+	ProtocolUpgrades ProtocolUpgrades `noflag:"true"`
+
 	SybilProtection struct {
 		Committee Validators `noflag:"true"`
+
+		// ProofOfStake contains the configuration parameters of the proof of stake based sybil protection.
+		ProofOfStake struct {
+			// Enabled defines whether the committee is derived from the stake in the ledger instead of the static committee.
+			Enabled bool `default:"false" usage:"whether the committee is derived from the stake in the ledger instead of the static committee"`
+		}
 	}
 }
 
@@ -38,6 +66,13 @@ type Validator struct {
 
 type Validators []*Validator
 
+type ProtocolUpgrade struct {
+	StartEpoch     uint64 `usage:"the epoch from which on the protocol parameters are valid"`
+	ParametersPath string `usage:"the path to the JSON file containing the protocol parameters"`
+}
+
+type ProtocolUpgrades []*ProtocolUpgrade
+
 // ParametersDatabase contains the definition of configuration parameters used by the storage layer.
 type ParametersDatabase struct {
 	Engine           string `default:"rocksdb" usage:"the used database engine (rocksdb/mapdb)"`
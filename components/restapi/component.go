@@ -16,6 +16,7 @@ import (
 	"github.com/iotaledger/inx-app/pkg/httpserver"
 	"github.com/iotaledger/iota-core/pkg/daemon"
 	"github.com/iotaledger/iota-core/pkg/jwt"
+	"github.com/iotaledger/iota-core/pkg/protocol"
 )
 
 func init() {
@@ -46,6 +47,7 @@ type dependencies struct {
 	RestAPIBindAddress string         `name:"restAPIBindAddress"`
 	NodePrivateKey     crypto.PrivKey `name:"nodePrivateKey"`
 	RestRouteManager   *RestRouteManager
+	Protocol           *protocol.Protocol
 }
 
 func initConfigParams(c *dig.Container) error {
@@ -21,7 +21,10 @@ type RoutesResponse struct {
 func setupRoutes() {
 
 	deps.Echo.GET(nodeAPIHealthRoute, func(c echo.Context) error {
-		// TODO: health check
+		if !deps.Protocol.SyncManager.IsNodeSynced() || !deps.Protocol.NextPendingSupported() {
+			return c.NoContent(http.StatusServiceUnavailable)
+		}
+
 		return c.NoContent(http.StatusOK)
 	})
 
@@ -60,6 +60,10 @@ func (i *BlockIssuer) Shutdown() {
 func (i *BlockIssuer) CreateBlock(ctx context.Context, opts ...options.Option[BlockParams]) (*model.Block, error) {
 	blockParams := options.Apply(&BlockParams{}, opts)
 
+	if !i.protocol.NextPendingSupported() {
+		return nil, errors.WithMessage(ErrBlockAttacherAttachingNotPossible, "node does not support the upcoming protocol upgrade")
+	}
+
 	if blockParams.slotCommitment == nil {
 		blockParams.slotCommitment = i.protocol.MainEngineInstance().Storage.Settings().LatestCommitment().Commitment()
 	}
@@ -86,8 +90,15 @@ func (i *BlockIssuer) CreateBlock(ctx context.Context, opts ...options.Option[Bl
 		blockParams.issuer = NewEd25519Account(i.Account.ID(), i.Account.PrivateKey())
 	}
 
+	protoParams := i.protocolParametersForIssuingTime(*blockParams.issuingTime)
+
+	if blockParams.protocolVersion == nil {
+		protocolVersion := protoParams.Version
+		blockParams.protocolVersion = &protocolVersion
+	}
+
 	if blockParams.proofOfWorkDifficulty == nil {
-		powDifficulty := float64(i.protocol.MainEngineInstance().Storage.Settings().ProtocolParameters().MinPoWScore)
+		powDifficulty := float64(protoParams.MinPoWScore)
 		blockParams.proofOfWorkDifficulty = &powDifficulty
 	}
 
@@ -97,9 +108,7 @@ func (i *BlockIssuer) CreateBlock(ctx context.Context, opts ...options.Option[Bl
 
 	blockBuilder := builder.NewBlockBuilder()
 
-	if blockParams.protocolVersion != nil {
-		blockBuilder.ProtocolVersion(*blockParams.protocolVersion)
-	}
+	blockBuilder.ProtocolVersion(*blockParams.protocolVersion)
 
 	blockBuilder.Payload(blockParams.payload)
 
@@ -176,9 +185,19 @@ func (i *BlockIssuer) IssueBlockAndAwaitEvent(ctx context.Context, block *model.
 }
 
 func (i *BlockIssuer) AttachBlock(ctx context.Context, iotaBlock *iotago.Block) (iotago.BlockID, error) {
+	if !i.protocol.NextPendingSupported() {
+		return iotago.EmptyBlockID(), errors.WithMessage(ErrBlockAttacherAttachingNotPossible, "node does not support the upcoming protocol upgrade")
+	}
+
 	// if anything changes, need to make a new signature
 	var resign bool
-	protoParams := i.protocol.MainEngineInstance().Storage.Settings().ProtocolParameters()
+
+	if iotaBlock.IssuingTime.IsZero() {
+		iotaBlock.IssuingTime = time.Now()
+		resign = true
+	}
+
+	protoParams := i.protocolParametersForIssuingTime(iotaBlock.IssuingTime)
 	targetScore := protoParams.MinPoWScore
 
 	if iotaBlock.ProtocolVersion != protoParams.Version {
@@ -190,11 +209,6 @@ func (i *BlockIssuer) AttachBlock(ctx context.Context, iotaBlock *iotago.Block)
 		resign = true
 	}
 
-	if iotaBlock.IssuingTime.IsZero() {
-		iotaBlock.IssuingTime = time.Now()
-		resign = true
-	}
-
 	if iotaBlock.SlotCommitment == nil {
 		iotaBlock.SlotCommitment = i.protocol.MainEngineInstance().Storage.Settings().LatestCommitment().Commitment()
 		iotaBlock.LatestFinalizedSlot = i.protocol.MainEngineInstance().Storage.Settings().LatestFinalizedSlot()
@@ -312,6 +326,13 @@ func (i *BlockIssuer) validateReferences(issuingTime time.Time, slotCommitmentIn
 	return nil
 }
 
+// protocolParametersForIssuingTime returns the protocol parameters that are valid for the slot of the given issuing time.
+func (i *BlockIssuer) protocolParametersForIssuingTime(issuingTime time.Time) *iotago.ProtocolParameters {
+	settings := i.protocol.MainEngineInstance().Storage.Settings()
+
+	return settings.ProtocolParametersForSlot(settings.CurrentAPI().SlotTimeProvider().IndexFromTime(issuingTime))
+}
+
 func (i *BlockIssuer) issueBlock(block *model.Block) error {
 	if err := i.protocol.ProcessOwnBlock(block); err != nil {
 		return err
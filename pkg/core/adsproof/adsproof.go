@@ -0,0 +1,48 @@
+// Package adsproof creates and verifies Merkle proofs for the authenticated data structures (ads.Map and ads.Set)
+// whose roots are committed to in the commitments.
+package adsproof
+
+import (
+	"github.com/celestiaorg/smt"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/iotaledger/hive.go/ads"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/lo"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// Prove returns the non-empty side nodes of the Merkle path of the given key in the authenticated data structure that
+// is persisted in the given store and has the given root.
+func Prove(store kvstore.KVStore, root iotago.Identifier, key []byte) (sideNodes []iotago.Identifier, err error) {
+	// the authenticated data structures do not expose proofs, so we access the sparse merkle tree that backs them.
+	tree := smt.ImportSparseMerkleTree(
+		lo.PanicOnErr(store.WithExtendedRealm([]byte{ads.PrefixSMTKeysStorage})),
+		lo.PanicOnErr(store.WithExtendedRealm([]byte{ads.PrefixSMTValuesStorage})),
+		lo.PanicOnErr(blake2b.New256(nil)),
+		root[:],
+	)
+
+	proof, err := tree.Prove(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create proof")
+	}
+
+	sideNodes = make([]iotago.Identifier, len(proof.SideNodes))
+	for i, sideNode := range proof.SideNodes {
+		copy(sideNodes[i][:], sideNode)
+	}
+
+	return sideNodes, nil
+}
+
+// Verify checks that the given key is stored with the given value in the authenticated data structure with the given
+// root.
+func Verify(sideNodes []iotago.Identifier, root iotago.Identifier, key []byte, value []byte) bool {
+	proof := smt.SparseMerkleProof{
+		SideNodes: lo.Map(sideNodes, func(sideNode iotago.Identifier) []byte { return lo.CopySlice(sideNode[:]) }),
+	}
+
+	return smt.VerifyProof(proof, root[:], key, value, lo.PanicOnErr(blake2b.New256(nil)))
+}
@@ -9,9 +9,12 @@ const (
 	PriorityP2P
 	PriorityManualPeering
 	PriorityProtocol
+	PriorityIndexer // depends on Protocol
 	PriorityBlockIssuer
-	PriorityActivity // depends on BlockIssuer
-	PriorityRestAPI  // depends on PriorityPoWHandler
+	PriorityActivity    // depends on BlockIssuer
+	PriorityRestAPI     // depends on PriorityPoWHandler
+	PriorityEventStream // depends on Protocol and RestAPI
+	PriorityINX         // depends on Protocol, BlockIssuer and RestAPI
 	PriorityDashboardMetrics
 	PriorityDashboard
 )
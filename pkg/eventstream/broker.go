@@ -0,0 +1,164 @@
+package eventstream
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// ErrSlowConsumer is returned by a Subscription that was closed because it did not keep up with the published events.
+var ErrSlowConsumer = errors.New("subscription was closed because the consumer is too slow")
+
+// Broker distributes the published events to the subscriptions with matching topic filters.
+type Broker struct {
+	subscriptions      map[uint64]*Subscription
+	nextSubscriptionID uint64
+	mutex              sync.RWMutex
+}
+
+// NewBroker creates a new Broker.
+func NewBroker() *Broker {
+	return &Broker{
+		subscriptions: make(map[uint64]*Subscription),
+	}
+}
+
+// Subscribe creates a new Subscription for the given topic filters that buffers up to bufferSize events.
+func (b *Broker) Subscribe(filters []TopicFilter, bufferSize int) *Subscription {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	subscription := newSubscription(b, b.nextSubscriptionID, filters, bufferSize)
+	b.subscriptions[subscription.id] = subscription
+	b.nextSubscriptionID++
+
+	return subscription
+}
+
+// Publish delivers the given event to all subscriptions that are interested in its topic. Instead of silently dropping
+// events, subscriptions whose buffer is full are closed with ErrSlowConsumer.
+func (b *Broker) Publish(event *Event) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	for _, subscription := range b.subscriptions {
+		if subscription.matches(event.Topic) {
+			subscription.deliver(event)
+		}
+	}
+}
+
+// HasSubscribers returns true if there is at least one subscription for the given topic.
+func (b *Broker) HasSubscribers(topic string) bool {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	for _, subscription := range b.subscriptions {
+		if subscription.matches(topic) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SubscriptionCount returns the amount of active subscriptions.
+func (b *Broker) SubscriptionCount() int {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	return len(b.subscriptions)
+}
+
+// Shutdown closes all subscriptions.
+func (b *Broker) Shutdown() {
+	b.mutex.RLock()
+	subscriptions := make([]*Subscription, 0, len(b.subscriptions))
+	for _, subscription := range b.subscriptions {
+		subscriptions = append(subscriptions, subscription)
+	}
+	b.mutex.RUnlock()
+
+	for _, subscription := range subscriptions {
+		subscription.Close()
+	}
+}
+
+func (b *Broker) unsubscribe(subscription *Subscription) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	delete(b.subscriptions, subscription.id)
+}
+
+// Subscription is a subscription of a consumer to a set of topics.
+type Subscription struct {
+	id      uint64
+	broker  *Broker
+	filters []TopicFilter
+	events  chan *Event
+	done    chan struct{}
+	err     error
+
+	closeOnce sync.Once
+}
+
+func newSubscription(broker *Broker, id uint64, filters []TopicFilter, bufferSize int) *Subscription {
+	return &Subscription{
+		id:      id,
+		broker:  broker,
+		filters: filters,
+		events:  make(chan *Event, bufferSize),
+		done:    make(chan struct{}),
+	}
+}
+
+// Events returns the channel that the events of the subscription are delivered on.
+func (s *Subscription) Events() <-chan *Event {
+	return s.events
+}
+
+// Done returns a channel that is closed once the subscription was closed.
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err returns the reason why the subscription was closed by the broker (nil if it was closed by the consumer).
+func (s *Subscription) Err() error {
+	<-s.done
+
+	return s.err
+}
+
+// Close closes the subscription.
+func (s *Subscription) Close() {
+	s.close(nil)
+}
+
+func (s *Subscription) matches(topic string) bool {
+	for _, filter := range s.filters {
+		if filter.Matches(topic) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Subscription) deliver(event *Event) {
+	select {
+	case <-s.done:
+	case s.events <- event:
+	default:
+		go s.close(ErrSlowConsumer)
+	}
+}
+
+func (s *Subscription) close(err error) {
+	s.closeOnce.Do(func() {
+		s.err = err
+		close(s.done)
+
+		s.broker.unsubscribe(s)
+	})
+}
@@ -0,0 +1,51 @@
+package eventstream
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestBroker(t *testing.T) {
+	broker := NewBroker()
+
+	transactionID := iotago.TransactionID{1}
+
+	blocks := broker.Subscribe([]TopicFilter{TopicBlockAccepted, TopicBlockConfirmed}, 10)
+	transactions := broker.Subscribe([]TopicFilter{"transactions/*"}, 10)
+	require.Equal(t, 2, broker.SubscriptionCount())
+	require.True(t, broker.HasSubscribers(TopicTransaction(transactionID)))
+	require.False(t, broker.HasSubscribers(TopicSlotCommitted))
+	require.True(t, TopicFilter("*").MatchesPrefix(TopicPrefixAddressOutputs))
+	require.True(t, TopicFilter(TopicAddressOutputs("rms1")).MatchesPrefix(TopicPrefixAddressOutputs))
+	require.False(t, TopicFilter("transactions/*").MatchesPrefix(TopicPrefixAddressOutputs))
+
+	broker.Publish(NewEvent(TopicBlockAccepted, 1, nil))
+	broker.Publish(NewEvent(TopicTransaction(transactionID), 2, nil))
+	broker.Publish(NewEvent(TopicSlotCommitted, 3, nil))
+
+	require.Equal(t, TopicBlockAccepted, (<-blocks.Events()).Topic)
+	require.Equal(t, TopicTransaction(transactionID), (<-transactions.Events()).Topic)
+	require.Len(t, blocks.Events(), 0)
+	require.Len(t, transactions.Events(), 0)
+
+	blocks.Close()
+	require.NoError(t, blocks.Err())
+	require.Equal(t, 1, broker.SubscriptionCount())
+
+	// subscriptions that do not keep up with the published events are closed instead of silently dropping events.
+	for i := 0; i < 11; i++ {
+		broker.Publish(NewEvent(TopicTransaction(transactionID), iotago.SlotIndex(i), nil))
+	}
+	require.ErrorIs(t, transactions.Err(), ErrSlowConsumer)
+	require.Eventually(t, func() bool { return broker.SubscriptionCount() == 0 }, time.Second, 10*time.Millisecond)
+
+	// all subscriptions are closed when the broker is shut down.
+	subscription := broker.Subscribe([]TopicFilter{"*"}, 10)
+	broker.Shutdown()
+	require.NoError(t, subscription.Err())
+	require.Equal(t, 0, broker.SubscriptionCount())
+}
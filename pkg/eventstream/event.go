@@ -0,0 +1,24 @@
+package eventstream
+
+import (
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// Event is a message that is published to the subscribers of a topic.
+type Event struct {
+	// Topic is the topic the event was published on.
+	Topic string `json:"topic"`
+	// Slot is the slot the event refers to.
+	Slot iotago.SlotIndex `json:"slot"`
+	// Payload contains the topic specific data of the event.
+	Payload any `json:"payload"`
+}
+
+// NewEvent creates a new Event.
+func NewEvent(topic string, slot iotago.SlotIndex, payload any) *Event {
+	return &Event{
+		Topic:   topic,
+		Slot:    slot,
+		Payload: payload,
+	}
+}
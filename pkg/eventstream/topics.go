@@ -0,0 +1,62 @@
+package eventstream
+
+import (
+	"strings"
+
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+const (
+	// TopicBlockAccepted is the topic of the blocks that were accepted.
+	TopicBlockAccepted = "blocks/accepted"
+
+	// TopicBlockConfirmed is the topic of the blocks that were confirmed.
+	TopicBlockConfirmed = "blocks/confirmed"
+
+	// TopicSlotCommitted is the topic of the slots that were committed.
+	TopicSlotCommitted = "slots/committed"
+
+	// TopicSlotFinalized is the topic of the slots that were finalized.
+	TopicSlotFinalized = "slots/finalized"
+
+	// TopicPrefixTransaction is the prefix of the topics of the state changes of a transaction.
+	TopicPrefixTransaction = "transactions/"
+
+	// TopicPrefixAddressOutputs is the prefix of the topics of the outputs that were created or spent on an address.
+	TopicPrefixAddressOutputs = "addresses/"
+
+	// topicWildcard is the suffix of a topic filter that matches all topics that start with the given prefix.
+	topicWildcard = "*"
+)
+
+// TopicTransaction returns the topic of the state changes of the transaction with the given ID.
+func TopicTransaction(transactionID iotago.TransactionID) string {
+	return TopicPrefixTransaction + transactionID.ToHex()
+}
+
+// TopicAddressOutputs returns the topic of the outputs that were created or spent on the given bech32 address.
+func TopicAddressOutputs(bech32Address string) string {
+	return TopicPrefixAddressOutputs + bech32Address + "/outputs"
+}
+
+// TopicFilter is a filter for topics that either matches a topic exactly or, if it ends with a wildcard, matches all
+// topics that start with the given prefix.
+type TopicFilter string
+
+// Matches returns true if the given topic matches the filter.
+func (t TopicFilter) Matches(topic string) bool {
+	if prefix, isWildcard := strings.CutSuffix(string(t), topicWildcard); isWildcard {
+		return strings.HasPrefix(topic, prefix)
+	}
+
+	return string(t) == topic
+}
+
+// MatchesPrefix returns true if the filter matches at least some of the topics that start with the given prefix.
+func (t TopicFilter) MatchesPrefix(prefix string) bool {
+	if filterPrefix, isWildcard := strings.CutSuffix(string(t), topicWildcard); isWildcard {
+		return strings.HasPrefix(prefix, filterPrefix) || strings.HasPrefix(filterPrefix, prefix)
+	}
+
+	return strings.HasPrefix(string(t), prefix)
+}
@@ -0,0 +1,76 @@
+package indexer
+
+import (
+	"github.com/iotaledger/hive.go/serializer/v2/byteutils"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// Filter defines the criteria that the unspent outputs returned by a query need to match.
+type Filter struct {
+	// Address filters outputs that can be unlocked by the given address.
+	Address iotago.Address
+	// OutputType filters outputs of the given type.
+	OutputType *iotago.OutputType
+	// HasStorageDepositReturn filters outputs with (or without) a storage deposit return unlock condition.
+	HasStorageDepositReturn *bool
+	// HasExpiration filters outputs with (or without) an expiration unlock condition.
+	HasExpiration *bool
+	// HasTimelock filters outputs with (or without) a timelock unlock condition.
+	HasTimelock *bool
+	// Cursor is the ID of the first output of the requested page.
+	Cursor *iotago.OutputID
+	// PageSize is the maximum amount of outputs that are returned (0 means unlimited).
+	PageSize int
+}
+
+// Result contains the result of a query.
+type Result struct {
+	// LedgerIndex is the index of the slot that the result is based on.
+	LedgerIndex iotago.SlotIndex
+	// OutputIDs are the IDs of the outputs that matched the filter.
+	OutputIDs iotago.OutputIDs
+	// Cursor is the ID of the first output of the next page (nil if there are no more results).
+	Cursor *iotago.OutputID
+}
+
+// indexPrefix returns the prefix of the most selective index that can be used to answer the query.
+func (f *Filter) indexPrefix() []byte {
+	switch {
+	case f.Address != nil:
+		return byteutils.ConcatBytes([]byte{storeKeyPrefixAddress}, []byte(f.Address.Key()))
+	case f.HasStorageDepositReturn != nil && *f.HasStorageDepositReturn:
+		return []byte{storeKeyPrefixUnlockCondition, byte(iotago.UnlockConditionStorageDepositReturn)}
+	case f.HasTimelock != nil && *f.HasTimelock:
+		return []byte{storeKeyPrefixUnlockCondition, byte(iotago.UnlockConditionTimelock)}
+	case f.HasExpiration != nil && *f.HasExpiration:
+		return []byte{storeKeyPrefixUnlockCondition, byte(iotago.UnlockConditionExpiration)}
+	case f.OutputType != nil:
+		return []byte{storeKeyPrefixOutputType, byte(*f.OutputType)}
+	default:
+		return []byte{storeKeyPrefixOutput}
+	}
+}
+
+func (f *Filter) matches(record *outputRecord) bool {
+	if f.Address != nil && !record.hasAddressKey(f.Address.Key()) {
+		return false
+	}
+
+	if f.OutputType != nil && record.outputType != *f.OutputType {
+		return false
+	}
+
+	if f.HasStorageDepositReturn != nil && record.hasUnlockCondition(iotago.UnlockConditionStorageDepositReturn) != *f.HasStorageDepositReturn {
+		return false
+	}
+
+	if f.HasTimelock != nil && record.hasUnlockCondition(iotago.UnlockConditionTimelock) != *f.HasTimelock {
+		return false
+	}
+
+	if f.HasExpiration != nil && record.hasUnlockCondition(iotago.UnlockConditionExpiration) != *f.HasExpiration {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,250 @@
+package indexer
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/serializer/v2/byteutils"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+const (
+	storeKeyPrefixLedgerIndex byte = iota
+	storeKeyPrefixOutput
+	storeKeyPrefixAddress
+	storeKeyPrefixUnlockCondition
+	storeKeyPrefixOutputType
+)
+
+// ErrNotSynced is returned if the index was not built yet.
+var ErrNotSynced = errors.New("indexer is not synced")
+
+// Indexer keeps an index of the unspent outputs of the ledger by address, by unlock condition and by output type.
+type Indexer struct {
+	store kvstore.KVStore
+	mutex sync.RWMutex
+}
+
+// New creates a new Indexer that stores its index in the given store.
+func New(store kvstore.KVStore) *Indexer {
+	return &Indexer{
+		store: store,
+	}
+}
+
+// LedgerIndex returns the index of the slot that the index is based on.
+func (i *Indexer) LedgerIndex() (ledgerIndex iotago.SlotIndex, isSynced bool, err error) {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+
+	return i.ledgerIndex()
+}
+
+// Rebuild clears the index and rebuilds it from the given unspent outputs that belong to the given ledger index.
+func (i *Indexer) Rebuild(ledgerIndex iotago.SlotIndex, forEachUnspentOutput func(consumer func(output *ledgerstate.Output) bool) error) error {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	if err := i.store.Clear(); err != nil {
+		return errors.Wrap(err, "failed to clear index")
+	}
+
+	mutations, err := i.store.Batched()
+	if err != nil {
+		return errors.Wrap(err, "failed to create batched mutations")
+	}
+
+	var innerErr error
+	if err = forEachUnspentOutput(func(output *ledgerstate.Output) bool {
+		innerErr = addOutput(output, mutations)
+
+		return innerErr == nil
+	}); err != nil || innerErr != nil {
+		mutations.Cancel()
+
+		return errors.Wrap(lo.Cond(err != nil, err, innerErr), "failed to index unspent outputs")
+	}
+
+	if err = mutations.Set([]byte{storeKeyPrefixLedgerIndex}, ledgerIndex.Bytes()); err != nil {
+		mutations.Cancel()
+
+		return errors.Wrap(err, "failed to store ledger index")
+	}
+
+	return errors.Wrap(mutations.Commit(), "failed to commit index")
+}
+
+// ApplySlotDiff applies the given diff of a committed slot to the index.
+func (i *Indexer) ApplySlotDiff(diff *ledgerstate.SlotDiff) error {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	ledgerIndex, isSynced, err := i.ledgerIndex()
+	if err != nil {
+		return err
+	} else if !isSynced {
+		return ErrNotSynced
+	} else if diff.Index <= ledgerIndex {
+		return nil
+	} else if diff.Index != ledgerIndex+1 {
+		return errors.Errorf("diff of slot %d can not be applied to index at slot %d", diff.Index, ledgerIndex)
+	}
+
+	mutations, err := i.store.Batched()
+	if err != nil {
+		return errors.Wrap(err, "failed to create batched mutations")
+	}
+
+	for _, output := range diff.Outputs {
+		if err = addOutput(output, mutations); err != nil {
+			mutations.Cancel()
+
+			return err
+		}
+	}
+
+	for _, spent := range diff.Spents {
+		if err = deleteOutput(spent.Output(), mutations); err != nil {
+			mutations.Cancel()
+
+			return err
+		}
+	}
+
+	if err = mutations.Set([]byte{storeKeyPrefixLedgerIndex}, diff.Index.Bytes()); err != nil {
+		mutations.Cancel()
+
+		return errors.Wrap(err, "failed to store ledger index")
+	}
+
+	return errors.Wrap(mutations.Commit(), "failed to commit index")
+}
+
+// Outputs returns the IDs of the unspent outputs that match the given filter.
+func (i *Indexer) Outputs(filter *Filter) (*Result, error) {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+
+	ledgerIndex, isSynced, err := i.ledgerIndex()
+	if err != nil {
+		return nil, err
+	} else if !isSynced {
+		return nil, ErrNotSynced
+	}
+
+	result := &Result{
+		LedgerIndex: ledgerIndex,
+		OutputIDs:   make(iotago.OutputIDs, 0),
+	}
+
+	prefix := filter.indexPrefix()
+
+	var innerErr error
+	if err = i.store.IterateKeys(prefix, func(key kvstore.Key) bool {
+		var outputID iotago.OutputID
+		copy(outputID[:], key[len(prefix):])
+
+		if filter.Cursor != nil && bytes.Compare(outputID[:], filter.Cursor[:]) < 0 {
+			return true
+		}
+
+		record, err := i.outputRecord(outputID)
+		if err != nil {
+			innerErr = err
+			return false
+		}
+
+		if !filter.matches(record) {
+			return true
+		}
+
+		if filter.PageSize > 0 && len(result.OutputIDs) == filter.PageSize {
+			result.Cursor = &outputID
+			return false
+		}
+
+		result.OutputIDs = append(result.OutputIDs, outputID)
+
+		return true
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate index")
+	}
+
+	if innerErr != nil {
+		return nil, innerErr
+	}
+
+	return result, nil
+}
+
+func (i *Indexer) ledgerIndex() (ledgerIndex iotago.SlotIndex, isSynced bool, err error) {
+	value, err := i.store.Get([]byte{storeKeyPrefixLedgerIndex})
+	if err != nil {
+		if errors.Is(err, kvstore.ErrKeyNotFound) {
+			return 0, false, nil
+		}
+
+		return 0, false, errors.Wrap(err, "failed to load ledger index")
+	}
+
+	if ledgerIndex, err = iotago.SlotIndexFromBytes(value); err != nil {
+		return 0, false, errors.Wrap(err, "failed to parse ledger index")
+	}
+
+	return ledgerIndex, true, nil
+}
+
+func (i *Indexer) outputRecord(outputID iotago.OutputID) (*outputRecord, error) {
+	value, err := i.store.Get(outputKey(outputID))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load indexed output %s", outputID.ToHex())
+	}
+
+	record := &outputRecord{}
+	if err = record.FromBytes(value); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse indexed output %s", outputID.ToHex())
+	}
+
+	return record, nil
+}
+
+func addOutput(output *ledgerstate.Output, mutations kvstore.BatchedMutations) error {
+	record := newOutputRecord(output)
+
+	if err := mutations.Set(outputKey(output.OutputID()), record.Bytes()); err != nil {
+		return errors.Wrapf(err, "failed to index output %s", output.OutputID().ToHex())
+	}
+
+	for _, key := range record.indexKeys(output.OutputID()) {
+		if err := mutations.Set(key, []byte{}); err != nil {
+			return errors.Wrapf(err, "failed to index output %s", output.OutputID().ToHex())
+		}
+	}
+
+	return nil
+}
+
+func deleteOutput(output *ledgerstate.Output, mutations kvstore.BatchedMutations) error {
+	record := newOutputRecord(output)
+
+	if err := mutations.Delete(outputKey(output.OutputID())); err != nil {
+		return errors.Wrapf(err, "failed to remove output %s from index", output.OutputID().ToHex())
+	}
+
+	for _, key := range record.indexKeys(output.OutputID()) {
+		if err := mutations.Delete(key); err != nil {
+			return errors.Wrapf(err, "failed to remove output %s from index", output.OutputID().ToHex())
+		}
+	}
+
+	return nil
+}
+
+func outputKey(outputID iotago.OutputID) []byte {
+	return byteutils.ConcatBytes([]byte{storeKeyPrefixOutput}, outputID[:])
+}
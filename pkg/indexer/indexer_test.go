@@ -0,0 +1,109 @@
+package indexer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate/tpkg"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestIndexer(t *testing.T) {
+	indexer := New(mapdb.NewMapDB())
+
+	_, isSynced, err := indexer.LedgerIndex()
+	require.NoError(t, err)
+	require.False(t, isSynced)
+
+	_, err = indexer.Outputs(&Filter{})
+	require.ErrorIs(t, err, ErrNotSynced)
+
+	address := tpkg.RandAddress(iotago.AddressEd25519)
+	returnAddress := tpkg.RandAddress(iotago.AddressEd25519)
+
+	basicOutput := tpkg.RandLedgerStateOutputOnAddress(iotago.OutputBasic, address)
+	nftOutput := tpkg.RandLedgerStateOutputOnAddress(iotago.OutputNFT, address)
+	aliasOutput := tpkg.RandLedgerStateOutputOnAddress(iotago.OutputAlias, tpkg.RandAddress(iotago.AddressEd25519))
+	expiringOutput := ledgerstate.CreateOutput(tpkg.API(), tpkg.RandOutputID(), tpkg.RandBlockID(), 1, time.Now(), &iotago.BasicOutput{
+		Amount: tpkg.RandAmount(),
+		Conditions: iotago.BasicOutputUnlockConditions{
+			&iotago.AddressUnlockCondition{Address: address},
+			&iotago.ExpirationUnlockCondition{ReturnAddress: returnAddress, UnixTime: uint32(time.Now().Unix())},
+		},
+	})
+
+	// the index is built from the unspent outputs of the ledger.
+	require.NoError(t, indexer.Rebuild(1, func(consumer func(output *ledgerstate.Output) bool) error {
+		for _, output := range []*ledgerstate.Output{basicOutput, aliasOutput, expiringOutput} {
+			if !consumer(output) {
+				break
+			}
+		}
+
+		return nil
+	}))
+
+	requireOutputs(t, indexer, &Filter{}, basicOutput, aliasOutput, expiringOutput)
+	requireOutputs(t, indexer, &Filter{Address: address}, basicOutput, expiringOutput)
+	requireOutputs(t, indexer, &Filter{Address: returnAddress}, expiringOutput)
+	requireOutputs(t, indexer, &Filter{HasExpiration: boolPtr(true)}, expiringOutput)
+	requireOutputs(t, indexer, &Filter{Address: address, HasExpiration: boolPtr(false)}, basicOutput)
+	requireOutputs(t, indexer, &Filter{OutputType: outputTypePtr(iotago.OutputAlias)}, aliasOutput)
+
+	// committed slot diffs are applied in order.
+	require.Error(t, indexer.ApplySlotDiff(&ledgerstate.SlotDiff{Index: 3}))
+	require.NoError(t, indexer.ApplySlotDiff(&ledgerstate.SlotDiff{
+		Index:   2,
+		Outputs: ledgerstate.Outputs{nftOutput},
+		Spents:  ledgerstate.Spents{tpkg.RandLedgerStateSpentWithOutput(basicOutput, 2, time.Now())},
+	}))
+
+	ledgerIndex, isSynced, err := indexer.LedgerIndex()
+	require.NoError(t, err)
+	require.True(t, isSynced)
+	require.Equal(t, iotago.SlotIndex(2), ledgerIndex)
+
+	requireOutputs(t, indexer, &Filter{Address: address}, nftOutput, expiringOutput)
+	requireOutputs(t, indexer, &Filter{OutputType: outputTypePtr(iotago.OutputBasic)}, expiringOutput)
+
+	// results can be paginated using the returned cursor.
+	outputIDs := make(iotago.OutputIDs, 0)
+	filter := &Filter{PageSize: 2}
+	for {
+		result, err := indexer.Outputs(filter)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(result.OutputIDs), 2)
+
+		outputIDs = append(outputIDs, result.OutputIDs...)
+		if result.Cursor == nil {
+			break
+		}
+
+		filter.Cursor = result.Cursor
+	}
+	require.ElementsMatch(t, iotago.OutputIDs{nftOutput.OutputID(), aliasOutput.OutputID(), expiringOutput.OutputID()}, outputIDs)
+}
+
+func requireOutputs(t *testing.T, indexer *Indexer, filter *Filter, expectedOutputs ...*ledgerstate.Output) {
+	result, err := indexer.Outputs(filter)
+	require.NoError(t, err)
+
+	expectedOutputIDs := make(iotago.OutputIDs, 0)
+	for _, output := range expectedOutputs {
+		expectedOutputIDs = append(expectedOutputIDs, output.OutputID())
+	}
+
+	require.ElementsMatch(t, expectedOutputIDs, result.OutputIDs)
+}
+
+func boolPtr(value bool) *bool {
+	return &value
+}
+
+func outputTypePtr(outputType iotago.OutputType) *iotago.OutputType {
+	return &outputType
+}
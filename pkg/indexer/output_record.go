@@ -0,0 +1,145 @@
+package indexer
+
+import (
+	"github.com/iotaledger/hive.go/serializer/v2/byteutils"
+	"github.com/iotaledger/hive.go/serializer/v2/marshalutil"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// indexedUnlockConditions are the unlock conditions that outputs are indexed by.
+var indexedUnlockConditions = []iotago.UnlockConditionType{
+	iotago.UnlockConditionStorageDepositReturn,
+	iotago.UnlockConditionTimelock,
+	iotago.UnlockConditionExpiration,
+}
+
+// outputRecord contains the indexed properties of an unspent output.
+type outputRecord struct {
+	outputType       iotago.OutputType
+	unlockConditions []iotago.UnlockConditionType
+	addressKeys      []string
+}
+
+func newOutputRecord(output *ledgerstate.Output) *outputRecord {
+	record := &outputRecord{
+		outputType:       output.OutputType(),
+		unlockConditions: make([]iotago.UnlockConditionType, 0),
+		addressKeys:      make([]string, 0),
+	}
+
+	unlockConditions := output.Output().UnlockConditionSet()
+	for _, unlockConditionType := range indexedUnlockConditions {
+		if _, exists := unlockConditions[unlockConditionType]; exists {
+			record.unlockConditions = append(record.unlockConditions, unlockConditionType)
+		}
+	}
+
+	// index the output by all addresses that are able to unlock it.
+	for _, address := range output.UnlockAddresses() {
+		record.addressKeys = append(record.addressKeys, address.Key())
+	}
+
+	return record
+}
+
+// FromBytes parses the record from its serialized form.
+func (r *outputRecord) FromBytes(bytes []byte) error {
+	marshalUtil := marshalutil.New(bytes)
+
+	outputType, err := marshalUtil.ReadByte()
+	if err != nil {
+		return err
+	}
+	r.outputType = iotago.OutputType(outputType)
+
+	unlockConditionsCount, err := marshalUtil.ReadByte()
+	if err != nil {
+		return err
+	}
+	r.unlockConditions = make([]iotago.UnlockConditionType, unlockConditionsCount)
+	for i := range r.unlockConditions {
+		unlockConditionType, err := marshalUtil.ReadByte()
+		if err != nil {
+			return err
+		}
+		r.unlockConditions[i] = iotago.UnlockConditionType(unlockConditionType)
+	}
+
+	addressesCount, err := marshalUtil.ReadByte()
+	if err != nil {
+		return err
+	}
+	r.addressKeys = make([]string, addressesCount)
+	for i := range r.addressKeys {
+		addressKeyLength, err := marshalUtil.ReadByte()
+		if err != nil {
+			return err
+		}
+
+		addressKey, err := marshalUtil.ReadBytes(int(addressKeyLength))
+		if err != nil {
+			return err
+		}
+		r.addressKeys[i] = string(addressKey)
+	}
+
+	return nil
+}
+
+// Bytes returns the serialized form of the record.
+func (r *outputRecord) Bytes() []byte {
+	marshalUtil := marshalutil.New()
+
+	marshalUtil.WriteByte(byte(r.outputType))
+
+	marshalUtil.WriteByte(byte(len(r.unlockConditions)))
+	for _, unlockConditionType := range r.unlockConditions {
+		marshalUtil.WriteByte(byte(unlockConditionType))
+	}
+
+	marshalUtil.WriteByte(byte(len(r.addressKeys)))
+	for _, addressKey := range r.addressKeys {
+		marshalUtil.WriteByte(byte(len(addressKey)))
+		marshalUtil.WriteBytes([]byte(addressKey))
+	}
+
+	return marshalUtil.Bytes()
+}
+
+func (r *outputRecord) hasAddressKey(addressKey string) bool {
+	for _, existingAddressKey := range r.addressKeys {
+		if existingAddressKey == addressKey {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *outputRecord) hasUnlockCondition(unlockConditionType iotago.UnlockConditionType) bool {
+	for _, existingUnlockConditionType := range r.unlockConditions {
+		if existingUnlockConditionType == unlockConditionType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// indexKeys returns the keys of the secondary indexes that the output is referenced in.
+func (r *outputRecord) indexKeys(outputID iotago.OutputID) [][]byte {
+	keys := [][]byte{
+		byteutils.ConcatBytes([]byte{storeKeyPrefixOutputType, byte(r.outputType)}, outputID[:]),
+	}
+
+	for _, unlockConditionType := range r.unlockConditions {
+		keys = append(keys, byteutils.ConcatBytes([]byte{storeKeyPrefixUnlockCondition, byte(unlockConditionType)}, outputID[:]))
+	}
+
+	for _, addressKey := range r.addressKeys {
+		keys = append(keys, byteutils.ConcatBytes([]byte{storeKeyPrefixAddress}, []byte(addressKey), outputID[:]))
+	}
+
+	return keys
+}
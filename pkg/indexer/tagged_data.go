@@ -0,0 +1,316 @@
+package indexer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/serializer/v2"
+	"github.com/iotaledger/hive.go/serializer/v2/byteutils"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// TaggedDataBlockState is the acceptance state of an indexed block.
+type TaggedDataBlockState byte
+
+const (
+	// TaggedDataBlockStateAccepted is the state of an accepted block.
+	TaggedDataBlockStateAccepted TaggedDataBlockState = iota
+	// TaggedDataBlockStateConfirmed is the state of a confirmed block.
+	TaggedDataBlockStateConfirmed
+	// TaggedDataBlockStateFinalized is the state of an accepted block whose slot was finalized.
+	TaggedDataBlockStateFinalized
+)
+
+// taggedDataRecordLength is the length of the value that is stored for an indexed block (issuing time and state).
+const taggedDataRecordLength = serializer.UInt64ByteSize + 1
+
+// String returns a human-readable version of the TaggedDataBlockState.
+func (s TaggedDataBlockState) String() string {
+	switch s {
+	case TaggedDataBlockStateAccepted:
+		return "accepted"
+	case TaggedDataBlockStateConfirmed:
+		return "confirmed"
+	case TaggedDataBlockStateFinalized:
+		return "finalized"
+	default:
+		return "unknown"
+	}
+}
+
+// TaggedDataBlockStateFromString parses the human-readable version of a TaggedDataBlockState.
+func TaggedDataBlockStateFromString(state string) (TaggedDataBlockState, error) {
+	for _, candidate := range []TaggedDataBlockState{TaggedDataBlockStateAccepted, TaggedDataBlockStateConfirmed, TaggedDataBlockStateFinalized} {
+		if candidate.String() == state {
+			return candidate, nil
+		}
+	}
+
+	return 0, errors.Errorf("unknown block state %s", state)
+}
+
+// TaggedDataIndexer keeps an index of the accepted blocks with tagged data payloads by their tag. The index of a slot
+// is kept in the prunable storage of the slot, so that it is pruned together with the blocks of the slot.
+type TaggedDataIndexer struct {
+	storeFunc func(slot iotago.SlotIndex, createIfMissing bool) kvstore.KVStore
+	mutex     sync.Mutex
+}
+
+// NewTaggedDataIndexer creates a new TaggedDataIndexer that stores the index of a slot in the store returned by the
+// given function (nil if the slot was already pruned or if its store does not exist and should not be created).
+func NewTaggedDataIndexer(storeFunc func(slot iotago.SlotIndex, createIfMissing bool) kvstore.KVStore) *TaggedDataIndexer {
+	return &TaggedDataIndexer{
+		storeFunc: storeFunc,
+	}
+}
+
+// AddAcceptedBlock indexes the given accepted block if it contains tagged data.
+func (t *TaggedDataIndexer) AddAcceptedBlock(blockID iotago.BlockID, block *iotago.Block) error {
+	tag, hasTaggedData := taggedDataTag(block)
+	if !hasTaggedData {
+		return nil
+	}
+
+	store := t.storeFunc(blockID.Index(), true)
+	if store == nil {
+		return nil
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	// the block might have been confirmed before it was indexed.
+	if exists, err := store.Has(taggedDataKey(tag, blockID)); err != nil {
+		return errors.Wrapf(err, "failed to check if block %s is indexed", blockID)
+	} else if exists {
+		return nil
+	}
+
+	return errors.Wrapf(store.Set(taggedDataKey(tag, blockID), taggedDataRecord(block.IssuingTime, TaggedDataBlockStateAccepted)), "failed to index block %s", blockID)
+}
+
+// MarkConfirmed marks the given block as confirmed if it contains tagged data.
+func (t *TaggedDataIndexer) MarkConfirmed(blockID iotago.BlockID, block *iotago.Block) error {
+	tag, hasTaggedData := taggedDataTag(block)
+	if !hasTaggedData {
+		return nil
+	}
+
+	store := t.storeFunc(blockID.Index(), true)
+	if store == nil {
+		return nil
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return errors.Wrapf(store.Set(taggedDataKey(tag, blockID), taggedDataRecord(block.IssuingTime, TaggedDataBlockStateConfirmed)), "failed to mark block %s as confirmed", blockID)
+}
+
+// TaggedDataBlocks returns the indexed blocks that match the given filter.
+func (t *TaggedDataIndexer) TaggedDataBlocks(filter *TaggedDataFilter) (*TaggedDataResult, error) {
+	result := &TaggedDataResult{
+		Blocks: make([]*TaggedDataBlock, 0),
+	}
+
+	startSlot := filter.StartSlot
+	var cursorKey []byte
+	if len(filter.Cursor) != 0 {
+		cursorSlot, cursorKeyStart, err := parseTaggedDataCursor(filter.Cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		if cursorSlot > startSlot {
+			startSlot = cursorSlot
+		}
+
+		if cursorSlot == startSlot {
+			cursorKey = cursorKeyStart
+		}
+	}
+
+	for slot := startSlot; slot <= filter.EndSlot && result.Cursor == nil; slot++ {
+		// the remaining slots are searched by the next query.
+		if filter.MaxSlots > 0 && slot-startSlot >= filter.MaxSlots {
+			result.Cursor = slot.Bytes()
+			break
+		}
+
+		// slots without any indexed blocks are skipped without creating their store.
+		store := t.storeFunc(slot, false)
+		if store == nil {
+			continue
+		}
+
+		var innerErr error
+		if err := store.Iterate(filter.TagPrefix, func(key kvstore.Key, value kvstore.Value) bool {
+			if slot == startSlot && cursorKey != nil && bytes.Compare(key, cursorKey) < 0 {
+				return true
+			}
+
+			block, err := taggedDataBlockFromKeyValue(key, value)
+			if err != nil {
+				innerErr = err
+				return false
+			}
+
+			if slot <= filter.LatestFinalizedSlot {
+				block.State = TaggedDataBlockStateFinalized
+			}
+
+			if !filter.matches(block) {
+				return true
+			}
+
+			if filter.PageSize > 0 && len(result.Blocks) == filter.PageSize {
+				result.Cursor = byteutils.ConcatBytes(slot.Bytes(), key)
+				return false
+			}
+
+			result.Blocks = append(result.Blocks, block)
+
+			return true
+		}); err != nil {
+			return nil, errors.Wrapf(err, "failed to iterate index of slot %d", slot)
+		}
+
+		if innerErr != nil {
+			return nil, innerErr
+		}
+	}
+
+	return result, nil
+}
+
+// TaggedDataFilter defines the criteria that the blocks returned by a query need to match.
+type TaggedDataFilter struct {
+	// TagPrefix filters blocks whose tag starts with the given prefix.
+	TagPrefix []byte
+	// StartSlot is the first slot that is searched.
+	StartSlot iotago.SlotIndex
+	// EndSlot is the last slot that is searched.
+	EndSlot iotago.SlotIndex
+	// IssuedAfter filters blocks that were issued at or after the given time (ignored if zero).
+	IssuedAfter time.Time
+	// IssuedBefore filters blocks that were issued before the given time (ignored if zero).
+	IssuedBefore time.Time
+	// State filters blocks with the given acceptance state.
+	State *TaggedDataBlockState
+	// LatestFinalizedSlot is used to determine which of the blocks are finalized.
+	LatestFinalizedSlot iotago.SlotIndex
+	// Cursor is the position of the first block of the requested page.
+	Cursor []byte
+	// PageSize is the maximum amount of blocks that are returned (0 means unlimited).
+	PageSize int
+	// MaxSlots is the maximum amount of slots that are searched by a single query (0 means unlimited). If there are
+	// more slots to search, the Cursor of the result points to the first slot that was not searched.
+	MaxSlots iotago.SlotIndex
+}
+
+func (f *TaggedDataFilter) matches(block *TaggedDataBlock) bool {
+	if !f.IssuedAfter.IsZero() && block.IssuingTime.Before(f.IssuedAfter) {
+		return false
+	}
+
+	if !f.IssuedBefore.IsZero() && !block.IssuingTime.Before(f.IssuedBefore) {
+		return false
+	}
+
+	return f.State == nil || block.State == *f.State
+}
+
+// TaggedDataResult contains the result of a query.
+type TaggedDataResult struct {
+	// Blocks are the blocks that matched the filter (ordered by slot and tag).
+	Blocks []*TaggedDataBlock
+	// Cursor is the position of the first block of the next page (nil if there are no more results).
+	Cursor []byte
+}
+
+// TaggedDataBlock is an indexed block with tagged data.
+type TaggedDataBlock struct {
+	// BlockID is the ID of the block.
+	BlockID iotago.BlockID
+	// Tag is the tag of the tagged data of the block.
+	Tag []byte
+	// IssuingTime is the time at which the block was issued.
+	IssuingTime time.Time
+	// State is the acceptance state of the block.
+	State TaggedDataBlockState
+}
+
+// taggedDataTag returns the tag of the tagged data that is contained in the block (either as its payload or as the
+// payload of its transaction).
+func taggedDataTag(block *iotago.Block) (tag []byte, hasTaggedData bool) {
+	switch payload := block.Payload.(type) {
+	case *iotago.TaggedData:
+		return payload.Tag, true
+	case *iotago.Transaction:
+		if payload.Essence == nil {
+			return nil, false
+		}
+
+		if taggedData, isTaggedData := payload.Essence.Payload.(*iotago.TaggedData); isTaggedData {
+			return taggedData.Tag, true
+		}
+	}
+
+	return nil, false
+}
+
+func taggedDataKey(tag []byte, blockID iotago.BlockID) []byte {
+	return byteutils.ConcatBytes(tag, blockID[:])
+}
+
+func taggedDataRecord(issuingTime time.Time, state TaggedDataBlockState) []byte {
+	record := make([]byte, taggedDataRecordLength)
+	binary.LittleEndian.PutUint64(record, uint64(issuingTime.UnixNano()))
+	record[serializer.UInt64ByteSize] = byte(state)
+
+	return record
+}
+
+func taggedDataBlockFromKeyValue(key kvstore.Key, value kvstore.Value) (*TaggedDataBlock, error) {
+	if len(key) < iotago.SlotIdentifierLength {
+		return nil, errors.Errorf("invalid tagged data index key length %d", len(key))
+	}
+
+	if len(value) != taggedDataRecordLength {
+		return nil, errors.Errorf("invalid tagged data index record length %d", len(value))
+	}
+
+	tagLength := len(key) - iotago.SlotIdentifierLength
+	block := &TaggedDataBlock{
+		Tag:         lo.CopySlice(key[:tagLength]),
+		IssuingTime: time.Unix(0, int64(binary.LittleEndian.Uint64(value))),
+		State:       TaggedDataBlockState(value[serializer.UInt64ByteSize]),
+	}
+	copy(block.BlockID[:], key[tagLength:])
+
+	return block, nil
+}
+
+// parseTaggedDataCursor parses a cursor that either points to a block of a slot or (without a key) to the start of the
+// slot.
+func parseTaggedDataCursor(cursor []byte) (slot iotago.SlotIndex, key []byte, err error) {
+	if len(cursor) != serializer.UInt64ByteSize && len(cursor) < serializer.UInt64ByteSize+iotago.SlotIdentifierLength {
+		return 0, nil, errors.Errorf("invalid cursor length %d", len(cursor))
+	}
+
+	if slot, err = iotago.SlotIndexFromBytes(cursor[:serializer.UInt64ByteSize]); err != nil {
+		return 0, nil, errors.Wrap(err, "invalid cursor")
+	}
+
+	if len(cursor) == serializer.UInt64ByteSize {
+		return slot, nil, nil
+	}
+
+	return slot, cursor[serializer.UInt64ByteSize:], nil
+}
@@ -0,0 +1,125 @@
+package indexer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate/tpkg"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestTaggedDataIndexer(t *testing.T) {
+	stores := make(map[iotago.SlotIndex]kvstore.KVStore)
+	prunedUntil := iotago.SlotIndex(0)
+	indexer := NewTaggedDataIndexer(func(slot iotago.SlotIndex, createIfMissing bool) kvstore.KVStore {
+		if slot <= prunedUntil {
+			return nil
+		}
+
+		if _, exists := stores[slot]; !exists {
+			if !createIfMissing {
+				return nil
+			}
+
+			stores[slot] = mapdb.NewMapDB()
+		}
+
+		return stores[slot]
+	})
+
+	genesisTime := time.Unix(1000, 0)
+	newBlock := func(slot iotago.SlotIndex, payload iotago.Payload) (iotago.BlockID, *iotago.Block) {
+		return iotago.NewSlotIdentifier(slot, iotago.IdentifierFromData(tpkg.RandBytes(32))), &iotago.Block{
+			IssuingTime: genesisTime.Add(time.Duration(slot) * time.Second),
+			Payload:     payload,
+		}
+	}
+
+	activity1ID, activity1 := newBlock(1, &iotago.TaggedData{Tag: []byte("ACTIVITY")})
+	activity2ID, activity2 := newBlock(2, &iotago.TaggedData{Tag: []byte("ACTIVITY")})
+	actionID, action := newBlock(2, &iotago.TaggedData{Tag: []byte("ACTION")})
+	transactionID, transaction := newBlock(3, &iotago.Transaction{Essence: &iotago.TransactionEssence{Payload: &iotago.TaggedData{Tag: []byte("TX")}}})
+	untaggedID, untagged := newBlock(3, &iotago.Transaction{Essence: &iotago.TransactionEssence{}})
+
+	for blockID, block := range map[iotago.BlockID]*iotago.Block{activity1ID: activity1, activity2ID: activity2, actionID: action, transactionID: transaction, untaggedID: untagged} {
+		require.NoError(t, indexer.AddAcceptedBlock(blockID, block))
+	}
+	require.NoError(t, indexer.MarkConfirmed(activity2ID, activity2))
+
+	// blocks are found by the prefix of their tag.
+	requireTaggedDataBlocks(t, indexer, &TaggedDataFilter{EndSlot: 3}, activity1ID, actionID, activity2ID, transactionID)
+	requireTaggedDataBlocks(t, indexer, &TaggedDataFilter{EndSlot: 3, TagPrefix: []byte("ACT")}, activity1ID, actionID, activity2ID)
+	requireTaggedDataBlocks(t, indexer, &TaggedDataFilter{EndSlot: 3, TagPrefix: []byte("ACTIVITY")}, activity1ID, activity2ID)
+	requireTaggedDataBlocks(t, indexer, &TaggedDataFilter{EndSlot: 3, TagPrefix: []byte("TX")}, transactionID)
+
+	// blocks are filtered by slot and issuing time.
+	requireTaggedDataBlocks(t, indexer, &TaggedDataFilter{StartSlot: 2, EndSlot: 2}, actionID, activity2ID)
+	requireTaggedDataBlocks(t, indexer, &TaggedDataFilter{EndSlot: 3, IssuedAfter: activity2.IssuingTime, IssuedBefore: transaction.IssuingTime}, actionID, activity2ID)
+
+	// blocks are filtered by their acceptance state.
+	requireTaggedDataBlocks(t, indexer, &TaggedDataFilter{EndSlot: 3, State: taggedDataBlockStatePtr(lo.PanicOnErr(TaggedDataBlockStateFromString("confirmed")))}, activity2ID)
+	requireTaggedDataBlocks(t, indexer, &TaggedDataFilter{EndSlot: 3, State: taggedDataBlockStatePtr(TaggedDataBlockStateAccepted)}, activity1ID, actionID, transactionID)
+	requireTaggedDataBlocks(t, indexer, &TaggedDataFilter{EndSlot: 3, LatestFinalizedSlot: 2, State: taggedDataBlockStatePtr(TaggedDataBlockStateFinalized)}, activity1ID, actionID, activity2ID)
+
+	// results can be paginated using the returned cursor.
+	blockIDs := make([]iotago.BlockID, 0)
+	filter := &TaggedDataFilter{EndSlot: 3, PageSize: 1}
+	for {
+		result, err := indexer.TaggedDataBlocks(filter)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(result.Blocks), 1)
+
+		for _, block := range result.Blocks {
+			blockIDs = append(blockIDs, block.BlockID)
+		}
+
+		if result.Cursor == nil {
+			break
+		}
+
+		filter.Cursor = result.Cursor
+	}
+	require.Equal(t, []iotago.BlockID{activity1ID, actionID, activity2ID, transactionID}, blockIDs)
+
+	// the amount of slots that are searched by a single query is limited, the cursor continues at the next slot.
+	filter = &TaggedDataFilter{EndSlot: 1_000, MaxSlots: 2}
+	result, err := indexer.TaggedDataBlocks(filter)
+	require.NoError(t, err)
+	require.Equal(t, []*TaggedDataBlock{{BlockID: activity1ID, Tag: []byte("ACTIVITY"), IssuingTime: activity1.IssuingTime}}, result.Blocks)
+	require.Equal(t, iotago.SlotIndex(2).Bytes(), result.Cursor)
+
+	filter.Cursor = result.Cursor
+	result, err = indexer.TaggedDataBlocks(filter)
+	require.NoError(t, err)
+	require.Len(t, result.Blocks, 3)
+	require.Equal(t, iotago.SlotIndex(4).Bytes(), result.Cursor)
+
+	// searching slots without indexed blocks does not create their stores.
+	require.Len(t, stores, 3)
+
+	// the index of pruned slots is not available anymore.
+	prunedUntil = 2
+	requireTaggedDataBlocks(t, indexer, &TaggedDataFilter{EndSlot: 3}, transactionID)
+}
+
+func requireTaggedDataBlocks(t *testing.T, indexer *TaggedDataIndexer, filter *TaggedDataFilter, expectedBlockIDs ...iotago.BlockID) {
+	result, err := indexer.TaggedDataBlocks(filter)
+	require.NoError(t, err)
+	require.Nil(t, result.Cursor)
+
+	blockIDs := make([]iotago.BlockID, 0)
+	for _, block := range result.Blocks {
+		blockIDs = append(blockIDs, block.BlockID)
+	}
+
+	require.Equal(t, expectedBlockIDs, blockIDs)
+}
+
+func taggedDataBlockStatePtr(state TaggedDataBlockState) *TaggedDataBlockState {
+	return &state
+}
@@ -0,0 +1,27 @@
+package model
+
+import (
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/serializer/v2/byteutils"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// AttestationBlockID returns the ID of the block that the given attestation was created from. Contrary to
+// iotago.Attestation.BlockID, which hashes the content hash of the block again, it derives the identifier in the same
+// way as iotago.Block.ID, so that the attested blocks can be requested from our peers.
+func AttestationBlockID(attestation *iotago.Attestation, api iotago.API) (iotago.BlockID, error) {
+	signatureBytes, err := api.Encode(attestation.Signature)
+	if err != nil {
+		return iotago.EmptyBlockID(), errors.Wrap(err, "failed to serialize signature of attestation")
+	}
+
+	nonceBytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(nonceBytes, attestation.Nonce)
+
+	blockIdentifier := iotago.IdentifierFromData(byteutils.ConcatBytes(attestation.BlockContentHash[:], signatureBytes, nonceBytes))
+
+	return iotago.NewSlotIdentifier(api.SlotTimeProvider().IndexFromTime(attestation.IssuingTime), blockIdentifier), nil
+}
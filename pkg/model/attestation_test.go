@@ -0,0 +1,28 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate/tpkg"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestAttestationBlockID(t *testing.T) {
+	block, err := model.BlockFromBlock(&iotago.Block{
+		ProtocolVersion: tpkg.ProtocolParams().Version,
+		IssuerID:        iotago.AccountID{1},
+		IssuingTime:     tpkg.API().SlotTimeProvider().StartTime(5),
+		SlotCommitment:  iotago.NewCommitment(1, iotago.CommitmentID{1}, iotago.Identifier{2}, 3),
+		StrongParents:   iotago.StrongParentsIDs{iotago.EmptyBlockID()},
+		Signature:       &iotago.Ed25519Signature{PublicKey: [32]byte{4}, Signature: [64]byte{5}},
+		Nonce:           42,
+	}, tpkg.API())
+	require.NoError(t, err)
+
+	blockID, err := model.AttestationBlockID(iotago.NewAttestation(block.Block()), tpkg.API())
+	require.NoError(t, err)
+	require.Equal(t, block.ID(), blockID)
+}
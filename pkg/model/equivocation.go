@@ -0,0 +1,105 @@
+package model
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/serializer/v2/marshalutil"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// Equivocation is the evidence that a validator issued two blocks that contradict each other.
+type Equivocation struct {
+	issuerID iotago.AccountID
+	blocks   [2]*Block
+}
+
+// NewEquivocation creates a new Equivocation from the two given (conflicting) blocks of the same issuer.
+func NewEquivocation(block1, block2 *Block) *Equivocation {
+	// the blocks are ordered by their ID, so that the evidence is independent of the order in which they were received.
+	if block1ID, block2ID := block1.ID(), block2.ID(); bytes.Compare(block1ID[:], block2ID[:]) > 0 {
+		block1, block2 = block2, block1
+	}
+
+	return &Equivocation{
+		issuerID: block1.Block().IssuerID,
+		blocks:   [2]*Block{block1, block2},
+	}
+}
+
+// EquivocationFromBytes parses an Equivocation from the given bytes.
+func EquivocationFromBytes(data []byte, api iotago.API) (*Equivocation, error) {
+	m := marshalutil.New(data)
+
+	issuerIDBytes, err := m.ReadBytes(iotago.IdentifierLength)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read issuer ID")
+	}
+
+	e := new(Equivocation)
+	copy(e.issuerID[:], issuerIDBytes)
+
+	for i := range e.blocks {
+		blockIDBytes, err := m.ReadBytes(iotago.BlockIDLength)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read ID of block %d", i)
+		}
+
+		blockDataLength, err := m.ReadUint32()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read length of block %d", i)
+		}
+
+		blockData, err := m.ReadBytes(int(blockDataLength))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read data of block %d", i)
+		}
+
+		var blockID iotago.BlockID
+		copy(blockID[:], blockIDBytes)
+
+		if e.blocks[i], err = BlockFromIDAndBytes(blockID, blockData, api); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse block %d", i)
+		}
+	}
+
+	return e, nil
+}
+
+// IssuerID returns the ID of the validator that equivocated.
+func (e *Equivocation) IssuerID() iotago.AccountID {
+	return e.issuerID
+}
+
+// Blocks returns the two conflicting blocks.
+func (e *Equivocation) Blocks() (block1, block2 *Block) {
+	return e.blocks[0], e.blocks[1]
+}
+
+// Key returns the key that uniquely identifies the Equivocation.
+func (e *Equivocation) Key() []byte {
+	block1ID, block2ID := e.blocks[0].ID(), e.blocks[1].ID()
+
+	key := make([]byte, 0, iotago.IdentifierLength+2*iotago.BlockIDLength)
+	key = append(key, e.issuerID[:]...)
+	key = append(key, block1ID[:]...)
+
+	return append(key, block2ID[:]...)
+}
+
+// Bytes returns the serialized form of the Equivocation.
+func (e *Equivocation) Bytes() []byte {
+	m := marshalutil.New()
+	m.WriteBytes(e.issuerID[:])
+
+	for _, block := range e.blocks {
+		blockID := block.ID()
+
+		m.WriteBytes(blockID[:])
+		m.WriteUint32(uint32(len(block.Data())))
+		m.WriteBytes(block.Data())
+	}
+
+	return m.Bytes()
+}
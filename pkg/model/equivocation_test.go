@@ -0,0 +1,55 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate/tpkg"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestEquivocation(t *testing.T) {
+	issuerID := iotago.AccountID{1}
+	issuingTime := tpkg.API().SlotTimeProvider().StartTime(5)
+
+	block1 := newBlock(t, issuerID, issuingTime, iotago.NewEmptyCommitment())
+	block2 := newBlock(t, issuerID, issuingTime, iotago.NewCommitment(1, iotago.CommitmentID{1}, iotago.Identifier{2}, 3))
+
+	equivocation := model.NewEquivocation(block1, block2)
+	require.Equal(t, issuerID, equivocation.IssuerID())
+
+	// The evidence does not depend on the order of the blocks.
+	require.Equal(t, equivocation.Bytes(), model.NewEquivocation(block2, block1).Bytes())
+	require.Equal(t, equivocation.Key(), model.NewEquivocation(block2, block1).Key())
+
+	parsedEquivocation, err := model.EquivocationFromBytes(equivocation.Bytes(), tpkg.API())
+	require.NoError(t, err)
+	require.Equal(t, equivocation.IssuerID(), parsedEquivocation.IssuerID())
+
+	expectedBlock1, expectedBlock2 := equivocation.Blocks()
+	parsedBlock1, parsedBlock2 := parsedEquivocation.Blocks()
+	require.Equal(t, expectedBlock1.ID(), parsedBlock1.ID())
+	require.Equal(t, expectedBlock1.Data(), parsedBlock1.Data())
+	require.Equal(t, expectedBlock2.ID(), parsedBlock2.ID())
+	require.Equal(t, expectedBlock2.Data(), parsedBlock2.Data())
+
+	_, err = model.EquivocationFromBytes(equivocation.Bytes()[:50], tpkg.API())
+	require.Error(t, err)
+}
+
+func newBlock(t *testing.T, issuerID iotago.AccountID, issuingTime time.Time, commitment *iotago.Commitment) *model.Block {
+	block, err := model.BlockFromBlock(&iotago.Block{
+		ProtocolVersion: tpkg.ProtocolParams().Version,
+		IssuerID:        issuerID,
+		IssuingTime:     issuingTime,
+		SlotCommitment:  commitment,
+		StrongParents:   iotago.StrongParentsIDs{iotago.EmptyBlockID()},
+		Signature:       &iotago.Ed25519Signature{},
+	}, tpkg.API())
+	require.NoError(t, err)
+
+	return block
+}
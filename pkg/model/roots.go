@@ -0,0 +1,16 @@
+package model
+
+import (
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/iotaledger/hive.go/serializer/v2/byteutils"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// RootsID returns the identifier of the given roots that a commitment commits to. Contrary to iotago.Roots.ID, it also
+// commits to the ActivityRoot, so that the attestations of a slot can be verified against its commitment.
+func RootsID(roots *iotago.Roots) iotago.Identifier {
+	rootsID := roots.ID()
+
+	return blake2b.Sum256(byteutils.ConcatBytes(rootsID[:], roots.ActivityRoot[:]))
+}
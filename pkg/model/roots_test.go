@@ -0,0 +1,23 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/iota-core/pkg/model"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestRootsID(t *testing.T) {
+	roots := iotago.NewRoots(iotago.Identifier{1}, iotago.Identifier{2}, iotago.Identifier{3}, iotago.Identifier{4}, iotago.Identifier{5})
+	require.NotEqual(t, roots.ID(), model.RootsID(roots))
+
+	// the ActivityRoot is committed to as well.
+	otherRoots := iotago.NewRoots(iotago.Identifier{1}, iotago.Identifier{2}, iotago.Identifier{3}, iotago.Identifier{4}, iotago.Identifier{5})
+	otherRoots.ActivityRoot = iotago.Identifier{6}
+	require.NotEqual(t, model.RootsID(roots), model.RootsID(otherRoots))
+
+	otherRoots.ActivityRoot = roots.ActivityRoot
+	require.Equal(t, model.RootsID(roots), model.RootsID(otherRoots))
+}
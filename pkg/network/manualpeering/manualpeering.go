@@ -14,8 +14,11 @@ import (
 	"github.com/iotaledger/hive.go/autopeering/peer/service"
 	"github.com/iotaledger/hive.go/crypto/ed25519"
 	"github.com/iotaledger/hive.go/crypto/identity"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/hive.go/logger"
 	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/hive.go/runtime/options"
 	"github.com/iotaledger/hive.go/runtime/workerpool"
 	"github.com/iotaledger/iota-core/pkg/network"
 	"github.com/iotaledger/iota-core/pkg/network/p2p"
@@ -79,22 +82,23 @@ type Manager struct {
 	knownPeers        map[network.PeerID]*knownPeer
 	workerPool        *workerpool.WorkerPool
 
+	// persistentPeersStore holds the peers that were added via AddPersistentPeer, so they survive a restart.
+	persistentPeersStore kvstore.KVStore
+
 	onGossipNeighborRemovedHook *event.Hook[func(*p2p.NeighborRemovedEvent)]
 	onGossipNeighborAddedHook   *event.Hook[func(*p2p.NeighborAddedEvent)]
 }
 
 // NewManager initializes a new Manager instance.
-func NewManager(p2pm *p2p.Manager, local *peer.Local, workerPool *workerpool.WorkerPool, log *logger.Logger) *Manager {
-	m := &Manager{
+func NewManager(p2pm *p2p.Manager, local *peer.Local, workerPool *workerpool.WorkerPool, log *logger.Logger, opts ...options.Option[Manager]) *Manager {
+	return options.Apply(&Manager{
 		p2pm:              p2pm,
 		local:             local,
 		log:               log,
 		reconnectInterval: defaultReconnectInterval,
 		knownPeers:        map[network.PeerID]*knownPeer{},
 		workerPool:        workerPool,
-	}
-
-	return m
+	}, opts)
 }
 
 // AddPeer adds multiple peers to the list of known peers.
@@ -109,13 +113,41 @@ func (m *Manager) AddPeer(peers ...*KnownPeerToAdd) error {
 	return resultErr
 }
 
-// RemovePeer removes multiple peers from the list of known peers.
+// AddPersistentPeer adds multiple peers to the list of known peers and stores them, so that they are added again after
+// a restart of the node.
+func (m *Manager) AddPersistentPeer(peers ...*KnownPeerToAdd) error {
+	if m.persistentPeersStore == nil {
+		return errors.New("manual peering manager has no store for persistent peers")
+	}
+
+	var resultErr error
+	for _, p := range peers {
+		if err := m.addPeer(p); err != nil {
+			resultErr = err
+			continue
+		}
+
+		if err := m.persistentPeersStore.Set(lo.PanicOnErr(p.PublicKey.Bytes()), []byte(p.Address)); err != nil {
+			resultErr = errors.Wrapf(err, "failed to store peer %s", p.PublicKey)
+		}
+	}
+
+	return resultErr
+}
+
+// RemovePeer removes multiple peers from the list of known peers (and from the persistent peers if they were stored).
 func (m *Manager) RemovePeer(keys ...ed25519.PublicKey) error {
 	var resultErr error
 	for _, key := range keys {
 		if err := m.removePeer(key); err != nil {
 			resultErr = err
 		}
+
+		if m.persistentPeersStore != nil {
+			if err := m.persistentPeersStore.Delete(lo.PanicOnErr(key.Bytes())); err != nil {
+				resultErr = errors.Wrapf(err, "failed to delete stored peer %s", key)
+			}
+		}
 	}
 
 	return resultErr
@@ -190,6 +222,8 @@ func (m *Manager) Start() {
 			m.onGossipNeighborAdded(event.Neighbor)
 		}, event.WithWorkerPool(m.workerPool))
 		m.isStarted.Store(true)
+
+		m.addPersistentPeersFromStore()
 	})
 }
 
@@ -288,6 +322,37 @@ func (m *Manager) addPeer(p *KnownPeerToAdd) error {
 	return nil
 }
 
+func (m *Manager) addPersistentPeersFromStore() {
+	if m.persistentPeersStore == nil {
+		return
+	}
+
+	peers := make([]*KnownPeerToAdd, 0)
+	if err := m.persistentPeersStore.Iterate(kvstore.EmptyPrefix, func(key kvstore.Key, value kvstore.Value) bool {
+		publicKey, _, err := ed25519.PublicKeyFromBytes(key)
+		if err != nil {
+			m.log.Errorw("Failed to parse stored peer", "err", err)
+			return true
+		}
+
+		peers = append(peers, &KnownPeerToAdd{
+			PublicKey: publicKey,
+			Address:   string(value),
+		})
+
+		return true
+	}); err != nil {
+		m.log.Errorw("Failed to read stored peers", "err", err)
+	}
+
+	if len(peers) != 0 {
+		m.log.Infow("Adding stored peers to the list of known peers in manual peering", "peers", peers)
+		if err := m.AddPeer(peers...); err != nil {
+			m.log.Errorw("Failed to add stored peers", "err", err)
+		}
+	}
+}
+
 func (m *Manager) removePeer(key ed25519.PublicKey) error {
 	m.knownPeersMutex.Lock()
 	defer m.knownPeersMutex.Unlock()
@@ -416,3 +481,10 @@ func (m *Manager) connectionDirection(peerPK ed25519.PublicKey) (ConnectionDirec
 		)
 	}
 }
+
+// WithPersistentPeersStore sets the store that is used to persist the peers that were added via AddPersistentPeer.
+func WithPersistentPeersStore(store kvstore.KVStore) options.Option[Manager] {
+	return func(m *Manager) {
+		m.persistentPeersStore = store
+	}
+}
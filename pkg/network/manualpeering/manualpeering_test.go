@@ -0,0 +1,95 @@
+package manualpeering
+
+import (
+	"net"
+	"testing"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/autopeering/peer"
+	"github.com/iotaledger/hive.go/autopeering/peer/service"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/logger"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/iota-core/pkg/network/p2p"
+)
+
+var log = logger.NewExampleLogger("manualpeering_test")
+
+func TestManager_PersistentPeers(t *testing.T) {
+	persistentPeersStore := mapdb.NewMapDB()
+	local := newTestLocal(t)
+	knownPeer := &KnownPeerToAdd{
+		PublicKey: ed25519.GenerateKeyPair().PublicKey,
+		Address:   "127.0.0.1:15600",
+	}
+
+	// a peer that is added at runtime is stored.
+	manager := newTestManager(t, local, persistentPeersStore)
+	require.NoError(t, manager.AddPersistentPeer(knownPeer))
+	requireKnownPeers(t, manager, knownPeer)
+
+	storedAddress, err := persistentPeersStore.Get(lo.PanicOnErr(knownPeer.PublicKey.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, knownPeer.Address, string(storedAddress))
+
+	require.NoError(t, manager.Stop())
+
+	// the stored peer is added again by a manager that is created on the same store (e.g. after a restart).
+	restartedManager := newTestManager(t, local, persistentPeersStore)
+	requireKnownPeers(t, restartedManager, knownPeer)
+
+	// removing the peer deletes it from the store.
+	require.NoError(t, restartedManager.RemovePeer(knownPeer.PublicKey))
+	requireKnownPeers(t, restartedManager)
+
+	_, err = persistentPeersStore.Get(lo.PanicOnErr(knownPeer.PublicKey.Bytes()))
+	require.ErrorIs(t, err, kvstore.ErrKeyNotFound)
+
+	require.NoError(t, restartedManager.Stop())
+}
+
+func newTestLocal(t *testing.T) *peer.Local {
+	peerDB, err := peer.NewDB(mapdb.NewMapDB())
+	require.NoError(t, err)
+
+	services := service.New()
+	services.Update(service.PeeringKey, "tcp", 0)
+
+	local, err := peer.NewLocal(net.IPv4(127, 0, 0, 1), services, peerDB)
+	require.NoError(t, err)
+
+	return local
+}
+
+// newTestManager creates a started Manager that uses a p2p.Manager without any registered protocols, so that it never
+// establishes a connection to the known peers.
+func newTestManager(t *testing.T, local *peer.Local, persistentPeersStore kvstore.KVStore) *Manager {
+	workerPool := workerpool.New(t.Name(), 1)
+	t.Cleanup(func() { workerPool.Shutdown() })
+
+	libp2pHost, err := libp2p.New(libp2p.NoListenAddrs, libp2p.DisableRelay())
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, libp2pHost.Close()) })
+
+	manager := NewManager(p2p.NewManager(libp2pHost, local, log), local, workerPool, log, WithPersistentPeersStore(persistentPeersStore))
+	manager.Start()
+
+	return manager
+}
+
+func requireKnownPeers(t *testing.T, manager *Manager, expectedPeers ...*KnownPeerToAdd) {
+	knownPeers := make([]*KnownPeerToAdd, 0)
+	for _, knownPeer := range manager.GetPeers() {
+		knownPeers = append(knownPeers, &KnownPeerToAdd{
+			PublicKey: knownPeer.PublicKey,
+			Address:   knownPeer.Address,
+		})
+	}
+
+	require.ElementsMatch(t, expectedPeers, knownPeers)
+}
@@ -0,0 +1,23 @@
+package core
+
+import (
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// SlotAttestations contains the attestations of a committed slot together with the roots of its commitment, so that
+// the receiver can verify them against the RootsID of the commitment.
+type SlotAttestations struct {
+	Index        iotago.SlotIndex      `serix:"0"`
+	Roots        *iotago.Roots         `serix:"1"`
+	Attestations []*iotago.Attestation `serix:"2,lengthPrefixType=uint32"`
+}
+
+// attestationsPayload is the wire format of the attestations of a range of slots.
+type attestationsPayload struct {
+	Slots []*SlotAttestations `serix:"0,lengthPrefixType=uint32"`
+}
+
+// blockIDsPayload is the wire format of the block IDs that are sent along with the attestations or a warp sync response.
+type blockIDsPayload struct {
+	BlockIDs iotago.BlockIDs `serix:"0,lengthPrefixType=uint32"`
+}
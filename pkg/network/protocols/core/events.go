@@ -14,6 +14,8 @@ type Events struct {
 	SlotCommitmentRequestReceived *event.Event2[iotago.CommitmentID, network.PeerID]
 	AttestationsReceived          *event.Event1[*AttestationsReceivedEvent]
 	AttestationsRequestReceived   *event.Event1[*AttestationsRequestReceivedEvent]
+	WarpSyncRequestReceived       *event.Event2[iotago.CommitmentID, network.PeerID]
+	WarpSyncResponseReceived      *event.Event4[iotago.CommitmentID, iotago.BlockIDs, *iotago.Roots, network.PeerID]
 	Error                         *event.Event2[error, network.PeerID]
 
 	event.Group[Events, *Events]
@@ -28,15 +30,17 @@ var NewEvents = event.CreateGroupConstructor(func() (newEvents *Events) {
 		SlotCommitmentRequestReceived: event.New2[iotago.CommitmentID, network.PeerID](),
 		AttestationsReceived:          event.New1[*AttestationsReceivedEvent](),
 		AttestationsRequestReceived:   event.New1[*AttestationsRequestReceivedEvent](),
+		WarpSyncRequestReceived:       event.New2[iotago.CommitmentID, network.PeerID](),
+		WarpSyncResponseReceived:      event.New4[iotago.CommitmentID, iotago.BlockIDs, *iotago.Roots, network.PeerID](),
 		Error:                         event.New2[error, network.PeerID](),
 	}
 })
 
 type AttestationsReceivedEvent struct {
-	Commitment *iotago.Commitment
-	BlockIDs   iotago.BlockIDs
-	//Attestations *orderedmap.OrderedMap[iotago.SlotIndex, *advancedset.AdvancedSet[*notarization.Attestation]]
-	Source network.PeerID
+	ForkingPoint *model.Commitment
+	BlockIDs     iotago.BlockIDs
+	Attestations []*SlotAttestations
+	Source       network.PeerID
 }
 
 type AttestationsRequestReceivedEvent struct {
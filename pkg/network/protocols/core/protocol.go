@@ -71,13 +71,27 @@ func (p *Protocol) SendSlotCommitment(cm *model.Commitment, to ...network.PeerID
 	}}}, protocolID, to...)
 }
 
-// func (p *Protocol) SendAttestations(cm *commitment.Commitment, blockIDs models.BlockIDs, attestations *orderedmap.OrderedMap[iotago.SlotIndex, *advancedset.AdvancedSet[*notarization.Attestation]], to ...network.PeerID) {
-//	p.network.Send(&nwmodels.Packet{Body: &nwmodels.Packet_Attestations{Attestations: &nwmodels.Attestations{
-//		Commitment:   lo.PanicOnErr(cm.Bytes()),
-//		BlocksIds:    lo.PanicOnErr(blockIDs.Bytes()),
-//		Attestations: lo.PanicOnErr(attestations.Encode()),
-//	}}}, protocolID, to...)
-// }
+func (p *Protocol) SendAttestations(forkingPoint *model.Commitment, blockIDs iotago.BlockIDs, attestations []*SlotAttestations, to ...network.PeerID) {
+	blockIDsBytes, err := p.api.Encode(&blockIDsPayload{BlockIDs: blockIDs})
+	if err != nil {
+		p.Events.Error.Trigger(errors.Wrap(err, "failed to serialize block IDs"), p.network.LocalPeerID())
+
+		return
+	}
+
+	attestationsBytes, err := p.api.Encode(&attestationsPayload{Slots: attestations})
+	if err != nil {
+		p.Events.Error.Trigger(errors.Wrap(err, "failed to serialize attestations"), p.network.LocalPeerID())
+
+		return
+	}
+
+	p.network.Send(&nwmodels.Packet{Body: &nwmodels.Packet_Attestations{Attestations: &nwmodels.Attestations{
+		Commitment:   forkingPoint.Data(),
+		BlocksIds:    blockIDsBytes,
+		Attestations: attestationsBytes,
+	}}}, protocolID, to...)
+}
 
 func (p *Protocol) RequestCommitment(id iotago.CommitmentID, to ...network.PeerID) {
 	p.network.Send(&nwmodels.Packet{Body: &nwmodels.Packet_SlotCommitmentRequest{SlotCommitmentRequest: &nwmodels.SlotCommitmentRequest{
@@ -92,6 +106,34 @@ func (p *Protocol) RequestAttestations(cm *model.Commitment, endIndex iotago.Slo
 	}}}, protocolID, to...)
 }
 
+func (p *Protocol) RequestWarpSync(id iotago.CommitmentID, to ...network.PeerID) {
+	p.network.Send(&nwmodels.Packet{Body: &nwmodels.Packet_WarpSyncRequest{WarpSyncRequest: &nwmodels.WarpSyncRequest{
+		CommitmentId: id[:],
+	}}}, protocolID, to...)
+}
+
+func (p *Protocol) SendWarpSyncResponse(id iotago.CommitmentID, blockIDs iotago.BlockIDs, roots *iotago.Roots, to ...network.PeerID) {
+	blockIDsBytes, err := p.api.Encode(&blockIDsPayload{BlockIDs: blockIDs})
+	if err != nil {
+		p.Events.Error.Trigger(errors.Wrap(err, "failed to serialize block IDs"), p.network.LocalPeerID())
+
+		return
+	}
+
+	rootsBytes, err := p.api.Encode(roots)
+	if err != nil {
+		p.Events.Error.Trigger(errors.Wrap(err, "failed to serialize roots"), p.network.LocalPeerID())
+
+		return
+	}
+
+	p.network.Send(&nwmodels.Packet{Body: &nwmodels.Packet_WarpSyncResponse{WarpSyncResponse: &nwmodels.WarpSyncResponse{
+		CommitmentId: id[:],
+		BlockIds:     blockIDsBytes,
+		Roots:        rootsBytes,
+	}}}, protocolID, to...)
+}
+
 func (p *Protocol) Shutdown() {
 	p.network.UnregisterProtocol(protocolID)
 
@@ -110,13 +152,19 @@ func (p *Protocol) handlePacket(nbr network.PeerID, packet proto.Message) (err e
 	case *nwmodels.Packet_SlotCommitmentRequest:
 		p.workerPool.Submit(func() { p.onSlotCommitmentRequest(packetBody.SlotCommitmentRequest.GetId(), nbr) })
 	case *nwmodels.Packet_Attestations:
-		// p.workerPool.Submit(func() {
-		//	p.onAttestations(packetBody.Attestations.GetCommitment(), packetBody.Attestations.GetBlocksIds(), packetBody.Attestations.GetAttestations(), nbr)
-		// })
+		p.workerPool.Submit(func() {
+			p.onAttestations(packetBody.Attestations.GetCommitment(), packetBody.Attestations.GetBlocksIds(), packetBody.Attestations.GetAttestations(), nbr)
+		})
 	case *nwmodels.Packet_AttestationsRequest:
 		p.workerPool.Submit(func() {
 			p.onAttestationsRequest(packetBody.AttestationsRequest.GetCommitment(), packetBody.AttestationsRequest.GetEndIndex(), nbr)
 		})
+	case *nwmodels.Packet_WarpSyncRequest:
+		p.workerPool.Submit(func() { p.onWarpSyncRequest(packetBody.WarpSyncRequest.GetCommitmentId(), nbr) })
+	case *nwmodels.Packet_WarpSyncResponse:
+		p.workerPool.Submit(func() {
+			p.onWarpSyncResponse(packetBody.WarpSyncResponse.GetCommitmentId(), packetBody.WarpSyncResponse.GetBlockIds(), packetBody.WarpSyncResponse.GetRoots(), nbr)
+		})
 	default:
 		return errors.Errorf("unsupported packet; packet=%+v, packetBody=%T-%+v", packet, packetBody, packetBody)
 	}
@@ -179,44 +227,35 @@ func (p *Protocol) onSlotCommitmentRequest(idBytes []byte, id network.PeerID) {
 	p.Events.SlotCommitmentRequestReceived.Trigger(iotago.CommitmentID(idBytes), id)
 }
 
-// func (p *Protocol) onAttestations(commitmentBytes []byte, blockIDBytes []byte, attestationsBytes []byte, id network.PeerID) {
-//	cm := &commitment.Commitment{}
-//	if _, err := cm.FromBytes(commitmentBytes); err != nil {
-//		p.Events.Error.Trigger(&ErrorEvent{
-//			Error:  errors.Wrap(err, "failed to deserialize commitment"),
-//			Source: id,
-//		})
-//
-//		return
-//	}
-//
-//	blockIDs := models.NewBlockIDs()
-//	if _, err := blockIDs.FromBytes(blockIDBytes); err != nil {
-//		p.Events.Error.Trigger(&ErrorEvent{
-//			Error:  errors.Wrap(err, "failed to deserialize blockIDs"),
-//			Source: id,
-//		})
-//
-//		return
-//	}
-//
-//	attestations := orderedmap.New[iotago.SlotIndex, *advancedset.AdvancedSet[*notarization.Attestation]]()
-//	if _, err := attestations.Decode(attestationsBytes); err != nil {
-//		p.Events.Error.Trigger(&ErrorEvent{
-//			Error:  errors.Wrap(err, "failed to deserialize attestations"),
-//			Source: id,
-//		})
-//
-//		return
-//	}
-//
-//	p.Events.AttestationsReceived.Trigger(&AttestationsReceivedEvent{
-//		Commitment:   cm,
-//		BlockIDs:     blockIDs,
-//		Attestations: attestations,
-//		Source:       id,
-//	})
-// }
+func (p *Protocol) onAttestations(commitmentBytes []byte, blockIDsBytes []byte, attestationsBytes []byte, id network.PeerID) {
+	forkingPoint, err := model.CommitmentFromBytes(commitmentBytes, p.api, serix.WithValidation())
+	if err != nil {
+		p.Events.Error.Trigger(errors.Wrap(err, "failed to deserialize commitment"), id)
+
+		return
+	}
+
+	blockIDs := new(blockIDsPayload)
+	if _, err = p.api.Decode(blockIDsBytes, blockIDs, serix.WithValidation()); err != nil {
+		p.Events.Error.Trigger(errors.Wrap(err, "failed to deserialize block IDs"), id)
+
+		return
+	}
+
+	attestations := new(attestationsPayload)
+	if _, err = p.api.Decode(attestationsBytes, attestations, serix.WithValidation()); err != nil {
+		p.Events.Error.Trigger(errors.Wrap(err, "failed to deserialize attestations"), id)
+
+		return
+	}
+
+	p.Events.AttestationsReceived.Trigger(&AttestationsReceivedEvent{
+		ForkingPoint: forkingPoint,
+		BlockIDs:     blockIDs.BlockIDs,
+		Attestations: attestations.Slots,
+		Source:       id,
+	})
+}
 
 func (p *Protocol) onAttestationsRequest(commitmentBytes []byte, slotIndexBytes []byte, id network.PeerID) {
 	cm := new(iotago.Commitment)
@@ -240,6 +279,40 @@ func (p *Protocol) onAttestationsRequest(commitmentBytes []byte, slotIndexBytes
 	})
 }
 
+func (p *Protocol) onWarpSyncRequest(idBytes []byte, id network.PeerID) {
+	if len(idBytes) != iotago.CommitmentIDLength {
+		p.Events.Error.Trigger(errors.Wrap(iotago.ErrInvalidIdentifierLength, "failed to deserialize warp sync request"), id)
+
+		return
+	}
+
+	p.Events.WarpSyncRequestReceived.Trigger(iotago.CommitmentID(idBytes), id)
+}
+
+func (p *Protocol) onWarpSyncResponse(idBytes []byte, blockIDsBytes []byte, rootsBytes []byte, id network.PeerID) {
+	if len(idBytes) != iotago.CommitmentIDLength {
+		p.Events.Error.Trigger(errors.Wrap(iotago.ErrInvalidIdentifierLength, "failed to deserialize warp sync response"), id)
+
+		return
+	}
+
+	blockIDs := new(blockIDsPayload)
+	if _, err := p.api.Decode(blockIDsBytes, blockIDs, serix.WithValidation()); err != nil {
+		p.Events.Error.Trigger(errors.Wrap(err, "failed to deserialize block IDs"), id)
+
+		return
+	}
+
+	roots := new(iotago.Roots)
+	if _, err := p.api.Decode(rootsBytes, roots, serix.WithValidation()); err != nil {
+		p.Events.Error.Trigger(errors.Wrap(err, "failed to deserialize roots"), id)
+
+		return
+	}
+
+	p.Events.WarpSyncResponseReceived.Trigger(iotago.CommitmentID(idBytes), blockIDs.BlockIDs, roots, id)
+}
+
 func newPacket() proto.Message {
 	return &nwmodels.Packet{}
 }
@@ -17,10 +17,11 @@ type ChainCommitment struct {
 	id         iotago.CommitmentID
 	commitment *model.Commitment
 
-	solid       bool
-	mainChildID iotago.CommitmentID
-	children    *shrinkingmap.ShrinkingMap[iotago.CommitmentID, *ChainCommitment]
-	chain       *Chain
+	solid          bool
+	attestedWeight uint64
+	mainChildID    iotago.CommitmentID
+	children       *shrinkingmap.ShrinkingMap[iotago.CommitmentID, *ChainCommitment]
+	chain          *Chain
 
 	mutex sync.RWMutex
 }
@@ -78,6 +79,26 @@ func (c *ChainCommitment) SetSolid(solid bool) (updated bool) {
 	return
 }
 
+// AttestedWeight returns the cumulative weight of the commitment that was verified through its attestations.
+func (c *ChainCommitment) AttestedWeight() uint64 {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return c.attestedWeight
+}
+
+// SetAttestedWeight sets the cumulative weight of the commitment that was verified through its attestations.
+func (c *ChainCommitment) SetAttestedWeight(weight uint64) (updated bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if updated = c.attestedWeight != weight; updated {
+		c.attestedWeight = weight
+	}
+
+	return
+}
+
 func (c *ChainCommitment) PublishCommitment(commitment *model.Commitment) (published bool) {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
@@ -122,7 +143,7 @@ func (c *ChainCommitment) setMainChild(commitment *ChainCommitment) error {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
-	if c.children.Has(commitment.ID()) {
+	if !c.children.Has(commitment.ID()) {
 		return errors.Errorf("trying to set a main child %s before registering it as a child", commitment.ID())
 	}
 	c.mainChildID = commitment.ID()
@@ -156,6 +177,7 @@ func (c *ChainCommitment) String() string {
 		stringify.NewStructField("ID", c.id),
 		stringify.NewStructField("Commitment", c.commitment.String()),
 		stringify.NewStructField("Solid", c.solid),
+		stringify.NewStructField("AttestedWeight", c.attestedWeight),
 		stringify.NewStructField("Chain", c.chain),
 		stringify.NewStructField("MainChildID", c.mainChildID),
 	)
@@ -20,6 +20,7 @@ import (
 var (
 	ErrCommitmentUnknown  = errors.New("unknown commitment")
 	ErrCommitmentNotSolid = errors.New("commitment not solid")
+	ErrForkNotAttested    = errors.New("fork not attested")
 )
 
 type Manager struct {
@@ -203,6 +204,82 @@ func (m *Manager) ForkByForkingPoint(forkingPoint iotago.CommitmentID) (fork *Fo
 	return m.forksByForkingPoint.Get(forkingPoint)
 }
 
+// Forks returns all forks that were detected and not evicted yet.
+func (m *Manager) Forks() (forks []*Fork) {
+	m.evictionMutex.RLock()
+	defer m.evictionMutex.RUnlock()
+
+	forks = make([]*Fork, 0)
+	m.forksByForkingPoint.ForEach(func(_ iotago.CommitmentID, fork *Fork) bool {
+		forks = append(forks, fork)
+		return true
+	})
+
+	return forks
+}
+
+// Chains returns all chains that contain at least one of the commitments that are known to the manager.
+func (m *Manager) Chains() (chains []*Chain) {
+	m.evictionMutex.RLock()
+	defer m.evictionMutex.RUnlock()
+
+	seenChains := make(map[*Chain]struct{})
+	chains = make([]*Chain, 0)
+	m.commitmentsByID.ForEach(func(_ iotago.SlotIndex, storage *shrinkingmap.ShrinkingMap[iotago.CommitmentID, *ChainCommitment]) {
+		storage.ForEach(func(_ iotago.CommitmentID, commitment *ChainCommitment) bool {
+			if chain := commitment.Chain(); chain != nil {
+				if _, seen := seenChains[chain]; !seen {
+					seenChains[chain] = struct{}{}
+					chains = append(chains, chain)
+				}
+			}
+
+			return true
+		})
+	})
+
+	return chains
+}
+
+// SetAttestedWeight sets the cumulative weight of the given commitment that was verified through its attestations.
+func (m *Manager) SetAttestedWeight(id iotago.CommitmentID, weight uint64) error {
+	m.evictionMutex.RLock()
+	defer m.evictionMutex.RUnlock()
+
+	commitment, _ := m.commitment(id)
+	if commitment == nil {
+		return errors.Wrapf(ErrCommitmentUnknown, "unknown commitment %s", id)
+	}
+
+	commitment.SetAttestedWeight(weight)
+
+	return nil
+}
+
+// AttestedCommitment returns the head of the fork with the given forking point if its cumulative weight was verified
+// through its attestations. Unlike the CumulativeWeight claimed by the commitments of a peer, its AttestedWeight is
+// what the fork choice relies on.
+func (m *Manager) AttestedCommitment(forkingPoint iotago.CommitmentID) (*ChainCommitment, error) {
+	m.evictionMutex.RLock()
+	defer m.evictionMutex.RUnlock()
+
+	fork, exists := m.forksByForkingPoint.Get(forkingPoint)
+	if !exists {
+		return nil, errors.Wrapf(ErrCommitmentUnknown, "unknown fork with forking point %s", forkingPoint)
+	}
+
+	commitment, _ := m.commitment(fork.Commitment.ID())
+	if commitment == nil {
+		return nil, errors.Wrapf(ErrCommitmentUnknown, "unknown commitment %s", fork.Commitment.ID())
+	}
+
+	if commitment.AttestedWeight() == 0 {
+		return nil, errors.Wrapf(ErrForkNotAttested, "fork with forking point %s was not attested", forkingPoint)
+	}
+
+	return commitment, nil
+}
+
 func (m *Manager) SwitchMainChain(head iotago.CommitmentID) error {
 	m.evictionMutex.RLock()
 	defer m.evictionMutex.RUnlock()
@@ -401,6 +478,11 @@ func (m *Manager) switchMainChainToCommitment(commitment *ChainCommitment) error
 			childWalker.PushAll(m.propagateReplaceChainToMainChild(childWalker.Next(), newChildChain)...)
 		}
 
+		// The commitments of the fork become part of the chain of the forking point's parent
+		for childWalker := walker.New[*ChainCommitment]().Push(fp); childWalker.HasNext(); {
+			childWalker.PushAll(m.propagateReplaceChainToMainChild(childWalker.Next(), fpParent.Chain())...)
+		}
+
 		if fp == forkingPoint {
 			break
 		}
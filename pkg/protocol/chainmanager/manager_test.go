@@ -165,6 +165,14 @@ func TestManager(t *testing.T) {
 		require.Nil(t, tf.Instance.Chain(iotago.SlotIdentifierRepresentingData(1, []byte{255, 255})))
 	}
 
+	{
+		require.ElementsMatch(t, []*Chain{tf.Chain("Genesis"), tf.Chain("1*"), tf.Chain("4*")}, tf.Instance.Chains())
+
+		forks := tf.Instance.Forks()
+		require.Len(t, forks, 1)
+		require.Equal(t, tf.SlotCommitment("4*"), forks[0].ForkingPoint.ID())
+	}
+
 	require.Eventually(t, func() bool {
 		select {
 		case <-forkDetected:
@@ -421,3 +429,107 @@ func TestProcessCommitment(t *testing.T) {
 		}
 	}
 }
+
+func TestManager_AttestedWeight(t *testing.T) {
+	tf := NewTestFramework(t, iotago.LatestAPI(&iotago.ProtocolParameters{}))
+	tf.CreateCommitment("1", "Genesis")
+	tf.CreateCommitment("2", "1")
+	tf.CreateCommitment("1*", "Genesis")
+	tf.CreateCommitment("2*", "1*")
+	tf.CreateCommitment("3*", "2*")
+	tf.CreateCommitment("4*", "3*")
+
+	tf.ProcessCommitment("1")
+	tf.ProcessCommitment("2")
+
+	require.Zero(t, tf.ChainCommitment("2").AttestedWeight())
+
+	require.NoError(t, tf.Instance.SetAttestedWeight(tf.SlotCommitment("2"), 42))
+	require.EqualValues(t, 42, tf.ChainCommitment("2").AttestedWeight())
+	require.Zero(t, tf.ChainCommitment("1").AttestedWeight())
+
+	require.ErrorIs(t, tf.Instance.SetAttestedWeight(tf.SlotCommitment("3*"), 42), ErrCommitmentUnknown)
+
+	// The fork choice only learns about the weight of a fork once its attestations were verified.
+	require.ErrorIs(t, lo.Return2(tf.Instance.AttestedCommitment(tf.SlotCommitment("1*"))), ErrCommitmentUnknown)
+
+	tf.ProcessCommitmentFromOtherSource("1*")
+	tf.ProcessCommitmentFromOtherSource("2*")
+	tf.ProcessCommitmentFromOtherSource("3*")
+	tf.ProcessCommitmentFromOtherSource("4*")
+	tf.AssertForkDetectedCount(1)
+
+	require.ErrorIs(t, lo.Return2(tf.Instance.AttestedCommitment(tf.SlotCommitment("1*"))), ErrForkNotAttested)
+
+	require.NoError(t, tf.Instance.SetAttestedWeight(tf.SlotCommitment("4*"), 100))
+
+	attestedCommitment, err := tf.Instance.AttestedCommitment(tf.SlotCommitment("1*"))
+	require.NoError(t, err)
+	require.Equal(t, tf.SlotCommitment("4*"), attestedCommitment.ID())
+	require.EqualValues(t, 100, attestedCommitment.AttestedWeight())
+}
+
+func TestManager_SwitchMainChain(t *testing.T) {
+	tf := NewTestFramework(t, iotago.LatestAPI(&iotago.ProtocolParameters{}))
+	tf.CreateCommitment("1", "Genesis")
+	tf.CreateCommitment("2", "1")
+	tf.CreateCommitment("3", "2")
+	tf.CreateCommitment("2*", "1")
+	tf.CreateCommitment("3*", "2*")
+	tf.CreateCommitment("4*", "3*")
+
+	tf.ProcessCommitment("1")
+	tf.ProcessCommitment("2")
+	tf.ProcessCommitment("3")
+	tf.ProcessCommitmentFromOtherSource("2*")
+	tf.ProcessCommitmentFromOtherSource("3*")
+	tf.ProcessCommitmentFromOtherSource("4*")
+
+	tf.AssertChainState(map[string]string{
+		"1":  "Genesis",
+		"2":  "Genesis",
+		"3":  "Genesis",
+		"2*": "2*",
+		"3*": "2*",
+		"4*": "2*",
+	})
+
+	require.NoError(t, tf.Instance.SwitchMainChain(tf.SlotCommitment("4*")))
+
+	// The fork is now part of the main chain and the old main chain is cut off at the forking point.
+	tf.AssertChainState(map[string]string{
+		"1":  "Genesis",
+		"2":  "2",
+		"3":  "",
+		"2*": "Genesis",
+		"3*": "Genesis",
+		"4*": "Genesis",
+	})
+	require.Equal(t, tf.ChainCommitment("2*"), tf.Instance.RootCommitment().Chain().Commitment(tf.SlotIndex("2*")))
+	require.Equal(t, tf.ChainCommitment("2*"), tf.ChainCommitment("1").mainChild())
+
+	// Switching to a commitment of the main chain does not change anything.
+	require.NoError(t, tf.Instance.SwitchMainChain(tf.SlotCommitment("3*")))
+	require.Equal(t, tf.ChainCommitment("2*"), tf.ChainCommitment("1").mainChild())
+}
+
+func TestManager_ProcessCommitmentOfFork(t *testing.T) {
+	tf := NewTestFramework(t, iotago.LatestAPI(&iotago.ProtocolParameters{}))
+	tf.CreateCommitment("1", "Genesis")
+	tf.CreateCommitment("2", "1")
+	tf.CreateCommitment("2*", "1")
+
+	tf.ProcessCommitment("1")
+	tf.ProcessCommitment("2")
+	tf.ProcessCommitmentFromOtherSource("2*")
+
+	// Our engine committing to a commitment that we already received from a peer switches the main chain to it.
+	isSolid, chain := tf.ProcessCommitment("2*")
+	require.True(t, isSolid)
+	tf.AssertChainIsAlias(chain, "Genesis")
+	tf.AssertChainState(map[string]string{
+		"1":  "Genesis",
+		"2":  "2",
+		"2*": "Genesis",
+	})
+}
@@ -2,6 +2,7 @@ package blockdag
 
 import (
 	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/iota-core/pkg/model"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
 )
 
@@ -23,6 +24,9 @@ type Events struct {
 	BlockInvalid *event.Event2[*blocks.Block, error]
 	// TODO: hook this up in engine
 
+	// ValidatorEquivocated is triggered when a member of the committee issued two blocks that contradict each other.
+	ValidatorEquivocated *event.Event1[*model.Equivocation]
+
 	event.Group[Events, *Events]
 }
 
@@ -34,5 +38,6 @@ var NewEvents = event.CreateGroupConstructor(func() (newEvents *Events) {
 		BlockMissing:         event.New1[*blocks.Block](),
 		MissingBlockAttached: event.New1[*blocks.Block](),
 		BlockInvalid:         event.New2[*blocks.Block, error](),
+		ValidatorEquivocated: event.New1[*model.Equivocation](),
 	}
 })
@@ -9,6 +9,7 @@ import (
 	"github.com/iotaledger/hive.go/core/causalorder"
 	"github.com/iotaledger/hive.go/core/memstorage"
 	"github.com/iotaledger/hive.go/ds/advancedset"
+	"github.com/iotaledger/hive.go/ds/shrinkingmap"
 	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/hive.go/runtime/event"
 	"github.com/iotaledger/hive.go/runtime/module"
@@ -19,6 +20,7 @@ import (
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blockdag"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/eviction"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/notarization"
 	iotago "github.com/iotaledger/iota.go/v4"
 )
 
@@ -43,6 +45,19 @@ type BlockDAG struct {
 
 	blockCache *blocks.Blocks
 
+	// isCommitteeMemberFunc is a function that returns true if the given issuer is a member of the committee.
+	isCommitteeMemberFunc func(issuerID iotago.AccountID) bool
+
+	// blocksByCommitment contains the first block (indexed by its slot) of every committee member that committed to a
+	// given slot.
+	blocksByCommitment *memstorage.IndexedStorage[iotago.SlotIndex, commitmentKey, *blocks.Block]
+
+	// blocksByIssuingTime contains the first block (indexed by its slot) of every committee member that was issued at
+	// a given time.
+	blocksByIssuingTime *memstorage.IndexedStorage[iotago.SlotIndex, issuingTimeKey, *blocks.Block]
+
+	equivocationsMutex sync.Mutex
+
 	// The Queue always read-locks the eviction mutex of the solidifier, and then evaluates if the block is
 	// future thus read-locking the futureBlocks mutex. At the same time, when re-adding parked blocks,
 	// promoteFutureBlocksMethod write-locks the futureBlocks mutex, and then read-locks the eviction mutex
@@ -63,6 +78,16 @@ type BlockDAG struct {
 func NewProvider(opts ...options.Option[BlockDAG]) module.Provider[*engine.Engine, blockdag.BlockDAG] {
 	return module.Provide(func(e *engine.Engine) blockdag.BlockDAG {
 		b := New(e.Workers.CreateGroup("BlockDAG"), e.EvictionState, e.BlockCache, e.Storage.Commitments().Load, e.ErrorHandler("blockdag"), opts...)
+		b.isCommitteeMemberFunc = func(issuerID iotago.AccountID) bool {
+			return e.SybilProtection.Committee().Has(issuerID)
+		}
+
+		e.Storage.Settings().HookInitialized(func() {
+			b.futureBlocksMutex.Lock()
+			defer b.futureBlocksMutex.Unlock()
+
+			b.nextIndexToPromote = e.Storage.Settings().LatestCommitment().Index() + 1
+		})
 
 		e.HookConstructed(func() {
 			e.Events.Filter.BlockAllowed.Hook(func(block *model.Block) {
@@ -71,6 +96,11 @@ func NewProvider(opts ...options.Option[BlockDAG]) module.Provider[*engine.Engin
 				}
 			}, event.WithWorkerPool(b.workers.CreatePool("BlockDAG.Attach", 2)))
 
+			// Blocks that commit to a slot that we didn't commit yet are only solidified once we committed it as well.
+			e.Events.Notarization.SlotCommitted.Hook(func(details *notarization.SlotCommittedDetails) {
+				b.PromoteFutureBlocksUntil(details.Commitment.Index())
+			})
+
 			e.Events.BlockDAG.LinkTo(b.events)
 
 			b.TriggerInitialized()
@@ -91,6 +121,10 @@ func New(workers *workerpool.Group, evictionState *eviction.State, blockCache *b
 		workers:        workers,
 		workerPool:     workers.CreatePool("Solidifier", 2),
 		errorHandler:   errorHandler,
+
+		isCommitteeMemberFunc: func(iotago.AccountID) bool { return false },
+		blocksByCommitment:    memstorage.NewIndexedStorage[iotago.SlotIndex, commitmentKey, *blocks.Block](),
+		blocksByIssuingTime:   memstorage.NewIndexedStorage[iotago.SlotIndex, issuingTimeKey, *blocks.Block](),
 	}, opts,
 		func(b *BlockDAG) {
 			b.solidifier = causalorder.New(
@@ -117,6 +151,8 @@ func (b *BlockDAG) Attach(data *model.Block) (block *blocks.Block, wasAttached b
 	if block, wasAttached, err = b.attach(data); wasAttached {
 		b.events.BlockAttached.Trigger(block)
 
+		b.detectEquivocations(block)
+
 		b.solidifierMutex.RLock()
 		defer b.solidifierMutex.RUnlock()
 
@@ -138,6 +174,12 @@ func (b *BlockDAG) SetInvalid(block *blocks.Block, reason error) (wasUpdated boo
 func (b *BlockDAG) PromoteFutureBlocksUntil(index iotago.SlotIndex) {
 	b.solidifierMutex.RLock()
 	defer b.solidifierMutex.RUnlock()
+
+	// The notarization keeps committing slots while the BlockDAG shuts down, but its blocks are not processed anymore.
+	if b.WasStopped() {
+		return
+	}
+
 	b.futureBlocksMutex.Lock()
 	defer b.futureBlocksMutex.Unlock()
 
@@ -161,7 +203,11 @@ func (b *BlockDAG) PromoteFutureBlocksUntil(index iotago.SlotIndex) {
 }
 
 func (b *BlockDAG) Shutdown() {
+	// Wait for pending promotions of future blocks, so that no blocks are queued after the workers were shut down.
+	b.solidifierMutex.Lock()
 	b.TriggerStopped()
+	b.solidifierMutex.Unlock()
+
 	b.workers.Shutdown()
 }
 
@@ -171,6 +217,55 @@ func (b *BlockDAG) evictSlot(index iotago.SlotIndex) {
 	defer b.solidifierMutex.Unlock()
 
 	b.solidifier.EvictUntil(index)
+
+	b.equivocationsMutex.Lock()
+	defer b.equivocationsMutex.Unlock()
+
+	b.blocksByCommitment.Evict(index)
+	b.blocksByIssuingTime.Evict(index)
+}
+
+// detectEquivocations checks if the issuer of the given block is a member of the committee that already issued another
+// block at the same issuing time or another block that commits to a different commitment of the same slot. Blocks of
+// different issuing times that commit to the same commitment do not contradict each other and are therefore not
+// considered to be equivocations.
+func (b *BlockDAG) detectEquivocations(block *blocks.Block) {
+	issuerID := block.Block().IssuerID
+	if !b.isCommitteeMemberFunc(issuerID) {
+		return
+	}
+
+	byCommitmentKey := commitmentKey{issuerID, block.SlotCommitmentID().Index()}
+	byIssuingTimeKey := issuingTimeKey{issuerID, block.Block().IssuingTime.UnixNano()}
+
+	b.equivocationsMutex.Lock()
+
+	// the blocks issued at a given time always belong to the same slot.
+	var conflictingBlock *blocks.Block
+	if blocksByIssuingTime := b.blocksByIssuingTime.Get(block.ID().Index()); blocksByIssuingTime != nil {
+		if existingBlock, exists := blocksByIssuingTime.Get(byIssuingTimeKey); exists && existingBlock.ID() != block.ID() {
+			conflictingBlock = existingBlock
+		}
+	}
+
+	// the blocks are indexed by their own slot, so that they are evicted together with the blocks of the slot, but
+	// blocks of other (non-evicted) slots can commit to the same slot.
+	b.blocksByCommitment.ForEach(func(_ iotago.SlotIndex, blocksByCommitment *shrinkingmap.ShrinkingMap[commitmentKey, *blocks.Block]) {
+		if existingBlock, exists := blocksByCommitment.Get(byCommitmentKey); exists && conflictingBlock == nil && existingBlock.SlotCommitmentID() != block.SlotCommitmentID() {
+			conflictingBlock = existingBlock
+		}
+	})
+
+	if conflictingBlock == nil && block.ID().Index() > b.evictionState.LastEvictedSlot() {
+		b.blocksByCommitment.Get(block.ID().Index(), true).GetOrCreate(byCommitmentKey, func() *blocks.Block { return block })
+		b.blocksByIssuingTime.Get(block.ID().Index(), true).GetOrCreate(byIssuingTimeKey, func() *blocks.Block { return block })
+	}
+
+	b.equivocationsMutex.Unlock()
+
+	if conflictingBlock != nil {
+		b.events.ValidatorEquivocated.Trigger(model.NewEquivocation(conflictingBlock.ModelBlock(), block.ModelBlock()))
+	}
 }
 
 func (b *BlockDAG) markSolid(block *blocks.Block) (err error) {
@@ -327,3 +422,15 @@ func checkReference(child *blocks.Block, parent *blocks.Block) (err error) {
 
 	return nil
 }
+
+// commitmentKey is the key that is used to look up the blocks of an issuer by the slot of their commitment.
+type commitmentKey struct {
+	issuerID iotago.AccountID
+	slot     iotago.SlotIndex
+}
+
+// issuingTimeKey is the key that is used to look up the blocks of an issuer by their issuing time (in nanoseconds).
+type issuingTimeKey struct {
+	issuerID    iotago.AccountID
+	issuingTime int64
+}
@@ -0,0 +1,88 @@
+package inmemoryblockdag
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/eviction"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate/tpkg"
+	"github.com/iotaledger/iota-core/pkg/storage/prunable"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestBlockDAG_DetectEquivocations(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	defer workers.Shutdown()
+
+	evictionState := eviction.NewState(func(iotago.SlotIndex) *prunable.RootBlocks { return nil })
+	blockDAG := New(workers, evictionState, blocks.New(evictionState, tpkg.API().SlotTimeProvider), func(iotago.SlotIndex) (*model.Commitment, error) {
+		return nil, nil
+	}, func(err error) { require.NoError(t, err) })
+	blockDAG.isCommitteeMemberFunc = func(issuerID iotago.AccountID) bool {
+		return issuerID != iotago.AccountID{3}
+	}
+
+	equivocations := make([]*model.Equivocation, 0)
+	blockDAG.events.ValidatorEquivocated.Hook(func(equivocation *model.Equivocation) {
+		equivocations = append(equivocations, equivocation)
+	})
+
+	commitment := iotago.NewCommitment(1, iotago.CommitmentID{1}, iotago.Identifier{1}, 1)
+	conflictingCommitment := iotago.NewCommitment(1, iotago.CommitmentID{1}, iotago.Identifier{2}, 1)
+	issuingTime := tpkg.API().SlotTimeProvider().StartTime(5)
+
+	// blocks of different issuing times that commit to the same commitment do not contradict each other.
+	block1 := newTestBlock(t, iotago.AccountID{1}, issuingTime, commitment, 1)
+	block2 := newTestBlock(t, iotago.AccountID{1}, issuingTime.Add(time.Second), commitment, 1)
+	blockDAG.detectEquivocations(block1)
+	blockDAG.detectEquivocations(block2)
+	blockDAG.detectEquivocations(block1)
+	require.Empty(t, equivocations)
+
+	// different blocks of the same issuer that were issued at the same time are equivocations.
+	block3 := newTestBlock(t, iotago.AccountID{1}, issuingTime, commitment, 2)
+	blockDAG.detectEquivocations(block3)
+	require.Len(t, equivocations, 1)
+	require.Equal(t, iotago.AccountID{1}, equivocations[0].IssuerID())
+	require.Equal(t, model.NewEquivocation(block1.ModelBlock(), block3.ModelBlock()).Key(), equivocations[0].Key())
+
+	// blocks of the same issuer that commit to different commitments of the same slot are equivocations, even if they
+	// are issued in different slots.
+	block4 := newTestBlock(t, iotago.AccountID{1}, tpkg.API().SlotTimeProvider().StartTime(6), conflictingCommitment, 1)
+	blockDAG.detectEquivocations(block4)
+	require.Len(t, equivocations, 2)
+	require.Equal(t, iotago.AccountID{1}, equivocations[1].IssuerID())
+	require.Equal(t, model.NewEquivocation(block1.ModelBlock(), block4.ModelBlock()).Key(), equivocations[1].Key())
+
+	// conflicting blocks of different issuers or of issuers that are not part of the committee are ignored.
+	blockDAG.detectEquivocations(newTestBlock(t, iotago.AccountID{2}, issuingTime, commitment, 1))
+	blockDAG.detectEquivocations(newTestBlock(t, iotago.AccountID{3}, issuingTime, commitment, 1))
+	blockDAG.detectEquivocations(newTestBlock(t, iotago.AccountID{3}, issuingTime, conflictingCommitment, 1))
+	blockDAG.detectEquivocations(newTestBlock(t, iotago.AccountID{3}, issuingTime.Add(time.Second), conflictingCommitment, 2))
+	require.Len(t, equivocations, 2)
+
+	// blocks of evicted slots are not used as evidence anymore.
+	blockDAG.evictSlot(block1.ID().Index())
+	blockDAG.detectEquivocations(newTestBlock(t, iotago.AccountID{2}, tpkg.API().SlotTimeProvider().StartTime(6), conflictingCommitment, 2))
+	require.Len(t, equivocations, 2)
+}
+
+func newTestBlock(t *testing.T, issuerID iotago.AccountID, issuingTime time.Time, commitment *iotago.Commitment, nonce uint64) *blocks.Block {
+	modelBlock, err := model.BlockFromBlock(&iotago.Block{
+		ProtocolVersion: tpkg.ProtocolParams().Version,
+		IssuerID:        issuerID,
+		IssuingTime:     issuingTime,
+		SlotCommitment:  commitment,
+		StrongParents:   iotago.StrongParentsIDs{iotago.EmptyBlockID()},
+		Signature:       &iotago.Ed25519Signature{},
+		Nonce:           nonce,
+	}, tpkg.API())
+	require.NoError(t, err)
+
+	return blocks.NewBlock(modelBlock)
+}
@@ -32,12 +32,20 @@ type Block struct {
 	// payloadConflictIDs are the conflictIDs of the block's payload (in case it is a transaction, otherwise empty).
 	payloadConflictIDs *advancedset.AdvancedSet[iotago.TransactionID]
 
+	// Scheduler block
+	scheduled bool
+	skipped   bool
+	dropped   bool
+
 	// BlockGadget block
 	accepted         bool
 	ratifiers        *advancedset.AdvancedSet[iotago.AccountID]
 	ratifiedAccepted bool
 	confirmed        bool
 
+	// Orphanage block
+	orphaned bool
+
 	mutex sync.RWMutex
 
 	modelBlock *model.Block
@@ -360,6 +368,66 @@ func (b *Block) SetPayloadConflictIDs(payloadConflictIDs *advancedset.AdvancedSe
 	b.payloadConflictIDs = payloadConflictIDs
 }
 
+// IsScheduled returns true if the Block was scheduled.
+func (b *Block) IsScheduled() bool {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	return b.scheduled
+}
+
+// SetScheduled sets the Block as scheduled.
+func (b *Block) SetScheduled() (wasUpdated bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if wasUpdated = !b.scheduled; wasUpdated {
+		b.scheduled = true
+	}
+
+	return wasUpdated
+}
+
+// IsSkipped returns true if the Block was skipped by the scheduler.
+func (b *Block) IsSkipped() bool {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	return b.skipped
+}
+
+// SetSkipped sets the Block as skipped by the scheduler.
+func (b *Block) SetSkipped() (wasUpdated bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if wasUpdated = !b.skipped; wasUpdated {
+		b.skipped = true
+	}
+
+	return wasUpdated
+}
+
+// IsDropped returns true if the Block was dropped by the scheduler.
+func (b *Block) IsDropped() bool {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	return b.dropped
+}
+
+// SetDropped sets the Block as dropped by the scheduler.
+func (b *Block) SetDropped() (wasUpdated bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if wasUpdated = !b.dropped; wasUpdated {
+		b.dropped = true
+	}
+
+	return wasUpdated
+}
+
 // IsAccepted returns true if the Block was accepted.
 func (b *Block) IsAccepted() bool {
 	b.mutex.RLock()
@@ -432,6 +500,26 @@ func (b *Block) SetConfirmed() (wasUpdated bool) {
 	return wasUpdated
 }
 
+// IsOrphaned returns true if the Block can no longer be accepted.
+func (b *Block) IsOrphaned() bool {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	return b.orphaned
+}
+
+// SetOrphaned sets the Block as orphaned.
+func (b *Block) SetOrphaned() (wasUpdated bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if wasUpdated = !b.orphaned; wasUpdated {
+		b.orphaned = true
+	}
+
+	return wasUpdated
+}
+
 func (b *Block) String() string {
 	b.mutex.RLock()
 	defer b.mutex.RUnlock()
@@ -443,10 +531,14 @@ func (b *Block) String() string {
 	builder.AddField(stringify.NewStructField("Future", b.future))
 	builder.AddField(stringify.NewStructField("Booked", b.booked))
 	builder.AddField(stringify.NewStructField("Witnesses", b.witnesses))
+	builder.AddField(stringify.NewStructField("Scheduled", b.scheduled))
+	builder.AddField(stringify.NewStructField("Skipped", b.skipped))
+	builder.AddField(stringify.NewStructField("Dropped", b.dropped))
 	builder.AddField(stringify.NewStructField("Accepted", b.accepted))
 	builder.AddField(stringify.NewStructField("Ratifiers", b.ratifiers))
 	builder.AddField(stringify.NewStructField("RatifiedAccepted", b.ratifiedAccepted))
 	builder.AddField(stringify.NewStructField("Confirmed", b.confirmed))
+	builder.AddField(stringify.NewStructField("Orphaned", b.orphaned))
 
 	for index, child := range b.strongChildren {
 		builder.AddField(stringify.NewStructField(fmt.Sprintf("strongChildren%d", index), child.ID().String()))
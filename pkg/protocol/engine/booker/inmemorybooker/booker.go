@@ -1,6 +1,8 @@
 package inmemorybooker
 
 import (
+	"sync"
+
 	"github.com/pkg/errors"
 
 	"github.com/iotaledger/hive.go/core/account"
@@ -31,6 +33,9 @@ type Booker struct {
 
 	blockCache *blocks.Blocks
 
+	lastEvictedSlot iotago.SlotIndex
+	evictionMutex   sync.RWMutex
+
 	conflictDAG conflictdag.ConflictDAG[iotago.TransactionID, iotago.OutputID, booker.BlockVotePower]
 
 	ledger ledger.Ledger
@@ -116,6 +121,21 @@ func (b *Booker) Shutdown() {
 
 func (b *Booker) evict(slotIndex iotago.SlotIndex) {
 	b.bookingOrder.EvictUntil(slotIndex)
+
+	b.evictionMutex.Lock()
+	defer b.evictionMutex.Unlock()
+
+	if slotIndex > b.lastEvictedSlot {
+		b.lastEvictedSlot = slotIndex
+	}
+}
+
+// isEvicted returns true if the blocks of the given slot were evicted already.
+func (b *Booker) isEvicted(index iotago.SlotIndex) bool {
+	b.evictionMutex.RLock()
+	defer b.evictionMutex.RUnlock()
+
+	return index <= b.lastEvictedSlot
 }
 
 func (b *Booker) book(block *blocks.Block) error {
@@ -161,6 +181,11 @@ func (b *Booker) trackWitnessWeight(votingBlock *blocks.Block) error {
 		blockID := walk.Next()
 		block, exists := b.blockCache.Block(blockID)
 		if !exists {
+			// The block was evicted after its child was attached, so its witnesses are not tracked anymore.
+			if b.isEvicted(blockID.Index()) {
+				continue
+			}
+
 			return errors.Errorf("parent %s does not exist", blockID)
 		}
 
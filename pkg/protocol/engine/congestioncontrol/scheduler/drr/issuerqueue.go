@@ -0,0 +1,123 @@
+package drr
+
+import (
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// issuerQueue contains the buffered blocks of a single issuer.
+type issuerQueue struct {
+	// issuerID is the identifier of the issuer of the blocks in the queue.
+	issuerID iotago.AccountID
+
+	// submitted contains the blocks that are waiting for their parents to be scheduled.
+	submitted map[iotago.BlockID]*blocks.Block
+
+	// ready contains the blocks that can be scheduled in the order in which they became ready.
+	ready []*blocks.Block
+
+	// deficit is the amount of blocks that the issuer is currently allowed to schedule.
+	deficit float64
+}
+
+// newIssuerQueue creates a new issuerQueue for the given issuer.
+func newIssuerQueue(issuerID iotago.AccountID) *issuerQueue {
+	return &issuerQueue{
+		issuerID:  issuerID,
+		submitted: make(map[iotago.BlockID]*blocks.Block),
+	}
+}
+
+// Size returns the total amount of blocks in the queue.
+func (q *issuerQueue) Size() int {
+	return len(q.submitted) + len(q.ready)
+}
+
+// HasReady returns true if the queue contains a block that can be scheduled.
+func (q *issuerQueue) HasReady() bool {
+	return len(q.ready) > 0
+}
+
+// Submit adds the given block to the blocks that are waiting for their parents.
+func (q *issuerQueue) Submit(block *blocks.Block) {
+	q.submitted[block.ID()] = block
+}
+
+// IsSubmitted returns true if the given block is waiting for its parents.
+func (q *issuerQueue) IsSubmitted(blockID iotago.BlockID) bool {
+	_, exists := q.submitted[blockID]
+
+	return exists
+}
+
+// Ready marks the given submitted block as ready to be scheduled.
+func (q *issuerQueue) Ready(block *blocks.Block) (wasReady bool) {
+	if _, exists := q.submitted[block.ID()]; !exists {
+		return false
+	}
+
+	delete(q.submitted, block.ID())
+	q.ready = append(q.ready, block)
+
+	return true
+}
+
+// PopReady removes and returns the oldest block that is ready to be scheduled.
+func (q *issuerQueue) PopReady() *blocks.Block {
+	if len(q.ready) == 0 {
+		return nil
+	}
+
+	block := q.ready[0]
+	q.ready[0] = nil
+	q.ready = q.ready[1:]
+
+	return block
+}
+
+// Remove removes the given block from the queue.
+func (q *issuerQueue) Remove(blockID iotago.BlockID) (block *blocks.Block, removed bool) {
+	if block, removed = q.submitted[blockID]; removed {
+		delete(q.submitted, blockID)
+
+		return block, true
+	}
+
+	for i, readyBlock := range q.ready {
+		if readyBlock.ID() == blockID {
+			q.ready = append(q.ready[:i], q.ready[i+1:]...)
+
+			return readyBlock, true
+		}
+	}
+
+	return nil, false
+}
+
+// Newest returns the block of the queue with the latest issuing time.
+func (q *issuerQueue) Newest() (newest *blocks.Block) {
+	for _, block := range q.ready {
+		if newest == nil || !block.IssuingTime().Before(newest.IssuingTime()) {
+			newest = block
+		}
+	}
+
+	for _, block := range q.submitted {
+		if newest == nil || block.IssuingTime().After(newest.IssuingTime()) {
+			newest = block
+		}
+	}
+
+	return newest
+}
+
+// Blocks returns all blocks in the queue.
+func (q *issuerQueue) Blocks() []*blocks.Block {
+	queuedBlocks := make([]*blocks.Block, 0, q.Size())
+	queuedBlocks = append(queuedBlocks, q.ready...)
+	for _, block := range q.submitted {
+		queuedBlocks = append(queuedBlocks, block)
+	}
+
+	return queuedBlocks
+}
@@ -0,0 +1,29 @@
+package drr
+
+import (
+	"time"
+
+	"github.com/iotaledger/hive.go/runtime/options"
+)
+
+// WithRate sets the interval at which the scheduler schedules blocks.
+func WithRate(rate time.Duration) options.Option[Scheduler] {
+	return func(s *Scheduler) {
+		s.optsRate = rate
+	}
+}
+
+// WithMaxBufferSize sets the maximum amount of blocks that are buffered before the scheduler starts to drop blocks.
+func WithMaxBufferSize(maxBufferSize int) options.Option[Scheduler] {
+	return func(s *Scheduler) {
+		s.optsMaxBufferSize = maxBufferSize
+	}
+}
+
+// WithMinimumQuantum sets the quantum that is assigned to issuers that neither have weight nor block issuance credits
+// (it is raised to 1 if it is not positive).
+func WithMinimumQuantum(minimumQuantum int64) options.Option[Scheduler] {
+	return func(s *Scheduler) {
+		s.optsMinimumQuantum = minimumQuantum
+	}
+}
@@ -0,0 +1,495 @@
+package drr
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/ds/walker"
+	"github.com/iotaledger/hive.go/runtime/module"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/congestioncontrol/scheduler"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+var (
+	// ErrBufferFull is returned when a block is dropped because the buffer of the scheduler is full.
+	ErrBufferFull = errors.New("buffer of the scheduler is full")
+
+	// ErrBlockEvicted is returned when a block is dropped because its slot was evicted before it was scheduled.
+	ErrBlockEvicted = errors.New("block was evicted before it was scheduled")
+
+	// ErrParentDropped is returned when a block is dropped because one of its parents was dropped.
+	ErrParentDropped = errors.New("parent of the block was dropped")
+)
+
+type (
+	blockRetrieverFunc func(id iotago.BlockID) (block *blocks.Block, exists bool)
+	quantumFunc        func(issuerID iotago.AccountID) int64
+)
+
+// region Scheduler ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Scheduler is a deficit round-robin scheduler: every issuer has its own queue and in every round, each issuer with
+// blocks that are ready to be scheduled receives a quantum that is proportional to its weight (or its block issuance
+// credits). An issuer can schedule a block once its accumulated deficit covers the cost of a block.
+type Scheduler struct {
+	events *scheduler.Events
+
+	blockRetrieverFunc blockRetrieverFunc
+	quantumFunc        quantumFunc
+
+	issuerQueues  map[iotago.AccountID]*issuerQueue
+	activeIssuers []iotago.AccountID
+	currentIssuer int
+	bufferSize    int
+	mutex         sync.Mutex
+
+	workerPool    *workerpool.WorkerPool
+	scheduleTimer *time.Timer
+	isShutdown    bool
+	shutdownMutex sync.RWMutex
+
+	optsRate           time.Duration
+	optsMaxBufferSize  int
+	optsMinimumQuantum int64
+
+	module.Module
+}
+
+// NewProvider creates a new Scheduler provider.
+func NewProvider(opts ...options.Option[Scheduler]) module.Provider[*engine.Engine, scheduler.Scheduler] {
+	return module.Provide(func(e *engine.Engine) scheduler.Scheduler {
+		s := New(e.Workers.CreateGroup("Scheduler"), e.BlockCache.Block, func(issuerID iotago.AccountID) int64 {
+			return issuerQuantum(e, issuerID)
+		}, opts...)
+
+		e.HookConstructed(func() {
+			e.Events.Scheduler.LinkTo(s.events)
+
+			e.Events.Booker.BlockBooked.Hook(s.AddBlock)
+			e.Events.BlockGadget.BlockAccepted.Hook(s.skipBlock)
+			e.BlockCache.Evict.Hook(s.evict)
+
+			s.TriggerInitialized()
+
+			s.workerPool.Submit(s.scheduleBlocks)
+		})
+
+		return s
+	})
+}
+
+// New creates a new Scheduler.
+func New(workers *workerpool.Group, blockRetriever blockRetrieverFunc, quantumFunc quantumFunc, opts ...options.Option[Scheduler]) *Scheduler {
+	return options.Apply(&Scheduler{
+		events:             scheduler.NewEvents(),
+		blockRetrieverFunc: blockRetriever,
+		quantumFunc:        quantumFunc,
+		issuerQueues:       make(map[iotago.AccountID]*issuerQueue),
+		workerPool:         workers.CreatePool("Schedule", 1),
+
+		optsRate:           5 * time.Millisecond,
+		optsMaxBufferSize:  300,
+		optsMinimumQuantum: 1,
+	}, opts, func(s *Scheduler) {
+		// issuers without weight or credits must still be able to schedule blocks eventually.
+		if s.optsMinimumQuantum < 1 {
+			s.optsMinimumQuantum = 1
+		}
+	},
+		(*Scheduler).TriggerConstructed,
+	)
+}
+
+// Events returns the events of the Scheduler.
+func (s *Scheduler) Events() *scheduler.Events {
+	return s.events
+}
+
+// AddBlock adds a booked block to the buffer of the scheduler.
+func (s *Scheduler) AddBlock(block *blocks.Block) {
+	// blocks that were accepted before they were booked do not need to be scheduled anymore.
+	if block.IsAccepted() {
+		if block.SetSkipped() {
+			s.events.BlockSkipped.Trigger(block)
+		}
+
+		return
+	}
+
+	s.mutex.Lock()
+
+	queue := s.issuerQueue(block.Block().IssuerID)
+	queue.Submit(block)
+	s.bufferSize++
+
+	if s.isReady(block) {
+		queue.Ready(block)
+	}
+
+	droppedBlocks, droppedChildren := s.dropOverflow()
+
+	s.mutex.Unlock()
+
+	for _, droppedBlock := range droppedBlocks {
+		s.events.BlockDropped.Trigger(droppedBlock, errors.Wrapf(ErrBufferFull, "failed to buffer block of issuer %s", droppedBlock.Block().IssuerID))
+	}
+
+	s.triggerDroppedChildren(droppedChildren)
+}
+
+// BufferSize returns the total amount of blocks that are buffered in the scheduler.
+func (s *Scheduler) BufferSize() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.bufferSize
+}
+
+// IssuerQueueSize returns the amount of blocks of the given issuer that are buffered in the scheduler.
+func (s *Scheduler) IssuerQueueSize(issuerID iotago.AccountID) int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	queue, exists := s.issuerQueues[issuerID]
+	if !exists {
+		return 0
+	}
+
+	return queue.Size()
+}
+
+// Shutdown shuts down the Scheduler and waits until a block that is currently being scheduled was processed.
+func (s *Scheduler) Shutdown() {
+	s.shutdownMutex.Lock()
+	defer s.shutdownMutex.Unlock()
+
+	if !s.isShutdown {
+		s.isShutdown = true
+
+		if s.scheduleTimer != nil {
+			s.scheduleTimer.Stop()
+		}
+		s.workerPool.Shutdown(true)
+	}
+
+	s.TriggerStopped()
+}
+
+// scheduleBlocks schedules the next block and submits itself to the worker pool again after the configured rate until
+// the Scheduler is shut down.
+func (s *Scheduler) scheduleBlocks() {
+	s.shutdownMutex.RLock()
+	defer s.shutdownMutex.RUnlock()
+
+	if s.isShutdown {
+		return
+	}
+
+	s.scheduleNextBlock()
+
+	s.scheduleTimer = time.AfterFunc(s.optsRate, func() {
+		s.shutdownMutex.RLock()
+		defer s.shutdownMutex.RUnlock()
+
+		if !s.isShutdown {
+			s.workerPool.Submit(s.scheduleBlocks)
+		}
+	})
+}
+
+// scheduleNextBlock schedules the next block according to the deficit round-robin policy (if there is one).
+func (s *Scheduler) scheduleNextBlock() (scheduledBlock *blocks.Block) {
+	s.mutex.Lock()
+
+	if scheduledBlock = s.selectBlock(); scheduledBlock != nil {
+		scheduledBlock.SetScheduled()
+		s.readyChildren(scheduledBlock)
+	}
+
+	s.mutex.Unlock()
+
+	if scheduledBlock != nil {
+		s.events.BlockScheduled.Trigger(scheduledBlock)
+	}
+
+	return scheduledBlock
+}
+
+// selectBlock removes the next block that is scheduled from the buffer.
+func (s *Scheduler) selectBlock() *blocks.Block {
+	if !s.hasReadyBlocks() {
+		return nil
+	}
+
+	for {
+		for i := 0; i < len(s.activeIssuers); i++ {
+			queue := s.issuerQueues[s.activeIssuers[s.currentIssuer]]
+
+			if queue.HasReady() && queue.deficit >= 1 {
+				queue.deficit--
+
+				block := queue.PopReady()
+				s.bufferSize--
+				s.removeIfEmpty(queue)
+
+				return block
+			}
+
+			// issuers can not save up their deficit while they have nothing to schedule.
+			if !queue.HasReady() {
+				queue.deficit = 0
+			}
+
+			s.currentIssuer = (s.currentIssuer + 1) % len(s.activeIssuers)
+		}
+
+		s.startRound()
+	}
+}
+
+// startRound increases the deficit of all issuers with ready blocks by their quantum. The quantum is normalized by the
+// largest quantum, so that at least the issuer with the largest share can schedule a block in every round.
+func (s *Scheduler) startRound() {
+	quantums := make(map[iotago.AccountID]int64)
+	var maxQuantum int64
+
+	for _, issuerID := range s.activeIssuers {
+		if !s.issuerQueues[issuerID].HasReady() {
+			continue
+		}
+
+		quantum := s.quantum(issuerID)
+		if quantum > maxQuantum {
+			maxQuantum = quantum
+		}
+
+		quantums[issuerID] = quantum
+	}
+
+	for issuerID, quantum := range quantums {
+		s.issuerQueues[issuerID].deficit += float64(quantum) / float64(maxQuantum)
+	}
+}
+
+// skipBlock removes an accepted block from the buffer, as it does not need to be scheduled anymore.
+func (s *Scheduler) skipBlock(block *blocks.Block) {
+	s.mutex.Lock()
+
+	var skipped bool
+	if queue, exists := s.issuerQueues[block.Block().IssuerID]; exists {
+		if _, skipped = queue.Remove(block.ID()); skipped {
+			s.bufferSize--
+			s.removeIfEmpty(queue)
+		}
+	}
+
+	// accepted blocks do not hold back their children anymore.
+	s.readyChildren(block)
+
+	s.mutex.Unlock()
+
+	if skipped && block.SetSkipped() {
+		s.events.BlockSkipped.Trigger(block)
+	}
+}
+
+// dropOverflow drops the newest blocks of the issuers with the longest queues (relative to their quantum) until the
+// buffer does not exceed its maximum size anymore. The buffered children of the dropped blocks are dropped as well.
+func (s *Scheduler) dropOverflow() (droppedBlocks, droppedChildren []*blocks.Block) {
+	for s.bufferSize > s.optsMaxBufferSize {
+		var longestQueue *issuerQueue
+		var longestScaledSize float64
+
+		for _, issuerID := range s.activeIssuers {
+			queue := s.issuerQueues[issuerID]
+
+			if scaledSize := float64(queue.Size()) / float64(s.quantum(issuerID)); longestQueue == nil || scaledSize > longestScaledSize {
+				longestQueue, longestScaledSize = queue, scaledSize
+			}
+		}
+
+		block, _ := longestQueue.Remove(longestQueue.Newest().ID())
+		block.SetDropped()
+		droppedBlocks = append(droppedBlocks, block)
+
+		s.bufferSize--
+		s.removeIfEmpty(longestQueue)
+
+		droppedChildren = append(droppedChildren, s.dropChildren(block)...)
+	}
+
+	return droppedBlocks, droppedChildren
+}
+
+// evict drops all buffered blocks of the evicted slots (and their buffered children).
+func (s *Scheduler) evict(index iotago.SlotIndex) {
+	s.mutex.Lock()
+
+	var evictedBlocks, droppedChildren []*blocks.Block
+	for _, issuerID := range append([]iotago.AccountID(nil), s.activeIssuers...) {
+		queue := s.issuerQueues[issuerID]
+
+		for _, block := range queue.Blocks() {
+			if block.ID().Index() > index {
+				continue
+			}
+
+			queue.Remove(block.ID())
+			block.SetDropped()
+			evictedBlocks = append(evictedBlocks, block)
+
+			s.bufferSize--
+		}
+
+		s.removeIfEmpty(queue)
+	}
+
+	for _, block := range evictedBlocks {
+		droppedChildren = append(droppedChildren, s.dropChildren(block)...)
+	}
+
+	s.mutex.Unlock()
+
+	for _, block := range evictedBlocks {
+		s.events.BlockDropped.Trigger(block, errors.Wrapf(ErrBlockEvicted, "failed to schedule block of slot %d", block.ID().Index()))
+	}
+
+	s.triggerDroppedChildren(droppedChildren)
+}
+
+// dropChildren drops the buffered future cone of the given dropped block, as it can never become ready anymore.
+func (s *Scheduler) dropChildren(block *blocks.Block) (droppedChildren []*blocks.Block) {
+	for childWalker := walker.New[*blocks.Block]().PushAll(block.Children()...); childWalker.HasNext(); {
+		child := childWalker.Next()
+
+		queue, exists := s.issuerQueues[child.Block().IssuerID]
+		if !exists {
+			continue
+		}
+
+		if _, removed := queue.Remove(child.ID()); !removed {
+			continue
+		}
+
+		child.SetDropped()
+		droppedChildren = append(droppedChildren, child)
+
+		s.bufferSize--
+		s.removeIfEmpty(queue)
+
+		childWalker.PushAll(child.Children()...)
+	}
+
+	return droppedChildren
+}
+
+// triggerDroppedChildren triggers the BlockDropped event for the given children of dropped blocks.
+func (s *Scheduler) triggerDroppedChildren(droppedChildren []*blocks.Block) {
+	for _, child := range droppedChildren {
+		s.events.BlockDropped.Trigger(child, errors.Wrapf(ErrParentDropped, "failed to schedule block of issuer %s", child.Block().IssuerID))
+	}
+}
+
+// readyChildren marks the buffered children of the given block as ready if all of their parents are scheduled.
+func (s *Scheduler) readyChildren(block *blocks.Block) {
+	for _, child := range block.Children() {
+		if queue, exists := s.issuerQueues[child.Block().IssuerID]; exists && queue.IsSubmitted(child.ID()) && s.isReady(child) {
+			queue.Ready(child)
+		}
+	}
+}
+
+// isReady returns true if all parents of the given block are scheduled, accepted or root blocks.
+func (s *Scheduler) isReady(block *blocks.Block) bool {
+	for _, parentID := range block.Parents() {
+		parent, exists := s.blockRetrieverFunc(parentID)
+		if !exists {
+			// parents that are not in the cache anymore were already evicted.
+			continue
+		}
+
+		if !parent.IsRootBlock() && !parent.IsScheduled() && !parent.IsAccepted() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *Scheduler) hasReadyBlocks() bool {
+	for _, issuerID := range s.activeIssuers {
+		if s.issuerQueues[issuerID].HasReady() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// issuerQueue returns the queue of the given issuer and activates it if necessary.
+func (s *Scheduler) issuerQueue(issuerID iotago.AccountID) *issuerQueue {
+	queue, exists := s.issuerQueues[issuerID]
+	if !exists {
+		queue = newIssuerQueue(issuerID)
+		s.issuerQueues[issuerID] = queue
+		s.activeIssuers = append(s.activeIssuers, issuerID)
+	}
+
+	return queue
+}
+
+// removeIfEmpty removes the given queue from the active issuers if it does not contain any blocks anymore.
+func (s *Scheduler) removeIfEmpty(queue *issuerQueue) {
+	if queue.Size() != 0 {
+		return
+	}
+
+	delete(s.issuerQueues, queue.issuerID)
+
+	for i, issuerID := range s.activeIssuers {
+		if issuerID != queue.issuerID {
+			continue
+		}
+
+		s.activeIssuers = append(s.activeIssuers[:i], s.activeIssuers[i+1:]...)
+		if i < s.currentIssuer {
+			s.currentIssuer--
+		}
+		if s.currentIssuer >= len(s.activeIssuers) {
+			s.currentIssuer = 0
+		}
+
+		return
+	}
+}
+
+// quantum returns the quantum of the given issuer (which is at least the configured minimum quantum).
+func (s *Scheduler) quantum(issuerID iotago.AccountID) int64 {
+	if quantum := s.quantumFunc(issuerID); quantum > s.optsMinimumQuantum {
+		return quantum
+	}
+
+	return s.optsMinimumQuantum
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// issuerQuantum returns the weight of the given issuer in the SybilProtection or (if it has none) the block issuance
+// credits of its account.
+func issuerQuantum(e *engine.Engine, issuerID iotago.AccountID) int64 {
+	if weight, exists := e.SybilProtection.Accounts().Get(issuerID); exists && weight > 0 {
+		return weight
+	}
+
+	if credits, exists, err := e.Ledger.Credits(issuerID, e.Storage.Settings().LatestCommitment().Index()); err == nil && exists {
+		return credits
+	}
+
+	return 0
+}
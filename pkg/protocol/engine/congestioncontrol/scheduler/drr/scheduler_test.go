@@ -0,0 +1,230 @@
+package drr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate/tpkg"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestScheduler_Fairness(t *testing.T) {
+	tf := newTestFramework(t, map[iotago.AccountID]int64{{1}: 30, {2}: 10})
+
+	for i := 0; i < 20; i++ {
+		tf.Scheduler.AddBlock(tf.NewBlock(iotago.AccountID{1}))
+		tf.Scheduler.AddBlock(tf.NewBlock(iotago.AccountID{2}))
+	}
+
+	// Issuers receive a share of the throughput that is proportional to their quantum.
+	scheduledBlocks := make(map[iotago.AccountID]int)
+	for i := 0; i < 20; i++ {
+		scheduledBlocks[tf.Scheduler.scheduleNextBlock().Block().IssuerID]++
+	}
+
+	require.Equal(t, map[iotago.AccountID]int{{1}: 15, {2}: 5}, scheduledBlocks)
+	require.Equal(t, 20, tf.Scheduler.BufferSize())
+	require.Len(t, tf.ScheduledBlocks, 20)
+}
+
+func TestScheduler_MinimumQuantum(t *testing.T) {
+	tf := newTestFramework(t, map[iotago.AccountID]int64{{1}: -5, {2}: 0}, WithMinimumQuantum(0))
+
+	tf.Scheduler.AddBlock(tf.NewBlock(iotago.AccountID{1}))
+	tf.Scheduler.AddBlock(tf.NewBlock(iotago.AccountID{2}))
+
+	// issuers that are in debt or have no credits are not starved.
+	require.NotNil(t, tf.Scheduler.scheduleNextBlock())
+	require.NotNil(t, tf.Scheduler.scheduleNextBlock())
+	require.Zero(t, tf.Scheduler.BufferSize())
+}
+
+func TestScheduler_ParentsFirst(t *testing.T) {
+	tf := newTestFramework(t, map[iotago.AccountID]int64{{1}: 10, {2}: 10})
+
+	parent := tf.NewBlock(iotago.AccountID{1})
+	child := tf.NewBlock(iotago.AccountID{2}, parent)
+
+	// the child is added first, but it can only be scheduled after its parent.
+	tf.Scheduler.AddBlock(child)
+	require.Nil(t, tf.Scheduler.scheduleNextBlock())
+
+	tf.Scheduler.AddBlock(parent)
+	require.Equal(t, parent, tf.Scheduler.scheduleNextBlock())
+	require.Equal(t, child, tf.Scheduler.scheduleNextBlock())
+	require.True(t, child.IsScheduled())
+	require.Zero(t, tf.Scheduler.BufferSize())
+}
+
+func TestScheduler_Drop(t *testing.T) {
+	tf := newTestFramework(t, map[iotago.AccountID]int64{{1}: 10, {2}: 10}, WithMaxBufferSize(4))
+
+	honestBlock := tf.NewBlock(iotago.AccountID{2})
+	tf.Scheduler.AddBlock(honestBlock)
+
+	floodingBlocks := make([]*blocks.Block, 0)
+	for i := 0; i < 5; i++ {
+		block := tf.NewBlock(iotago.AccountID{1})
+		floodingBlocks = append(floodingBlocks, block)
+
+		tf.Scheduler.AddBlock(block)
+	}
+
+	// only the newest blocks of the flooding issuer are dropped.
+	require.Equal(t, 4, tf.Scheduler.BufferSize())
+	require.Equal(t, 1, tf.Scheduler.IssuerQueueSize(iotago.AccountID{2}))
+	require.Equal(t, floodingBlocks[3:], tf.DroppedBlocks)
+	require.False(t, honestBlock.IsDropped())
+	require.True(t, floodingBlocks[4].IsDropped())
+
+	for _, droppedBlock := range tf.DroppedBlocks {
+		require.ErrorIs(t, tf.DropReasons[droppedBlock.ID()], ErrBufferFull)
+	}
+}
+
+func TestScheduler_Skip(t *testing.T) {
+	tf := newTestFramework(t, map[iotago.AccountID]int64{{1}: 10})
+
+	parent := tf.NewBlock(iotago.AccountID{1})
+	child := tf.NewBlock(iotago.AccountID{1}, parent)
+
+	tf.Scheduler.AddBlock(parent)
+	tf.Scheduler.AddBlock(child)
+
+	// accepted blocks are removed from the buffer and release their children.
+	parent.SetAccepted()
+	tf.Scheduler.skipBlock(parent)
+
+	require.True(t, parent.IsSkipped())
+	require.Equal(t, []*blocks.Block{parent}, tf.SkippedBlocks)
+	require.Equal(t, child, tf.Scheduler.scheduleNextBlock())
+	require.Nil(t, tf.Scheduler.scheduleNextBlock())
+}
+
+func TestScheduler_DropChildren(t *testing.T) {
+	tf := newTestFramework(t, map[iotago.AccountID]int64{{1}: 10, {2}: 10})
+
+	parent := tf.NewBlock(iotago.AccountID{1})
+
+	// the children are issued in a later slot, so they are not evicted together with their parent.
+	tf.issuingTime = tpkg.API().SlotTimeProvider().StartTime(2)
+	child := tf.NewBlock(iotago.AccountID{2}, parent)
+	grandChild := tf.NewBlock(iotago.AccountID{2}, child)
+
+	tf.Scheduler.AddBlock(parent)
+	tf.Scheduler.AddBlock(child)
+	tf.Scheduler.AddBlock(grandChild)
+
+	// the future cone of a dropped block can never become ready, so it is dropped as well.
+	tf.Scheduler.evict(parent.ID().Index())
+
+	require.Equal(t, []*blocks.Block{parent, child, grandChild}, tf.DroppedBlocks)
+	require.ErrorIs(t, tf.DropReasons[parent.ID()], ErrBlockEvicted)
+	require.ErrorIs(t, tf.DropReasons[child.ID()], ErrParentDropped)
+	require.ErrorIs(t, tf.DropReasons[grandChild.ID()], ErrParentDropped)
+	require.True(t, grandChild.IsDropped())
+	require.Zero(t, tf.Scheduler.BufferSize())
+	require.Nil(t, tf.Scheduler.scheduleNextBlock())
+}
+
+func TestScheduler_Shutdown(t *testing.T) {
+	tf := newTestFramework(t, map[iotago.AccountID]int64{{1}: 10}, WithRate(time.Millisecond))
+
+	scheduled := make(chan *blocks.Block, 2)
+	tf.Scheduler.Events().BlockScheduled.Hook(func(block *blocks.Block) {
+		scheduled <- block
+	})
+
+	tf.Scheduler.workerPool.Submit(tf.Scheduler.scheduleBlocks)
+
+	block := tf.NewBlock(iotago.AccountID{1})
+	tf.Scheduler.AddBlock(block)
+	require.Equal(t, block, <-scheduled)
+
+	// no blocks are scheduled anymore once the scheduler was shut down.
+	tf.Scheduler.Shutdown()
+	tf.Scheduler.AddBlock(tf.NewBlock(iotago.AccountID{1}))
+
+	require.Never(t, func() bool { return len(scheduled) > 0 }, 50*time.Millisecond, time.Millisecond)
+	require.Equal(t, 1, tf.Scheduler.BufferSize())
+}
+
+type testFramework struct {
+	Scheduler *Scheduler
+
+	ScheduledBlocks []*blocks.Block
+	DroppedBlocks   []*blocks.Block
+	DropReasons     map[iotago.BlockID]error
+	SkippedBlocks   []*blocks.Block
+
+	test        *testing.T
+	blocks      map[iotago.BlockID]*blocks.Block
+	issuingTime time.Time
+}
+
+func newTestFramework(t *testing.T, quantums map[iotago.AccountID]int64, opts ...options.Option[Scheduler]) *testFramework {
+	tf := &testFramework{
+		DropReasons: make(map[iotago.BlockID]error),
+		test:        t,
+		blocks:      make(map[iotago.BlockID]*blocks.Block),
+		issuingTime: tpkg.API().SlotTimeProvider().StartTime(1),
+	}
+
+	tf.Scheduler = New(workerpool.NewGroup(t.Name()), func(id iotago.BlockID) (*blocks.Block, bool) {
+		block, exists := tf.blocks[id]
+		return block, exists
+	}, func(issuerID iotago.AccountID) int64 {
+		return quantums[issuerID]
+	}, opts...)
+
+	tf.Scheduler.Events().BlockScheduled.Hook(func(block *blocks.Block) {
+		tf.ScheduledBlocks = append(tf.ScheduledBlocks, block)
+	})
+	tf.Scheduler.Events().BlockDropped.Hook(func(block *blocks.Block, err error) {
+		tf.DroppedBlocks = append(tf.DroppedBlocks, block)
+		tf.DropReasons[block.ID()] = err
+	})
+	tf.Scheduler.Events().BlockSkipped.Hook(func(block *blocks.Block) {
+		tf.SkippedBlocks = append(tf.SkippedBlocks, block)
+	})
+
+	return tf
+}
+
+// NewBlock creates a new booked block of the given issuer that references the given parents.
+func (tf *testFramework) NewBlock(issuerID iotago.AccountID, parents ...*blocks.Block) *blocks.Block {
+	tf.issuingTime = tf.issuingTime.Add(time.Millisecond)
+
+	strongParents := iotago.StrongParentsIDs{iotago.EmptyBlockID()}
+	if len(parents) > 0 {
+		strongParents = make(iotago.StrongParentsIDs, 0, len(parents))
+		for _, parent := range parents {
+			strongParents = append(strongParents, parent.ID())
+		}
+	}
+
+	modelBlock, err := model.BlockFromBlock(&iotago.Block{
+		ProtocolVersion: tpkg.ProtocolParams().Version,
+		IssuerID:        issuerID,
+		IssuingTime:     tf.issuingTime,
+		SlotCommitment:  iotago.NewEmptyCommitment(),
+		StrongParents:   strongParents,
+		Signature:       &iotago.Ed25519Signature{},
+	}, tpkg.API())
+	require.NoError(tf.test, err)
+
+	block := blocks.NewBlock(modelBlock)
+	for _, parent := range parents {
+		parent.AppendChild(block, model.StrongParentType)
+	}
+
+	tf.blocks[block.ID()] = block
+
+	return block
+}
@@ -0,0 +1,29 @@
+package scheduler
+
+import (
+	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
+)
+
+// Events represents events happening in the Scheduler.
+type Events struct {
+	// BlockScheduled is triggered when a block is scheduled.
+	BlockScheduled *event.Event1[*blocks.Block]
+
+	// BlockDropped is triggered when a block is dropped from the buffer of the scheduler.
+	BlockDropped *event.Event2[*blocks.Block, error]
+
+	// BlockSkipped is triggered when a block is accepted before it was scheduled.
+	BlockSkipped *event.Event1[*blocks.Block]
+
+	event.Group[Events, *Events]
+}
+
+// NewEvents contains the constructor of the Events object (it is generated by a generic factory).
+var NewEvents = event.CreateGroupConstructor(func() (newEvents *Events) {
+	return &Events{
+		BlockScheduled: event.New1[*blocks.Block](),
+		BlockDropped:   event.New2[*blocks.Block, error](),
+		BlockSkipped:   event.New1[*blocks.Block](),
+	}
+})
@@ -0,0 +1,23 @@
+package scheduler
+
+import (
+	"github.com/iotaledger/hive.go/runtime/module"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// Scheduler is the engine module that orders booked blocks before they are handed to the tip manager, so that no
+// issuer can claim more than its fair share of the throughput of the network.
+type Scheduler interface {
+	// AddBlock adds a booked block to the buffer of the scheduler.
+	AddBlock(block *blocks.Block)
+
+	// BufferSize returns the total amount of blocks that are buffered in the scheduler.
+	BufferSize() int
+
+	// IssuerQueueSize returns the amount of blocks of the given issuer that are buffered in the scheduler.
+	IssuerQueueSize(issuerID iotago.AccountID) int
+
+	// Interface embeds the required methods of the module.Interface.
+	module.Interface
+}
@@ -2,9 +2,11 @@ package thresholdblockgadget
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/pkg/errors"
 
+	"github.com/iotaledger/hive.go/core/account"
 	"github.com/iotaledger/hive.go/core/causalorder"
 	"github.com/iotaledger/hive.go/ds/walker"
 	"github.com/iotaledger/hive.go/runtime/module"
@@ -15,6 +17,7 @@ import (
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/consensus/blockgadget"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/sybilprotection"
+	"github.com/iotaledger/iota-core/pkg/storage/prunable"
 	"github.com/iotaledger/iota-core/pkg/votes"
 	iotago "github.com/iotaledger/iota.go/v4"
 )
@@ -28,6 +31,13 @@ type Gadget struct {
 
 	sybilProtection sybilprotection.SybilProtection
 	blockCache      *blocks.Blocks
+	errorHandler    func(error)
+
+	// equivocators maps the validators that were caught issuing conflicting blocks to the first slot from which on
+	// their weight is ignored.
+	equivocators    map[iotago.AccountID]iotago.SlotIndex
+	lastEvictedSlot iotago.SlotIndex
+	mutex           sync.RWMutex
 
 	acceptanceOrder         *causalorder.CausalOrder[iotago.SlotIndex, iotago.BlockID, *blocks.Block]
 	ratifiedAcceptanceOrder *causalorder.CausalOrder[iotago.SlotIndex, iotago.BlockID, *blocks.Block]
@@ -41,22 +51,35 @@ type Gadget struct {
 
 func NewProvider(opts ...options.Option[Gadget]) module.Provider[*engine.Engine, blockgadget.Gadget] {
 	return module.Provide(func(e *engine.Engine) blockgadget.Gadget {
-		g := New(e.Workers.CreateGroup("BlockGadget"), e.BlockCache, e.SybilProtection, opts...)
+		g := New(e.Workers.CreateGroup("BlockGadget"), e.BlockCache, e.SybilProtection, e.ErrorHandler("blockgadget"), opts...)
 		e.Events.Booker.WitnessAdded.Hook(g.tryAccept)
+		e.Events.BlockDAG.ValidatorEquivocated.Hook(g.trackEquivocation)
 		e.BlockCache.Evict.Hook(g.evictUntil)
 
+		// the exclusions of the equivocators whose evidence is still kept in the storage are restored after a restart.
+		e.Storage.Settings().HookInitialized(func() {
+			startSlot := iotago.SlotIndex(0)
+			if lastPrunedSlot, hasPruned := e.Storage.LastPrunedSlot(); hasPruned {
+				startSlot = lastPrunedSlot + 1
+			}
+
+			g.restoreEquivocations(e.Storage.Equivocations, startSlot, e.Storage.Settings().LatestCommitment().Index())
+		})
+
 		e.Events.BlockGadget.LinkTo(g.events)
 
 		return g
 	})
 }
 
-func New(workers *workerpool.Group, blockCache *blocks.Blocks, sybilProtection sybilprotection.SybilProtection, opts ...options.Option[Gadget]) *Gadget {
+func New(workers *workerpool.Group, blockCache *blocks.Blocks, sybilProtection sybilprotection.SybilProtection, errorHandler func(error), opts ...options.Option[Gadget]) *Gadget {
 	return options.Apply(&Gadget{
 		events:          blockgadget.NewEvents(),
 		workers:         workers,
 		sybilProtection: sybilProtection,
 		blockCache:      blockCache,
+		errorHandler:    errorHandler,
+		equivocators:    make(map[iotago.AccountID]iotago.SlotIndex),
 
 		optsAcceptanceThreshold:   0.67,
 		optsConfirmationThreshold: 0.67,
@@ -98,14 +121,90 @@ func (g *Gadget) IsBlockConfirmed(blockID iotago.BlockID) bool {
 
 func (g *Gadget) tryAccept(block *blocks.Block) {
 	committee := g.sybilProtection.Committee()
-	blockWeight := committee.SelectAccounts(block.Witnesses()...).TotalWeight()
-	onlineCommitteeTotalWeight := g.sybilProtection.OnlineCommittee().TotalWeight()
+	blockWeight := committee.SelectAccounts(g.honestValidators(block.ID().Index(), block.Witnesses())...).TotalWeight()
+	onlineCommittee := g.sybilProtection.OnlineCommittee()
+	onlineCommitteeTotalWeight := onlineCommittee.TotalWeight() - g.excludedWeight(onlineCommittee, block.ID().Index())
 
 	if votes.IsThresholdReached(blockWeight, onlineCommitteeTotalWeight, g.optsAcceptanceThreshold) {
 		g.propagateAcceptance(block)
 	}
 }
 
+// trackEquivocation excludes the issuer of the given equivocation from the acceptance and confirmation of the blocks of
+// the earlier slot of the conflicting blocks onward (neither its votes nor its weight count towards the thresholds).
+// A validator that was caught equivocating is not trusted anymore, so the exclusion is kept for the lifetime of the
+// engine.
+func (g *Gadget) trackEquivocation(equivocation *model.Equivocation) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	block1, block2 := equivocation.Blocks()
+	excludedFrom := block1.ID().Index()
+	if block2.ID().Index() < excludedFrom {
+		excludedFrom = block2.ID().Index()
+	}
+
+	if currentExcludedFrom, exists := g.equivocators[equivocation.IssuerID()]; !exists || excludedFrom < currentExcludedFrom {
+		g.equivocators[equivocation.IssuerID()] = excludedFrom
+	}
+}
+
+// restoreEquivocations tracks the equivocations whose evidence was stored for the slots in the given range.
+func (g *Gadget) restoreEquivocations(equivocationsFunc func(iotago.SlotIndex) *prunable.Equivocations, startSlot iotago.SlotIndex, endSlot iotago.SlotIndex) {
+	for slot := startSlot; slot <= endSlot; slot++ {
+		equivocations := equivocationsFunc(slot)
+		if equivocations == nil {
+			continue
+		}
+
+		if err := equivocations.ForEach(func(equivocation *model.Equivocation) error {
+			g.trackEquivocation(equivocation)
+			return nil
+		}); err != nil {
+			g.errorHandler(errors.Wrapf(err, "failed to restore equivocations of slot %d", slot))
+		}
+	}
+}
+
+// honestValidators returns the given validators that were not caught equivocating in or before the given slot.
+func (g *Gadget) honestValidators(index iotago.SlotIndex, validators []iotago.AccountID) []iotago.AccountID {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	if len(g.equivocators) == 0 {
+		return validators
+	}
+
+	honestValidators := make([]iotago.AccountID, 0, len(validators))
+	for _, validator := range validators {
+		if excludedFrom, isEquivocator := g.equivocators[validator]; !isEquivocator || index < excludedFrom {
+			honestValidators = append(honestValidators, validator)
+		}
+	}
+
+	return honestValidators
+}
+
+// excludedWeight returns the weight of the given validators that were caught equivocating in or before the given slot,
+// which is not available to reach the thresholds of the blocks of that slot.
+func (g *Gadget) excludedWeight(validators *account.SelectedAccounts[iotago.AccountID, *iotago.AccountID], index iotago.SlotIndex) int64 {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	excludedValidators := make([]iotago.AccountID, 0)
+	for equivocator, excludedFrom := range g.equivocators {
+		if index >= excludedFrom {
+			excludedValidators = append(excludedValidators, equivocator)
+		}
+	}
+
+	if len(excludedValidators) == 0 {
+		return 0
+	}
+
+	return validators.SelectAccounts(excludedValidators...).TotalWeight()
+}
+
 func (g *Gadget) trackRatifierWeight(votingBlock *blocks.Block) {
 	ratifier := votingBlock.Block().IssuerID
 
@@ -120,6 +219,11 @@ func (g *Gadget) trackRatifierWeight(votingBlock *blocks.Block) {
 		blockID := walk.Next()
 		block, exists := g.blockCache.Block(blockID)
 		if !exists {
+			// The block was evicted after its child was attached, so there is nothing left to ratify.
+			if g.isEvicted(blockID.Index()) {
+				continue
+			}
+
 			panic(fmt.Sprintf("parent %s does not exist", blockID))
 		}
 
@@ -160,10 +264,11 @@ func (g *Gadget) trackRatifierWeight(votingBlock *blocks.Block) {
 // If there is not enough online weight to achieve confirmation, then acceptance condition is evaluated based on total active weight.
 func (g *Gadget) tryRatifiedAcceptAndConfirm(block *blocks.Block) {
 	committee := g.sybilProtection.Committee()
-	committeeTotalWeight := committee.TotalWeight()
+	committeeTotalWeight := committee.TotalWeight() - g.excludedWeight(committee, block.ID().Index())
 
-	blockWeight := committee.SelectAccounts(block.Ratifiers()...).TotalWeight()
-	onlineCommitteeTotalWeight := g.sybilProtection.OnlineCommittee().TotalWeight()
+	blockWeight := committee.SelectAccounts(g.honestValidators(block.ID().Index(), block.Ratifiers())...).TotalWeight()
+	onlineCommittee := g.sybilProtection.OnlineCommittee()
+	onlineCommitteeTotalWeight := onlineCommittee.TotalWeight() - g.excludedWeight(onlineCommittee, block.ID().Index())
 
 	// check if we reached the confirmation threshold based on the total committee weight.
 	if votes.IsThresholdReached(blockWeight, committeeTotalWeight, g.optsConfirmationThreshold) {
@@ -176,6 +281,33 @@ func (g *Gadget) tryRatifiedAcceptAndConfirm(block *blocks.Block) {
 func (g *Gadget) evictUntil(index iotago.SlotIndex) {
 	g.acceptanceOrder.EvictUntil(index)
 	g.confirmationOrder.EvictUntil(index)
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if index > g.lastEvictedSlot {
+		g.lastEvictedSlot = index
+	}
+}
+
+// hasEvictedParents returns true if one of the strong parents of the given block was evicted before the block could be
+// ordered, in which case the block can't be accepted anymore.
+func (g *Gadget) hasEvictedParents(block *blocks.Block) bool {
+	for _, parentID := range block.StrongParents() {
+		if _, exists := g.blockCache.Block(parentID); !exists && g.isEvicted(parentID.Index()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isEvicted returns true if the blocks of the given slot were evicted already.
+func (g *Gadget) isEvicted(index iotago.SlotIndex) bool {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	return index <= g.lastEvictedSlot
 }
 
 func (g *Gadget) propagateAcceptance(initialBlock *blocks.Block) {
@@ -184,6 +316,11 @@ func (g *Gadget) propagateAcceptance(initialBlock *blocks.Block) {
 		blockID := pastConeWalker.Next()
 		walkerBlock, exists := g.blockCache.Block(blockID)
 		if !exists {
+			// The block was evicted after its child was attached, so it can't be accepted anymore.
+			if g.isEvicted(blockID.Index()) {
+				continue
+			}
+
 			panic(fmt.Sprintf("parent %s does not exist", blockID))
 		}
 
@@ -220,6 +357,11 @@ func (g *Gadget) propagateRatifiedAcceptanceAndConfirmation(initialBlock *blocks
 		blockID := pastConeWalker.Next()
 		walkerBlock, exists := g.blockCache.Block(blockID)
 		if !exists {
+			// The block was evicted after its child was attached, so it can't be accepted anymore.
+			if g.isEvicted(blockID.Index()) {
+				continue
+			}
+
 			panic(fmt.Sprintf("parent %s does not exist", blockID))
 		}
 
@@ -291,14 +433,29 @@ func (g *Gadget) markAsConfirmed(block *blocks.Block) (err error) {
 }
 
 func (g *Gadget) acceptanceFailed(block *blocks.Block, err error) {
+	if g.hasEvictedParents(block) {
+		g.errorHandler(errors.Wrapf(err, "block %s can not be accepted anymore as one of its parents was evicted", block.ID()))
+		return
+	}
+
 	panic(errors.Wrapf(err, "could not mark block %s as accepted", block.ID()))
 }
 
 func (g *Gadget) ratifiedAcceptanceFailed(block *blocks.Block, err error) {
+	if g.hasEvictedParents(block) {
+		g.errorHandler(errors.Wrapf(err, "block %s can not be ratified accepted anymore as one of its parents was evicted", block.ID()))
+		return
+	}
+
 	panic(errors.Wrapf(err, "could not mark block %s as ratified accepted", block.ID()))
 }
 
 func (g *Gadget) confirmationFailed(block *blocks.Block, err error) {
+	if g.hasEvictedParents(block) {
+		g.errorHandler(errors.Wrapf(err, "block %s can not be confirmed anymore as one of its parents was evicted", block.ID()))
+		return
+	}
+
 	panic(errors.Wrapf(err, "could not mark block %s as confirmed", block.ID()))
 }
 
@@ -0,0 +1,189 @@
+package thresholdblockgadget
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/core/account"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/eviction"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate/tpkg"
+	"github.com/iotaledger/iota-core/pkg/storage/prunable"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestGadget_EquivocatorsAreExcludedFromTheirSlotOnward(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	defer workers.Shutdown()
+
+	evictionState := eviction.NewState(func(iotago.SlotIndex) *prunable.RootBlocks { return nil })
+	gadget := New(workers, blocks.New(evictionState, tpkg.API().SlotTimeProvider), nil, func(err error) { require.NoError(t, err) })
+
+	blockOfSlot4 := newTestBlock(t, iotago.AccountID{3}, 4, iotago.NewEmptyCommitment())
+	blockOfSlot5 := newTestBlock(t, iotago.AccountID{3}, 5, iotago.NewEmptyCommitment())
+	blockOfSlot6 := newTestBlock(t, iotago.AccountID{3}, 6, iotago.NewEmptyCommitment())
+	for _, block := range []*blocks.Block{blockOfSlot4, blockOfSlot5, blockOfSlot6} {
+		block.AddWitness(iotago.AccountID{1})
+		block.AddWitness(iotago.AccountID{2})
+	}
+
+	require.ElementsMatch(t, []iotago.AccountID{{1}, {2}}, gadget.honestValidators(blockOfSlot5.ID().Index(), blockOfSlot5.Witnesses()))
+
+	// the witness weight of an equivocator is ignored from the slot in which it equivocated onward.
+	gadget.trackEquivocation(model.NewEquivocation(
+		newTestBlock(t, iotago.AccountID{1}, 5, iotago.NewCommitment(1, iotago.CommitmentID{}, iotago.Identifier{1}, 0)).ModelBlock(),
+		newTestBlock(t, iotago.AccountID{1}, 6, iotago.NewCommitment(1, iotago.CommitmentID{}, iotago.Identifier{2}, 0)).ModelBlock(),
+	))
+	require.ElementsMatch(t, []iotago.AccountID{{1}, {2}}, gadget.honestValidators(blockOfSlot4.ID().Index(), blockOfSlot4.Witnesses()))
+	require.Equal(t, []iotago.AccountID{{2}}, gadget.honestValidators(blockOfSlot5.ID().Index(), blockOfSlot5.Witnesses()))
+	require.Equal(t, []iotago.AccountID{{2}}, gadget.honestValidators(blockOfSlot6.ID().Index(), blockOfSlot6.Witnesses()))
+
+	// later evidence does not shorten the exclusion, earlier evidence extends it.
+	gadget.trackEquivocation(model.NewEquivocation(
+		newTestBlock(t, iotago.AccountID{1}, 6, iotago.NewCommitment(1, iotago.CommitmentID{}, iotago.Identifier{3}, 0)).ModelBlock(),
+		newTestBlock(t, iotago.AccountID{1}, 6, iotago.NewCommitment(1, iotago.CommitmentID{}, iotago.Identifier{4}, 0)).ModelBlock(),
+	))
+	require.Equal(t, []iotago.AccountID{{2}}, gadget.honestValidators(blockOfSlot5.ID().Index(), blockOfSlot5.Witnesses()))
+
+	gadget.trackEquivocation(model.NewEquivocation(
+		newTestBlock(t, iotago.AccountID{1}, 4, iotago.NewCommitment(1, iotago.CommitmentID{}, iotago.Identifier{3}, 0)).ModelBlock(),
+		newTestBlock(t, iotago.AccountID{1}, 4, iotago.NewCommitment(1, iotago.CommitmentID{}, iotago.Identifier{4}, 0)).ModelBlock(),
+	))
+	require.Equal(t, []iotago.AccountID{{2}}, gadget.honestValidators(blockOfSlot4.ID().Index(), blockOfSlot4.Witnesses()))
+
+	// the exclusion outlives the eviction of the slot in which the validator equivocated.
+	gadget.evictUntil(5)
+	require.Equal(t, []iotago.AccountID{{2}}, gadget.honestValidators(blockOfSlot6.ID().Index(), blockOfSlot6.Witnesses()))
+}
+
+func TestGadget_RestoreEquivocations(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	defer workers.Shutdown()
+
+	evictionState := eviction.NewState(func(iotago.SlotIndex) *prunable.RootBlocks { return nil })
+	gadget := New(workers, blocks.New(evictionState, tpkg.API().SlotTimeProvider), nil, func(err error) { require.NoError(t, err) })
+
+	// the evidence is stored in the slot of the later block of the equivocation.
+	storedEquivocations := make(map[iotago.SlotIndex]*prunable.Equivocations)
+	for _, equivocation := range []*model.Equivocation{
+		model.NewEquivocation(
+			newTestBlock(t, iotago.AccountID{1}, 5, iotago.NewCommitment(1, iotago.CommitmentID{}, iotago.Identifier{1}, 0)).ModelBlock(),
+			newTestBlock(t, iotago.AccountID{1}, 6, iotago.NewCommitment(1, iotago.CommitmentID{}, iotago.Identifier{2}, 0)).ModelBlock(),
+		),
+		model.NewEquivocation(
+			newTestBlock(t, iotago.AccountID{2}, 8, iotago.NewCommitment(1, iotago.CommitmentID{}, iotago.Identifier{1}, 0)).ModelBlock(),
+			newTestBlock(t, iotago.AccountID{2}, 8, iotago.NewCommitment(1, iotago.CommitmentID{}, iotago.Identifier{2}, 0)).ModelBlock(),
+		),
+	} {
+		block1, block2 := equivocation.Blocks()
+		index := block1.ID().Index()
+		if block2.ID().Index() > index {
+			index = block2.ID().Index()
+		}
+
+		storedEquivocations[index] = prunable.NewEquivocations(index, mapdb.NewMapDB(), tpkg.API())
+		require.NoError(t, storedEquivocations[index].Store(equivocation))
+	}
+
+	// only the evidence of the given slots is restored.
+	gadget.restoreEquivocations(func(index iotago.SlotIndex) *prunable.Equivocations {
+		return storedEquivocations[index]
+	}, 1, 7)
+
+	witnesses := []iotago.AccountID{{1}, {2}, {3}}
+	require.ElementsMatch(t, witnesses, gadget.honestValidators(4, witnesses))
+	require.ElementsMatch(t, []iotago.AccountID{{2}, {3}}, gadget.honestValidators(5, witnesses))
+	require.ElementsMatch(t, []iotago.AccountID{{2}, {3}}, gadget.honestValidators(8, witnesses))
+}
+
+func TestGadget_EquivocatorsAreExcludedFromTheThresholds(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	defer workers.Shutdown()
+
+	evictionState := eviction.NewState(func(iotago.SlotIndex) *prunable.RootBlocks { return nil })
+	gadget := New(workers, blocks.New(evictionState, tpkg.API().SlotTimeProvider), nil, func(err error) { require.NoError(t, err) })
+
+	accounts := account.NewAccounts[iotago.AccountID](mapdb.NewMapDB())
+	accounts.Set(iotago.AccountID{1}, 30)
+	accounts.Set(iotago.AccountID{2}, 50)
+	accounts.Set(iotago.AccountID{3}, 20)
+	committee := accounts.SelectAccounts(iotago.AccountID{1}, iotago.AccountID{2}, iotago.AccountID{3})
+	onlineCommittee := accounts.SelectAccounts(iotago.AccountID{2}, iotago.AccountID{3})
+
+	require.Zero(t, gadget.excludedWeight(committee, 5))
+
+	gadget.trackEquivocation(model.NewEquivocation(
+		newTestBlock(t, iotago.AccountID{1}, 5, iotago.NewCommitment(1, iotago.CommitmentID{}, iotago.Identifier{1}, 0)).ModelBlock(),
+		newTestBlock(t, iotago.AccountID{1}, 5, iotago.NewCommitment(1, iotago.CommitmentID{}, iotago.Identifier{2}, 0)).ModelBlock(),
+	))
+	gadget.trackEquivocation(model.NewEquivocation(
+		newTestBlock(t, iotago.AccountID{3}, 7, iotago.NewCommitment(1, iotago.CommitmentID{}, iotago.Identifier{1}, 0)).ModelBlock(),
+		newTestBlock(t, iotago.AccountID{3}, 7, iotago.NewCommitment(1, iotago.CommitmentID{}, iotago.Identifier{2}, 0)).ModelBlock(),
+	))
+
+	// the weight of an equivocator is removed from the thresholds of the slots in which it is excluded.
+	require.Zero(t, gadget.excludedWeight(committee, 4))
+	require.EqualValues(t, 30, gadget.excludedWeight(committee, 5))
+	require.EqualValues(t, 30+20, gadget.excludedWeight(committee, 7))
+
+	// only the weight of the members of the given validators is removed.
+	require.Zero(t, gadget.excludedWeight(onlineCommittee, 5))
+	require.EqualValues(t, 20, gadget.excludedWeight(onlineCommittee, 7))
+
+	// the ratifiers of a block are filtered like its witnesses.
+	block := newTestBlock(t, iotago.AccountID{2}, 7, iotago.NewEmptyCommitment())
+	block.AddRatifier(iotago.AccountID{1})
+	block.AddRatifier(iotago.AccountID{2})
+	block.AddRatifier(iotago.AccountID{3})
+	require.Equal(t, []iotago.AccountID{{2}}, gadget.honestValidators(block.ID().Index(), block.Ratifiers()))
+}
+
+func TestGadget_BlocksWithEvictedParentsAreReported(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	defer workers.Shutdown()
+
+	var reportedErrors []error
+	evictionState := eviction.NewState(func(iotago.SlotIndex) *prunable.RootBlocks { return nil })
+	gadget := New(workers, blocks.New(evictionState, tpkg.API().SlotTimeProvider), nil, func(err error) { reportedErrors = append(reportedErrors, err) })
+
+	block := newTestBlock(t, iotago.AccountID{1}, 6, iotago.NewEmptyCommitment(), iotago.NewSlotIdentifier(5, iotago.Identifier{1}))
+	orderingErr := errors.New("parent is missing")
+
+	// a missing parent of a slot that was not evicted yet is a bug.
+	require.Panics(t, func() { gadget.acceptanceFailed(block, orderingErr) })
+
+	// a block can not be ordered anymore if one of its parents was evicted, which is reported instead.
+	gadget.evictUntil(5)
+	gadget.acceptanceFailed(block, orderingErr)
+	gadget.ratifiedAcceptanceFailed(block, orderingErr)
+	gadget.confirmationFailed(block, orderingErr)
+
+	require.Len(t, reportedErrors, 3)
+	for _, err := range reportedErrors {
+		require.ErrorIs(t, err, orderingErr)
+		require.ErrorContains(t, err, block.ID().String())
+	}
+}
+
+func newTestBlock(t *testing.T, issuerID iotago.AccountID, slot iotago.SlotIndex, commitment *iotago.Commitment, strongParents ...iotago.BlockID) *blocks.Block {
+	if len(strongParents) == 0 {
+		strongParents = iotago.BlockIDs{iotago.EmptyBlockID()}
+	}
+
+	modelBlock, err := model.BlockFromBlock(&iotago.Block{
+		ProtocolVersion: tpkg.ProtocolParams().Version,
+		IssuerID:        issuerID,
+		IssuingTime:     tpkg.API().SlotTimeProvider().StartTime(slot),
+		SlotCommitment:  commitment,
+		StrongParents:   strongParents,
+		Signature:       &iotago.Ed25519Signature{},
+	}, tpkg.API())
+	require.NoError(t, err)
+
+	return blocks.NewBlock(modelBlock)
+}
@@ -22,12 +22,14 @@ import (
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/booker"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/clock"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/congestioncontrol/scheduler"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/consensus/blockgadget"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/consensus/slotgadget"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/eviction"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/filter"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledger"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/notarization"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/orphanage"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/sybilprotection"
 	"github.com/iotaledger/iota-core/pkg/storage"
 	iotago "github.com/iotaledger/iota.go/v4"
@@ -43,12 +45,14 @@ type Engine struct {
 	BlockRequester  *eventticker.EventTicker[iotago.SlotIndex, iotago.BlockID]
 	BlockDAG        blockdag.BlockDAG
 	Booker          booker.Booker
+	Scheduler       scheduler.Scheduler
 	Clock           clock.Clock
 	SybilProtection sybilprotection.SybilProtection
 	BlockGadget     blockgadget.Gadget
 	SlotGadget      slotgadget.Gadget
 	Notarization    notarization.Notarization
 	Ledger          ledger.Ledger
+	Orphanage       *orphanage.Detector
 
 	Workers      *workerpool.Group
 	errorHandler func(error)
@@ -65,6 +69,7 @@ type Engine struct {
 	optsEntryPointsDepth      int
 	optsSnapshotDepth         int
 	optsBlockRequester        []options.Option[eventticker.EventTicker[iotago.SlotIndex, iotago.BlockID]]
+	optsOrphanage             []options.Option[orphanage.Detector]
 
 	module.Module
 }
@@ -76,6 +81,7 @@ func New(
 	filterProvider module.Provider[*Engine, filter.Filter],
 	blockDAGProvider module.Provider[*Engine, blockdag.BlockDAG],
 	bookerProvider module.Provider[*Engine, booker.Booker],
+	schedulerProvider module.Provider[*Engine, scheduler.Scheduler],
 	clockProvider module.Provider[*Engine, clock.Clock],
 	sybilProtectionProvider module.Provider[*Engine, sybilprotection.SybilProtection],
 	blockGadgetProvider module.Provider[*Engine, blockgadget.Gadget],
@@ -98,11 +104,13 @@ func New(
 			e.BlockCache = blocks.New(e.EvictionState, func() *iotago.SlotTimeProvider { return e.API().SlotTimeProvider() })
 
 			e.BlockRequester = eventticker.New(e.optsBlockRequester...)
+			e.Orphanage = orphanage.New(e.IsBootstrapped, e.optsOrphanage...)
 
 			e.SybilProtection = sybilProtectionProvider(e)
 			e.BlockDAG = blockDAGProvider(e)
 			e.Filter = filterProvider(e)
 			e.Booker = bookerProvider(e)
+			e.Scheduler = schedulerProvider(e)
 			e.Clock = clockProvider(e)
 			e.BlockGadget = blockGadgetProvider(e)
 			e.SlotGadget = slotGadgetProvider(e)
@@ -116,11 +124,14 @@ func New(
 
 			e.Storage.Settings().HookInitialized(func() {
 				e.EvictionState.Initialize(e.Storage.Settings().LatestCommitment().Index())
+				e.Orphanage.Initialize(e.Storage.Settings().LatestCommitment().Index())
 			})
 		},
 		(*Engine).setupBlockStorage,
+		(*Engine).setupEquivocationStorage,
 		(*Engine).setupEvictionState,
 		(*Engine).setupBlockRequester,
+		(*Engine).setupOrphanage,
 		(*Engine).TriggerConstructed,
 	)
 }
@@ -129,16 +140,19 @@ func (e *Engine) Shutdown() {
 	if !e.WasStopped() {
 		e.TriggerStopped()
 
+		// The modules are shut down in the order in which they process blocks, so that their pending work is done
+		// before the modules that they trigger are shut down.
 		e.BlockRequester.Shutdown()
-		e.Notarization.Shutdown()
-		e.Booker.Shutdown()
-		e.Ledger.Shutdown()
+		e.Filter.Shutdown()
 		e.BlockDAG.Shutdown()
+		e.Booker.Shutdown()
 		e.BlockGadget.Shutdown()
 		e.SlotGadget.Shutdown()
+		e.Scheduler.Shutdown()
 		e.Clock.Shutdown()
+		e.Notarization.Shutdown()
+		e.Ledger.Shutdown()
 		e.SybilProtection.Shutdown()
-		e.Filter.Shutdown()
 		e.Storage.Shutdown()
 		e.Workers.Shutdown()
 	}
@@ -197,7 +211,7 @@ func (e *Engine) IsSynced() (isBootstrapped bool) {
 }
 
 func (e *Engine) API() iotago.API {
-	return e.Storage.Settings().API()
+	return e.Storage.Settings().CurrentAPI()
 }
 
 func (e *Engine) Initialize(snapshot ...string) (err error) {
@@ -257,15 +271,22 @@ func (e *Engine) Import(reader io.ReadSeeker) (err error) {
 		return errors.Wrap(err, "failed to import ledger")
 	} else if err = e.EvictionState.Import(reader); err != nil {
 		return errors.Wrap(err, "failed to import eviction state")
-		// } else if err = e.Notarization.Import(reader); err != nil {
-		// 	return errors.Wrap(err, "failed to import notarization state")
+	} else if err = e.SybilProtection.Import(reader); err != nil {
+		return errors.Wrap(err, "failed to import sybil protection state")
+	} else if err = e.Notarization.Import(reader); err != nil {
+		return errors.Wrap(err, "failed to import notarization state")
 	}
 
 	return
 }
 
 func (e *Engine) Export(writer io.WriteSeeker, targetSlot iotago.SlotIndex) (err error) {
-	if err = e.Storage.Settings().Export(writer); err != nil {
+	targetCommitment, err := e.Storage.Commitments().Load(targetSlot)
+	if err != nil {
+		return errors.Wrapf(err, "failed to load target commitment at slot %d", targetSlot)
+	}
+
+	if err = e.Storage.Settings().Export(writer, targetCommitment.Commitment()); err != nil {
 		return errors.Wrap(err, "failed to export settings")
 	} else if err = e.Storage.Commitments().Export(writer, targetSlot); err != nil {
 		return errors.Wrap(err, "failed to export commitments")
@@ -273,8 +294,10 @@ func (e *Engine) Export(writer io.WriteSeeker, targetSlot iotago.SlotIndex) (err
 		return errors.Wrap(err, "failed to export ledger")
 	} else if err = e.EvictionState.Export(writer, targetSlot); err != nil {
 		return errors.Wrap(err, "failed to export eviction state")
-		// } else if err = e.Notarization.Export(writer, targetSlot); err != nil {
-		// 	return errors.Wrap(err, "failed to export notarization state")
+	} else if err = e.SybilProtection.Export(writer, targetSlot); err != nil {
+		return errors.Wrap(err, "failed to export sybil protection state")
+	} else if err = e.Notarization.Export(writer, targetSlot); err != nil {
+		return errors.Wrap(err, "failed to export notarization state")
 	}
 
 	return
@@ -318,6 +341,27 @@ func (e *Engine) setupBlockStorage() {
 	}, event.WithWorkerPool(wp))
 }
 
+func (e *Engine) setupEquivocationStorage() {
+	e.Events.BlockDAG.ValidatorEquivocated.Hook(func(equivocation *model.Equivocation) {
+		// the evidence is stored in the slot of the block that revealed the equivocation.
+		block1, block2 := equivocation.Blocks()
+		index := block1.ID().Index()
+		if block2.ID().Index() > index {
+			index = block2.ID().Index()
+		}
+
+		store := e.Storage.Equivocations(index)
+		if store == nil {
+			e.errorHandler(errors.Errorf("failed to store equivocation of issuer %s, storage with index %d does not exist", equivocation.IssuerID(), index))
+			return
+		}
+
+		if err := store.Store(equivocation); err != nil {
+			e.errorHandler(errors.Wrapf(err, "failed to store equivocation of issuer %s", equivocation.IssuerID()))
+		}
+	}, event.WithWorkerPool(e.Workers.CreatePool("EquivocationStorage", 1)))
+}
+
 func (e *Engine) setupEvictionState() {
 	e.Events.EvictionState.LinkTo(e.EvictionState.Events)
 
@@ -356,11 +400,26 @@ func (e *Engine) setupBlockRequester() {
 		// TODO: ONLY START REQUESTING WHEN NOT IN WARPSYNC RANGE (or just not attach outside)?
 		e.BlockRequester.StartTicker(block.ID())
 	})
-	e.Events.BlockDAG.MissingBlockAttached.Hook(func(block *blocks.Block) {
+	// Blocks can also be requested without being referenced as missing (e.g. during warp sync), so we stop requesting
+	// them as soon as any block gets attached.
+	e.Events.BlockDAG.BlockAttached.Hook(func(block *blocks.Block) {
 		e.BlockRequester.StopTicker(block.ID())
 	}, event.WithWorkerPool(e.Workers.CreatePool("BlockRequester", 1))) // Using just 1 worker to avoid contention
 }
 
+func (e *Engine) setupOrphanage() {
+	e.Events.Orphanage.LinkTo(e.Orphanage.Events)
+
+	wp := e.Workers.CreatePool("Orphanage", 1) // Using just 1 worker to avoid contention
+
+	e.Events.Booker.BlockBooked.Hook(e.Orphanage.TrackBlock, event.WithWorkerPool(wp))
+	e.Events.BlockGadget.BlockAccepted.Hook(e.Orphanage.UntrackBlock)
+	e.Events.Clock.AcceptedTimeUpdated.Hook(e.Orphanage.UpdateAcceptedTime, event.WithWorkerPool(wp))
+	e.Events.Notarization.SlotCommitted.Hook(func(details *notarization.SlotCommittedDetails) {
+		e.Orphanage.CommitSlot(details.Commitment.Index())
+	}, event.WithWorkerPool(wp))
+}
+
 func (e *Engine) readSnapshot(filePath string) (err error) {
 	file, err := os.Open(filePath)
 	if err != nil {
@@ -422,6 +481,12 @@ func WithSnapshotDepth(depth int) options.Option[Engine] {
 	}
 }
 
+func WithOrphanageOptions(opts ...options.Option[orphanage.Detector]) options.Option[Engine] {
+	return func(e *Engine) {
+		e.optsOrphanage = append(e.optsOrphanage, opts...)
+	}
+}
+
 func WithRequesterOptions(opts ...options.Option[eventticker.EventTicker[iotago.SlotIndex, iotago.BlockID]]) options.Option[Engine] {
 	return func(e *Engine) {
 		e.optsBlockRequester = append(e.optsBlockRequester, opts...)
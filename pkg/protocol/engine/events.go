@@ -6,11 +6,13 @@ import (
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blockdag"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/booker"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/clock"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/congestioncontrol/scheduler"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/consensus/blockgadget"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/consensus/slotgadget"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/eviction"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/filter"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/notarization"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/orphanage"
 	iotago "github.com/iotaledger/iota.go/v4"
 )
 
@@ -22,10 +24,12 @@ type Events struct {
 	BlockRequester *eventticker.Events[iotago.SlotIndex, iotago.BlockID]
 	BlockDAG       *blockdag.Events
 	Booker         *booker.Events
+	Scheduler      *scheduler.Events
 	Clock          *clock.Events
 	BlockGadget    *blockgadget.Events
 	SlotGadget     *slotgadget.Events
 	Notarization   *notarization.Events
+	Orphanage      *orphanage.Events
 
 	event.Group[Events, *Events]
 }
@@ -39,9 +43,11 @@ var NewEvents = event.CreateGroupConstructor(func() (newEvents *Events) {
 		BlockRequester: eventticker.NewEvents[iotago.SlotIndex, iotago.BlockID](),
 		BlockDAG:       blockdag.NewEvents(),
 		Booker:         booker.NewEvents(),
+		Scheduler:      scheduler.NewEvents(),
 		Clock:          clock.NewEvents(),
 		BlockGadget:    blockgadget.NewEvents(),
 		SlotGadget:     slotgadget.NewEvents(),
 		Notarization:   notarization.NewEvents(),
+		Orphanage:      orphanage.NewEvents(),
 	}
 })
@@ -4,6 +4,7 @@ import (
 	"io"
 	"math"
 	"sync"
+	"sync/atomic"
 
 	"github.com/pkg/errors"
 
@@ -26,6 +27,10 @@ type State struct {
 	lastEvictedSlot      iotago.SlotIndex
 	evictionMutex        sync.RWMutex
 
+	// genesisFallback is set if the State was imported from a snapshot without root blocks (i.e. all slots since
+	// genesis were empty) and it is unset as soon as the first root block is added.
+	genesisFallback atomic.Bool
+
 	optsRootBlocksEvictionDelay iotago.SlotIndex
 }
 
@@ -143,6 +148,7 @@ func (s *State) AddRootBlock(id iotago.BlockID, commitmentID iotago.CommitmentID
 	}
 
 	s.latestRootBlocks.Add(id)
+	s.genesisFallback.Store(false)
 }
 
 // RemoveRootBlock removes a solid entry points from the map.
@@ -162,6 +168,10 @@ func (s *State) IsRootBlock(id iotago.BlockID) (has bool) {
 	s.evictionMutex.RLock()
 	defer s.evictionMutex.RUnlock()
 
+	if s.isGenesisFallback(id) {
+		return true
+	}
+
 	if !s.withinActiveIndexRange(id.Index()) {
 		return false
 	}
@@ -176,6 +186,10 @@ func (s *State) RootBlockCommitmentID(id iotago.BlockID) (commitmentID iotago.Co
 	s.evictionMutex.RLock()
 	defer s.evictionMutex.RUnlock()
 
+	if s.isGenesisFallback(id) {
+		return iotago.NewEmptyCommitment().MustID(), true
+	}
+
 	if !s.withinActiveIndexRange(id.Index()) {
 		return iotago.CommitmentID{}, false
 	}
@@ -198,12 +212,13 @@ func (s *State) LatestRootBlocks() iotago.BlockIDs {
 	return rootBlocks
 }
 
-// Export exports the root blocks to the given writer.
+// Export exports the root blocks that are active when the given target slot is the last evicted slot to the given
+// writer.
 func (s *State) Export(writer io.WriteSeeker, targetSlot iotago.SlotIndex) (err error) {
 	s.evictionMutex.RLock()
 	defer s.evictionMutex.RUnlock()
 
-	start, _ := s.activeIndexRange()
+	start, _ := s.activeIndexRangeAt(targetSlot)
 
 	return stream.WriteCollection(writer, func() (elementsCount uint64, err error) {
 		for currentSlot := start; currentSlot <= targetSlot; currentSlot++ {
@@ -232,8 +247,9 @@ func (s *State) Export(writer io.WriteSeeker, targetSlot iotago.SlotIndex) (err
 func (s *State) Import(reader io.ReadSeeker) (err error) {
 	var rootBlockID iotago.BlockID
 	var commitmentID iotago.CommitmentID
+	var rootBlocksCount int
 
-	return stream.ReadCollection(reader, func(i int) error {
+	if err = stream.ReadCollection(reader, func(i int) error {
 		if err = stream.ReadSerializable(reader, &rootBlockID, iotago.BlockIDLength); err != nil {
 			return errors.Wrapf(err, "failed to read root block id %d", i)
 		}
@@ -242,9 +258,16 @@ func (s *State) Import(reader io.ReadSeeker) (err error) {
 		}
 
 		s.AddRootBlock(rootBlockID, commitmentID)
+		rootBlocksCount++
 
 		return nil
-	})
+	}); err != nil {
+		return err
+	}
+
+	s.genesisFallback.Store(rootBlocksCount == 0)
+
+	return nil
 }
 
 // PopulateFromStorage populates the root blocks from the storage.
@@ -259,7 +282,11 @@ func (s *State) PopulateFromStorage(latestCommitmentIndex iotago.SlotIndex) {
 }
 
 func (s *State) activeIndexRange() (start, end iotago.SlotIndex) {
-	lastCommitted := s.lastEvictedSlot
+	return s.activeIndexRangeAt(s.lastEvictedSlot)
+}
+
+// activeIndexRangeAt returns the range of slots whose root blocks are active if the given slot is the last evicted slot.
+func (s *State) activeIndexRangeAt(lastCommitted iotago.SlotIndex) (start, end iotago.SlotIndex) {
 	delayed, valid := s.delayedBlockEvictionThreshold(lastCommitted)
 
 	if !valid {
@@ -273,6 +300,13 @@ func (s *State) activeIndexRange() (start, end iotago.SlotIndex) {
 	return delayed + 1, lastCommitted
 }
 
+// isGenesisFallback returns true if the given block is the genesis block and the State was just imported from a
+// snapshot without root blocks (i.e. all slots since genesis were empty), in which case blocks still attach to genesis
+// (see LatestRootBlocks) until the first root block is added.
+func (s *State) isGenesisFallback(id iotago.BlockID) bool {
+	return id == iotago.EmptyBlockID() && s.genesisFallback.Load()
+}
+
 func (s *State) withinActiveIndexRange(index iotago.SlotIndex) bool {
 	start, end := s.activeIndexRange()
 
@@ -1,8 +1,13 @@
 package eviction_test
 
 import (
+	"io"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	hivedb "github.com/iotaledger/hive.go/kvstore/database"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/eviction"
 	"github.com/iotaledger/iota-core/pkg/storage/database"
@@ -66,3 +71,35 @@ func TestState_RootBlocks(t *testing.T) {
 	ts.RequireLastEvictedSlot(4)
 	ts.RequireStorageRootBlocks("Root1.0", "Root1.1", "Root2.0", "Root3.0", "Root4.0", "Root4.1", "Root5.0")
 }
+
+func TestState_GenesisFallback(t *testing.T) {
+	prunableStorage := prunable.New(database.Config{
+		Engine:    hivedb.EngineMapDB,
+		Directory: t.TempDir(),
+	}, func(err error) {
+		t.Error(err)
+	})
+
+	snapshotFile, err := os.Create(filepath.Join(t.TempDir(), "snapshot.bin"))
+	require.NoError(t, err)
+	defer snapshotFile.Close()
+
+	// the slots since genesis were empty, so the snapshot does not contain root blocks.
+	require.NoError(t, eviction.NewState(prunableStorage.RootBlocks).Export(snapshotFile, 0))
+	_, err = snapshotFile.Seek(0, io.SeekStart)
+	require.NoError(t, err)
+
+	ts := NewTestFramework(t, prunableStorage, eviction.NewState(prunableStorage.RootBlocks))
+	require.False(t, ts.Instance.IsRootBlock(iotago.EmptyBlockID()))
+
+	// blocks of an engine that was just started from such a snapshot still attach to genesis.
+	require.NoError(t, ts.Instance.Import(snapshotFile))
+	require.True(t, ts.Instance.IsRootBlock(iotago.EmptyBlockID()))
+	commitmentID, isRootBlock := ts.Instance.RootBlockCommitmentID(iotago.EmptyBlockID())
+	require.True(t, isRootBlock)
+	require.Equal(t, iotago.NewEmptyCommitment().MustID(), commitmentID)
+
+	// the fallback ends with the first root block of the engine.
+	ts.CreateAndAddRootBlock("Root1.0", 1, iotago.NewEmptyCommitment().MustID())
+	require.False(t, ts.Instance.IsRootBlock(iotago.EmptyBlockID()))
+}
@@ -19,13 +19,14 @@ var (
 	ErrBlockTimeTooFarAheadInFuture = errors.New("a block cannot be too far ahead in the future")
 	ErrInvalidSignature             = errors.New("block has invalid signature")
 	ErrInvalidProofOfWork           = errors.New("error validating PoW")
+	ErrInvalidProtocolVersion       = errors.New("block has invalid protocol version")
 )
 
 // Filter filters blocks.
 type Filter struct {
 	events *filter.Events
 
-	protocolParamsFunc func() *iotago.ProtocolParameters
+	protocolParamsFunc func(index iotago.SlotIndex) *iotago.ProtocolParameters
 
 	optsMaxAllowedWallClockDrift time.Duration
 	optsMinCommittableSlotAge    iotago.SlotIndex
@@ -36,7 +37,7 @@ type Filter struct {
 
 func NewProvider(opts ...options.Option[Filter]) module.Provider[*engine.Engine, filter.Filter] {
 	return module.Provide(func(e *engine.Engine) filter.Filter {
-		f := New(e.Storage.Settings().ProtocolParameters, opts...)
+		f := New(e.Storage.Settings().ProtocolParametersForSlot, opts...)
 
 		e.HookConstructed(func() {
 			e.Events.Filter.LinkTo(f.events)
@@ -49,7 +50,7 @@ func NewProvider(opts ...options.Option[Filter]) module.Provider[*engine.Engine,
 var _ filter.Filter = new(Filter)
 
 // New creates a new Filter.
-func New(protocolParamsFunc func() *iotago.ProtocolParameters, opts ...options.Option[Filter]) *Filter {
+func New(protocolParamsFunc func(index iotago.SlotIndex) *iotago.ProtocolParameters, opts ...options.Option[Filter]) *Filter {
 	return options.Apply(&Filter{
 		events:                  filter.NewEvents(),
 		protocolParamsFunc:      protocolParamsFunc,
@@ -64,7 +65,18 @@ func New(protocolParamsFunc func() *iotago.ProtocolParameters, opts ...options.O
 func (f *Filter) ProcessReceivedBlock(block *model.Block, source network.PeerID) {
 	// TODO: if TX add check for TX timestamp
 
-	protocolParams := f.protocolParamsFunc()
+	// Check if the block uses the protocol version that is active in its slot.
+	protocolParams := f.protocolParamsFunc(block.ID().Index())
+	if block.Block().ProtocolVersion != protocolParams.Version {
+		f.events.BlockFiltered.Trigger(&filter.BlockFilteredEvent{
+			Block:  block,
+			Reason: errors.WithMessagef(ErrInvalidProtocolVersion, "version %d does not match the active version %d of slot %d", block.Block().ProtocolVersion, protocolParams.Version, block.ID().Index()),
+			Source: source,
+		})
+
+		return
+	}
+
 	if protocolParams.MinPoWScore > 0 {
 		// Check if the block has enough PoW score.
 		score, _, err := block.Block().POW()
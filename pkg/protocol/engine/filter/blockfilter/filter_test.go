@@ -29,7 +29,7 @@ func NewTestFramework(t *testing.T, protocolParams *iotago.ProtocolParameters, o
 		Test: t,
 		api:  iotago.V3API(protocolParams),
 
-		Filter: New(func() *iotago.ProtocolParameters {
+		Filter: New(func(iotago.SlotIndex) *iotago.ProtocolParameters {
 			return protocolParams
 		}, optsFilter...),
 	}
@@ -103,6 +103,17 @@ func (t *TestFramework) IssueUnsignedBlockAtSlot(alias string, index iotago.Slot
 	t.processBlock(alias, block)
 }
 
+func (t *TestFramework) IssueUnsignedBlockWithVersion(alias string, version byte) {
+	block, err := builder.NewBlockBuilder().
+		ProtocolVersion(version).
+		StrongParents(iotago.StrongParentsIDs{iotago.BlockID{}}).
+		IssuingTime(time.Now()).
+		Build()
+	require.NoError(t.Test, err)
+
+	t.processBlock(alias, block)
+}
+
 func (t *TestFramework) IssueSigned(alias string) {
 	keyPair := ed25519.GenerateKeyPair()
 	// We derive a dummy account from addr.
@@ -236,3 +247,23 @@ func TestFilter_MinPoW(t *testing.T) {
 	require.Less(t, tf.IssueUnsignedBlockWithoutPoW("invalid"), float64(params.MinPoWScore))
 	require.GreaterOrEqual(t, tf.IssueUnsignedBlockWithPoWScore("valid", 1000), float64(params.MinPoWScore))
 }
+
+func TestFilter_ProtocolVersion(t *testing.T) {
+	tf := NewTestFramework(t,
+		&protoParams,
+		WithSignatureValidation(false),
+	)
+
+	tf.Filter.events.BlockAllowed.Hook(func(block *model.Block) {
+		require.True(t, strings.HasPrefix(block.ID().Alias(), "valid"))
+	})
+
+	tf.Filter.events.BlockFiltered.Hook(func(event *filter.BlockFilteredEvent) {
+		require.True(t, strings.HasPrefix(event.Block.ID().Alias(), "invalid"))
+		require.True(t, errors.Is(event.Reason, ErrInvalidProtocolVersion))
+	})
+
+	tf.IssueUnsignedBlockWithVersion("valid", protoParams.Version)
+	tf.IssueUnsignedBlockWithVersion("invalid-older", protoParams.Version-1)
+	tf.IssueUnsignedBlockWithVersion("invalid-newer", protoParams.Version+1)
+}
@@ -0,0 +1,122 @@
+package accountsledger
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/serializer/v2/marshalutil"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// AccountDataLength is the length of a serialized AccountData.
+const AccountDataLength = iotago.IdentifierLength + iotago.OutputIDLength + marshalutil.Uint64Size + 2*(marshalutil.Int64Size+marshalutil.Uint64Size)
+
+// AccountData is the state of an account that is tracked by the accounts ledger.
+type AccountData struct {
+	// ID is the identifier of the account (the AliasID of the account output).
+	ID iotago.AccountID
+	// OutputID is the ID of the latest output of the account.
+	OutputID iotago.OutputID
+	// Deposit is the amount of base tokens that are held by the latest output of the account.
+	Deposit uint64
+	// Credits are the block issuance credits of the account.
+	Credits *BlockIssuanceCredits
+	// Mana is the Mana of the account at the time of its last update.
+	Mana *StoredMana
+}
+
+// NewAccountData creates a new AccountData for the given account output.
+func NewAccountData(id iotago.AccountID, outputID iotago.OutputID, deposit uint64, index iotago.SlotIndex) *AccountData {
+	return &AccountData{
+		ID:       id,
+		OutputID: outputID,
+		Deposit:  deposit,
+		Credits:  &BlockIssuanceCredits{UpdateTime: index},
+		Mana:     &StoredMana{UpdateTime: index},
+	}
+}
+
+// Clone returns a deep copy of the AccountData.
+func (a *AccountData) Clone() *AccountData {
+	return &AccountData{
+		ID:       a.ID,
+		OutputID: a.OutputID,
+		Deposit:  a.Deposit,
+		Credits:  &BlockIssuanceCredits{Value: a.Credits.Value, UpdateTime: a.Credits.UpdateTime},
+		Mana:     &StoredMana{Value: a.Mana.Value, UpdateTime: a.Mana.UpdateTime},
+	}
+}
+
+// FromBytes unmarshals the AccountData from the given bytes.
+func (a *AccountData) FromBytes(bytes []byte) (int, error) {
+	m := marshalutil.New(bytes)
+
+	idBytes, err := m.ReadBytes(iotago.IdentifierLength)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to read account ID")
+	}
+	copy(a.ID[:], idBytes)
+
+	outputIDBytes, err := m.ReadBytes(iotago.OutputIDLength)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to read output ID")
+	}
+	copy(a.OutputID[:], outputIDBytes)
+
+	if a.Deposit, err = m.ReadUint64(); err != nil {
+		return 0, errors.Wrap(err, "failed to read deposit")
+	}
+
+	a.Credits = new(BlockIssuanceCredits)
+	if a.Credits.Value, err = m.ReadInt64(); err != nil {
+		return 0, errors.Wrap(err, "failed to read credits")
+	}
+
+	creditsUpdateTime, err := m.ReadUint64()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to read credits update time")
+	}
+	a.Credits.UpdateTime = iotago.SlotIndex(creditsUpdateTime)
+
+	a.Mana = new(StoredMana)
+	if a.Mana.Value, err = m.ReadUint64(); err != nil {
+		return 0, errors.Wrap(err, "failed to read mana")
+	}
+
+	manaUpdateTime, err := m.ReadUint64()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to read mana update time")
+	}
+	a.Mana.UpdateTime = iotago.SlotIndex(manaUpdateTime)
+
+	return m.ReadOffset(), nil
+}
+
+// Bytes returns the serialized form of the AccountData.
+func (a AccountData) Bytes() ([]byte, error) {
+	m := marshalutil.New(AccountDataLength)
+	m.WriteBytes(a.ID[:])
+	m.WriteBytes(a.OutputID[:])
+	m.WriteUint64(a.Deposit)
+	m.WriteInt64(a.Credits.Value)
+	m.WriteUint64(uint64(a.Credits.UpdateTime))
+	m.WriteUint64(a.Mana.Value)
+	m.WriteUint64(uint64(a.Mana.UpdateTime))
+
+	return m.Bytes(), nil
+}
+
+// BlockIssuanceCredits are the credits of an account that are burned by the blocks that it issues.
+type BlockIssuanceCredits struct {
+	// Value is the amount of credits (which can become negative if the account issued more blocks than it could afford).
+	Value int64
+	// UpdateTime is the slot of the last change of the credits.
+	UpdateTime iotago.SlotIndex
+}
+
+// StoredMana is the Mana of an account at a given slot.
+type StoredMana struct {
+	// Value is the amount of Mana at the UpdateTime.
+	Value uint64
+	// UpdateTime is the slot at which the Mana was last updated.
+	UpdateTime iotago.SlotIndex
+}
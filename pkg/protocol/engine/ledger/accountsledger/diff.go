@@ -0,0 +1,90 @@
+package accountsledger
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/serializer/v2/marshalutil"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// accountDiff stores the state of the accounts that were changed in a slot before the slot was applied, so that the
+// accounts ledger can be rolled back to earlier slots (e.g. to export a snapshot).
+type accountDiff struct {
+	// previousState contains the previous state of every changed account (nil if the account did not exist).
+	previousState map[iotago.AccountID]*AccountData
+}
+
+func newAccountDiff() *accountDiff {
+	return &accountDiff{
+		previousState: make(map[iotago.AccountID]*AccountData),
+	}
+}
+
+// FromBytes unmarshals the accountDiff from the given bytes.
+func (d *accountDiff) FromBytes(b []byte) (int, error) {
+	m := marshalutil.New(b)
+
+	count, err := m.ReadUint32()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to read account count")
+	}
+
+	for i := uint32(0); i < count; i++ {
+		var accountID iotago.AccountID
+		accountIDBytes, err := m.ReadBytes(iotago.IdentifierLength)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to read account ID %d", i)
+		}
+		copy(accountID[:], accountIDBytes)
+
+		existed, err := m.ReadBool()
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to read existence flag of account %s", accountID)
+		}
+
+		if !existed {
+			d.previousState[accountID] = nil
+
+			continue
+		}
+
+		accountDataBytes, err := m.ReadBytes(AccountDataLength)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to read previous state of account %s", accountID)
+		}
+
+		accountData := new(AccountData)
+		if _, err = accountData.FromBytes(accountDataBytes); err != nil {
+			return 0, errors.Wrapf(err, "failed to parse previous state of account %s", accountID)
+		}
+		d.previousState[accountID] = accountData
+	}
+
+	return m.ReadOffset(), nil
+}
+
+// Bytes returns the serialized form of the accountDiff.
+func (d *accountDiff) Bytes() ([]byte, error) {
+	accountIDs := lo.Keys(d.previousState)
+	sort.Slice(accountIDs, func(i, j int) bool {
+		return bytes.Compare(accountIDs[i][:], accountIDs[j][:]) < 0
+	})
+
+	m := marshalutil.New()
+	m.WriteUint32(uint32(len(accountIDs)))
+	for _, accountID := range accountIDs {
+		m.WriteBytes(accountID[:])
+
+		previousState := d.previousState[accountID]
+		m.WriteBool(previousState != nil)
+		if previousState != nil {
+			m.WriteBytes(lo.PanicOnErr(previousState.Bytes()))
+		}
+	}
+
+	return m.Bytes(), nil
+}
@@ -0,0 +1,96 @@
+package accountsledger
+
+import (
+	"math"
+	"math/bits"
+
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// manaDecayFactorExponent is the scaling exponent of the fixed point decay factor (the factor is given in 1/2^32).
+const manaDecayFactorExponent = 32
+
+// ManaDecayProvider calculates the decay and the generation of Mana.
+type ManaDecayProvider struct {
+	// epochLengthFunc returns the amount of slots after which Mana decays.
+	epochLengthFunc func() iotago.SlotIndex
+	// decayFactor is the factor (scaled by 2^32) that is applied to Mana at the end of every decay epoch.
+	decayFactor uint64
+	// generationRateExponent is the exponent of the rate (1/2^x per slot and base token) at which Mana is generated.
+	generationRateExponent uint8
+}
+
+// NewManaDecayProvider creates a new ManaDecayProvider.
+func NewManaDecayProvider(epochLengthFunc func() iotago.SlotIndex, decayFactor uint32, generationRateExponent uint8) *ManaDecayProvider {
+	return &ManaDecayProvider{
+		epochLengthFunc:        epochLengthFunc,
+		decayFactor:            uint64(decayFactor),
+		generationRateExponent: generationRateExponent,
+	}
+}
+
+// ManaAt returns the Mana of the given account at the given slot: the stored Mana is decayed for every epoch boundary
+// that was crossed since its last update and the Mana that was generated by the deposit in the meantime is added.
+func (m *ManaDecayProvider) ManaAt(accountData *AccountData, index iotago.SlotIndex) uint64 {
+	if index <= accountData.Mana.UpdateTime {
+		return accountData.Mana.Value
+	}
+
+	decayed := m.Decay(accountData.Mana.Value, accountData.Mana.UpdateTime, index)
+	generated := m.Generate(accountData.Deposit, index-accountData.Mana.UpdateTime)
+
+	if decayed > math.MaxUint64-generated {
+		return math.MaxUint64
+	}
+
+	return decayed + generated
+}
+
+// CreditsAt returns the block issuance credits of the given account at the given slot: the Mana that was generated by
+// the deposit since the last update of the credits is added to the stored credits.
+func (m *ManaDecayProvider) CreditsAt(accountData *AccountData, index iotago.SlotIndex) int64 {
+	if index <= accountData.Credits.UpdateTime {
+		return accountData.Credits.Value
+	}
+
+	generated := m.Generate(accountData.Deposit, index-accountData.Credits.UpdateTime)
+	if generated > math.MaxInt64 || accountData.Credits.Value > math.MaxInt64-int64(generated) {
+		return math.MaxInt64
+	}
+
+	return accountData.Credits.Value + int64(generated)
+}
+
+// Decay applies the decay of all epoch boundaries between the two given slots to the given amount of Mana.
+func (m *ManaDecayProvider) Decay(mana uint64, from, to iotago.SlotIndex) uint64 {
+	epochLength := m.epochLengthFunc()
+	if epochLength == 0 || to <= from {
+		return mana
+	}
+
+	for epochs := to/epochLength - from/epochLength; epochs > 0 && mana > 0; epochs-- {
+		// the factor is smaller than 2^32, so the result always fits into 64 bits.
+		high, low := bits.Mul64(mana, m.decayFactor)
+		mana = high<<(64-manaDecayFactorExponent) | low>>manaDecayFactorExponent
+	}
+
+	return mana
+}
+
+// Generate returns the amount of Mana that is generated by the given deposit over the given amount of slots.
+func (m *ManaDecayProvider) Generate(deposit uint64, slots iotago.SlotIndex) uint64 {
+	high, low := bits.Mul64(deposit, uint64(slots))
+	if m.generationRateExponent == 0 {
+		if high != 0 {
+			return math.MaxUint64
+		}
+
+		return low
+	}
+
+	if high>>m.generationRateExponent != 0 {
+		return math.MaxUint64
+	}
+
+	return high<<(64-m.generationRateExponent) | low>>m.generationRateExponent
+}
@@ -0,0 +1,339 @@
+package accountsledger
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/ads"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+const (
+	prefixLatestCommittedSlot byte = iota
+	prefixAccounts
+	prefixDiffs
+)
+
+// Manager is the accounts ledger: it keeps track of the account outputs, the block issuance credits and the Mana of
+// all accounts and commits to them in an authenticated tree.
+type Manager struct {
+	store             kvstore.KVStore
+	accountsTree      *ads.Map[iotago.AccountID, AccountData, *iotago.AccountID, *AccountData]
+	manaDecayProvider *ManaDecayProvider
+	latestSlot        iotago.SlotIndex
+	mutex             sync.RWMutex
+
+	optsBlockIssuanceCost          int64
+	optsManaDecayFactor            uint32
+	optsManaGenerationRateExponent uint8
+}
+
+// New creates a new accounts ledger that persists its state in the given store. Mana decays at the end of every epoch
+// of the given length.
+func New(store kvstore.KVStore, epochLengthFunc func() iotago.SlotIndex, opts ...options.Option[Manager]) *Manager {
+	return options.Apply(&Manager{
+		store:        store,
+		accountsTree: ads.NewMap[iotago.AccountID, AccountData](lo.PanicOnErr(store.WithExtendedRealm(kvstore.Realm{prefixAccounts}))),
+
+		optsBlockIssuanceCost:          1,
+		optsManaDecayFactor:            4294537799,
+		optsManaGenerationRateExponent: 10,
+	}, opts, func(m *Manager) {
+		m.latestSlot = lo.PanicOnErr(m.loadLatestCommittedSlot())
+		m.manaDecayProvider = NewManaDecayProvider(epochLengthFunc, m.optsManaDecayFactor, m.optsManaGenerationRateExponent)
+	})
+}
+
+// LatestCommittedSlot returns the index of the latest slot that was applied to the accounts ledger.
+func (m *Manager) LatestCommittedSlot() iotago.SlotIndex {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.latestSlot
+}
+
+// Account returns the state of the given account as of the latest committed slot.
+func (m *Manager) Account(accountID iotago.AccountID) (accountData *AccountData, exists bool, err error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	accountData, exists = m.accountsTree.Get(accountID)
+
+	return accountData, exists, nil
+}
+
+// ForEachAccount iterates over the states of all accounts as of the latest committed slot.
+func (m *Manager) ForEachAccount(consumer func(accountData *AccountData) bool) error {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.accountsTree.Stream(func(_ iotago.AccountID, accountData *AccountData) bool {
+		return consumer(accountData)
+	})
+}
+
+// Mana returns the Mana of the given account at the given slot.
+func (m *Manager) Mana(accountID iotago.AccountID, index iotago.SlotIndex) (mana uint64, exists bool, err error) {
+	accountData, exists, err := m.Account(accountID)
+	if err != nil || !exists {
+		return 0, exists, err
+	}
+
+	return m.manaDecayProvider.ManaAt(accountData, index), true, nil
+}
+
+// Credits returns the block issuance credits of the given account at the given slot.
+func (m *Manager) Credits(accountID iotago.AccountID, index iotago.SlotIndex) (credits int64, exists bool, err error) {
+	accountData, exists, err := m.Account(accountID)
+	if err != nil || !exists {
+		return 0, exists, err
+	}
+
+	return m.manaDecayProvider.CreditsAt(accountData, index), true, nil
+}
+
+// ManaDecayProvider returns the ManaDecayProvider that is used to calculate the Mana of the accounts.
+func (m *Manager) ManaDecayProvider() *ManaDecayProvider {
+	return m.manaDecayProvider
+}
+
+// AccountsRoot returns the root of the authenticated tree of all accounts.
+func (m *Manager) AccountsRoot() iotago.Identifier {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return iotago.Identifier(m.accountsTree.Root())
+}
+
+// AddAccountOutput adds the account of the given output to the accounts ledger (if it is an account output). It is
+// used to populate the accounts ledger when creating a snapshot.
+func (m *Manager) AddAccountOutput(output *ledgerstate.Output) error {
+	accountID, isAccountOutput := accountIDFromOutput(output)
+	if !isAccountOutput {
+		return nil
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.accountsTree.Has(accountID) {
+		return errors.Errorf("account %s already exists", accountID)
+	}
+
+	m.accountsTree.Set(accountID, NewAccountData(accountID, output.OutputID(), output.Deposit(), output.SlotIndexBooked()))
+
+	return nil
+}
+
+// ApplyDiff applies the created and consumed outputs of the given slot and burns the block issuance credits of the
+// ratified accepted blocks that its commitment contains (issuedBlocks maps the issuers to their number of blocks). The
+// credits that were generated since their last update are added before anything is burned.
+func (m *Manager) ApplyDiff(index iotago.SlotIndex, outputs ledgerstate.Outputs, spents ledgerstate.Spents, issuedBlocks map[iotago.AccountID]int64) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if index != m.latestSlot+1 {
+		return errors.Errorf("cannot apply diff of slot %d, latest committed slot is %d", index, m.latestSlot)
+	}
+
+	createdAccounts := make(map[iotago.AccountID]*ledgerstate.Output)
+	for _, output := range outputs {
+		if accountID, isAccountOutput := accountIDFromOutput(output); isAccountOutput {
+			createdAccounts[accountID] = output
+		}
+	}
+
+	consumedAccounts := make(map[iotago.AccountID]*ledgerstate.Output)
+	for _, spent := range spents {
+		if accountID, isAccountOutput := accountIDFromOutput(spent.Output()); isAccountOutput {
+			consumedAccounts[accountID] = spent.Output()
+		}
+	}
+
+	diff := newAccountDiff()
+	for _, accountID := range sortedAccountIDs(createdAccounts, consumedAccounts, issuedBlocks) {
+		accountData, exists := m.accountsTree.Get(accountID)
+		if exists {
+			diff.previousState[accountID] = accountData.Clone()
+
+			// the credits are generated by the deposit, so they need to be updated before the deposit changes.
+			accountData.Credits.Value = m.manaDecayProvider.CreditsAt(accountData, index)
+			accountData.Credits.UpdateTime = index
+		} else {
+			diff.previousState[accountID] = nil
+		}
+
+		if createdOutput, created := createdAccounts[accountID]; created {
+			if !exists {
+				accountData = NewAccountData(accountID, createdOutput.OutputID(), createdOutput.Deposit(), index)
+			} else {
+				accountData.Mana.Value = m.manaDecayProvider.ManaAt(accountData, index)
+				accountData.Mana.UpdateTime = index
+				accountData.OutputID = createdOutput.OutputID()
+				accountData.Deposit = createdOutput.Deposit()
+			}
+		} else if _, consumed := consumedAccounts[accountID]; consumed {
+			m.accountsTree.Delete(accountID)
+
+			continue
+		} else if !exists {
+			// blocks of issuers that are not accounts do not burn anything.
+			delete(diff.previousState, accountID)
+
+			continue
+		}
+
+		if blockCount, issued := issuedBlocks[accountID]; issued {
+			accountData.Credits.Value -= blockCount * m.optsBlockIssuanceCost
+		}
+
+		m.accountsTree.Set(accountID, accountData)
+	}
+
+	if err := m.store.Set(diffKey(index), lo.PanicOnErr(diff.Bytes())); err != nil {
+		return errors.Wrapf(err, "failed to store accounts diff of slot %d", index)
+	}
+
+	return m.setLatestCommittedSlot(index)
+}
+
+func (m *Manager) loadLatestCommittedSlot() (iotago.SlotIndex, error) {
+	latestCommittedSlotBytes, err := m.store.Get([]byte{prefixLatestCommittedSlot})
+	if err != nil {
+		if errors.Is(err, kvstore.ErrKeyNotFound) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	return iotago.SlotIndexFromBytes(latestCommittedSlotBytes)
+}
+
+func (m *Manager) setLatestCommittedSlot(index iotago.SlotIndex) error {
+	m.latestSlot = index
+
+	return m.store.Set([]byte{prefixLatestCommittedSlot}, index.Bytes())
+}
+
+// RollbackToSlot reverts the accounts ledger to the given slot by rolling back the stored diffs of all later slots.
+func (m *Manager) RollbackToSlot(targetIndex iotago.SlotIndex) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if targetIndex > m.latestSlot {
+		return errors.Errorf("cannot roll back accounts ledger to slot %d, latest committed slot is %d", targetIndex, m.latestSlot)
+	}
+
+	// load all diffs upfront so that the accounts ledger is not modified if a diff is missing.
+	diffs := make(map[iotago.SlotIndex]*accountDiff)
+	for index := m.latestSlot; index > targetIndex; index-- {
+		diff, err := m.loadDiff(index)
+		if err != nil {
+			return err
+		}
+
+		diffs[index] = diff
+	}
+
+	for index := m.latestSlot; index > targetIndex; index-- {
+		for accountID, previousState := range diffs[index].previousState {
+			if previousState == nil {
+				m.accountsTree.Delete(accountID)
+			} else {
+				m.accountsTree.Set(accountID, previousState)
+			}
+		}
+
+		if err := m.store.Delete(diffKey(index)); err != nil {
+			return errors.Wrapf(err, "failed to delete accounts diff of slot %d", index)
+		}
+
+		if err := m.setLatestCommittedSlot(index - 1); err != nil {
+			return errors.Wrapf(err, "failed to store latest committed slot %d", index-1)
+		}
+	}
+
+	return nil
+}
+
+// rollbackDiff reverts the changes of the given slot in the given accounts.
+func (m *Manager) rollbackDiff(accounts map[iotago.AccountID]*AccountData, index iotago.SlotIndex) error {
+	diff, err := m.loadDiff(index)
+	if err != nil {
+		return err
+	}
+
+	for accountID, previousState := range diff.previousState {
+		if previousState == nil {
+			delete(accounts, accountID)
+		} else {
+			accounts[accountID] = previousState
+		}
+	}
+
+	return nil
+}
+
+// loadDiff loads the accounts diff of the given slot.
+func (m *Manager) loadDiff(index iotago.SlotIndex) (*accountDiff, error) {
+	diffBytes, err := m.store.Get(diffKey(index))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load accounts diff of slot %d", index)
+	}
+
+	diff := newAccountDiff()
+	if _, err = diff.FromBytes(diffBytes); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse accounts diff of slot %d", index)
+	}
+
+	return diff, nil
+}
+
+func diffKey(index iotago.SlotIndex) []byte {
+	return append([]byte{prefixDiffs}, index.Bytes()...)
+}
+
+// accountIDFromOutput returns the ID of the account that is represented by the given output (if it is an AliasOutput).
+func accountIDFromOutput(output *ledgerstate.Output) (accountID iotago.AccountID, isAccountOutput bool) {
+	aliasOutput, isAliasOutput := output.Output().(*iotago.AliasOutput)
+	if !isAliasOutput {
+		return iotago.AccountID{}, false
+	}
+
+	aliasID := aliasOutput.AliasID
+	if aliasID.Empty() {
+		aliasID = iotago.AliasIDFromOutputID(output.OutputID())
+	}
+
+	return iotago.AccountID(aliasID), true
+}
+
+// sortedAccountIDs returns the (deterministically ordered) union of the keys of the given maps.
+func sortedAccountIDs(createdAccounts, consumedAccounts map[iotago.AccountID]*ledgerstate.Output, issuedBlocks map[iotago.AccountID]int64) []iotago.AccountID {
+	accountIDs := lo.Keys(createdAccounts)
+	for accountID := range consumedAccounts {
+		if _, exists := createdAccounts[accountID]; !exists {
+			accountIDs = append(accountIDs, accountID)
+		}
+	}
+	for accountID := range issuedBlocks {
+		_, created := createdAccounts[accountID]
+		if _, consumed := consumedAccounts[accountID]; !created && !consumed {
+			accountIDs = append(accountIDs, accountID)
+		}
+	}
+
+	sort.Slice(accountIDs, func(i, j int) bool {
+		return bytes.Compare(accountIDs[i][:], accountIDs[j][:]) < 0
+	})
+
+	return accountIDs
+}
@@ -0,0 +1,205 @@
+package accountsledger_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/orcaman/writerseeker"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledger/accountsledger"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate/tpkg"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestManager_ApplyDiff(t *testing.T) {
+	// every base token of the deposit generates a credit per slot.
+	manager := accountsledger.New(mapdb.NewMapDB(), epochLength, accountsledger.WithBlockIssuanceCost(3), accountsledger.WithManaGenerationRateExponent(0))
+
+	account1 := tpkg.RandLedgerStateOutputOnAddressWithAmount(iotago.OutputAlias, tpkg.RandAddress(iotago.AddressEd25519), 1_000)
+	account1ID := accountID(account1)
+
+	require.NoError(t, manager.ApplyDiff(1, ledgerstate.Outputs{account1, tpkg.RandLedgerStateOutputWithType(iotago.OutputBasic)}, nil, nil))
+	require.Equal(t, iotago.SlotIndex(1), manager.LatestCommittedSlot())
+
+	accountData, exists, err := manager.Account(account1ID)
+	require.NoError(t, err)
+	require.True(t, exists)
+	require.Equal(t, account1.OutputID(), accountData.OutputID)
+	require.Equal(t, uint64(1_000), accountData.Deposit)
+	require.Equal(t, int64(0), accountData.Credits.Value)
+
+	// Blocks issued by the account burn its credits (after adding the generated credits) once their slot is committed.
+	issuedBlocks := map[iotago.AccountID]int64{account1ID: 2, {1}: 1}
+
+	rootBeforeTransition := manager.AccountsRoot()
+
+	account1Transition := ledgerstate.CreateOutput(tpkg.API(), tpkg.RandOutputID(), tpkg.RandBlockID(), 2, tpkg.RandTimestamp(), &iotago.AliasOutput{
+		Amount:  2_000,
+		AliasID: iotago.AliasID(account1ID),
+	})
+	require.NoError(t, manager.ApplyDiff(2, ledgerstate.Outputs{account1Transition}, ledgerstate.Spents{tpkg.RandLedgerStateSpentWithOutput(account1, 2, time.Now())}, issuedBlocks))
+	require.NotEqual(t, rootBeforeTransition, manager.AccountsRoot())
+
+	accountData, exists, err = manager.Account(account1ID)
+	require.NoError(t, err)
+	require.True(t, exists)
+	require.Equal(t, account1Transition.OutputID(), accountData.OutputID)
+	require.Equal(t, uint64(2_000), accountData.Deposit)
+	require.Equal(t, int64(1_000-6), accountData.Credits.Value)
+	require.Equal(t, iotago.SlotIndex(2), accountData.Credits.UpdateTime)
+
+	_, exists, err = manager.Account(iotago.AccountID{1})
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	// Only accounts that are backed by an account output are part of the ledger.
+	deposits := make(map[iotago.AccountID]uint64)
+	require.NoError(t, manager.ForEachAccount(func(accountData *accountsledger.AccountData) bool {
+		deposits[accountData.ID] = accountData.Deposit
+
+		return true
+	}))
+	require.Equal(t, map[iotago.AccountID]uint64{account1ID: 2_000}, deposits)
+
+	// Destroying the account removes it from the ledger.
+	require.NoError(t, manager.ApplyDiff(3, nil, ledgerstate.Spents{tpkg.RandLedgerStateSpentWithOutput(account1Transition, 3, time.Now())}, nil))
+
+	_, exists, err = manager.Account(account1ID)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	// Slots need to be applied in order.
+	require.Error(t, manager.ApplyDiff(5, nil, nil, nil))
+}
+
+func TestManager_Snapshot(t *testing.T) {
+	manager := accountsledger.New(mapdb.NewMapDB(), epochLength)
+
+	account1 := tpkg.RandLedgerStateOutputOnAddressWithAmount(iotago.OutputAlias, tpkg.RandAddress(iotago.AddressEd25519), 1_000)
+	account2 := tpkg.RandLedgerStateOutputOnAddressWithAmount(iotago.OutputAlias, tpkg.RandAddress(iotago.AddressEd25519), 2_000)
+
+	require.NoError(t, manager.ApplyDiff(1, ledgerstate.Outputs{account1}, nil, nil))
+	rootAtSlot1 := manager.AccountsRoot()
+
+	require.NoError(t, manager.ApplyDiff(2, ledgerstate.Outputs{account2}, nil, map[iotago.AccountID]int64{accountID(account1): 1}))
+
+	// Exporting at an earlier slot rolls back the changes of the later slots.
+	writer := &writerseeker.WriterSeeker{}
+	require.NoError(t, manager.Export(writer, 1))
+
+	importedManager := accountsledger.New(mapdb.NewMapDB(), epochLength)
+	require.NoError(t, importedManager.Import(writer.BytesReader()))
+	require.Equal(t, iotago.SlotIndex(1), importedManager.LatestCommittedSlot())
+	require.Equal(t, rootAtSlot1, importedManager.AccountsRoot())
+
+	_, exists, err := importedManager.Account(accountID(account2))
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	writer = &writerseeker.WriterSeeker{}
+	require.NoError(t, manager.Export(writer, 2))
+
+	importedManager = accountsledger.New(mapdb.NewMapDB(), epochLength)
+	require.NoError(t, importedManager.Import(writer.BytesReader()))
+	require.Equal(t, manager.AccountsRoot(), importedManager.AccountsRoot())
+
+	require.Error(t, manager.Export(&writerseeker.WriterSeeker{}, 3))
+}
+
+func TestManager_RollbackToSlot(t *testing.T) {
+	manager := accountsledger.New(mapdb.NewMapDB(), epochLength)
+
+	account1 := tpkg.RandLedgerStateOutputOnAddressWithAmount(iotago.OutputAlias, tpkg.RandAddress(iotago.AddressEd25519), 1_000)
+	account2 := tpkg.RandLedgerStateOutputOnAddressWithAmount(iotago.OutputAlias, tpkg.RandAddress(iotago.AddressEd25519), 2_000)
+
+	require.NoError(t, manager.ApplyDiff(1, ledgerstate.Outputs{account1}, nil, nil))
+	rootAtSlot1 := manager.AccountsRoot()
+
+	require.NoError(t, manager.ApplyDiff(2, ledgerstate.Outputs{account2}, nil, map[iotago.AccountID]int64{accountID(account1): 1}))
+	require.NoError(t, manager.ApplyDiff(3, nil, ledgerstate.Spents{tpkg.RandLedgerStateSpentWithOutput(account1, 3, time.Now())}, nil))
+
+	require.Error(t, manager.RollbackToSlot(4))
+
+	require.NoError(t, manager.RollbackToSlot(1))
+	require.Equal(t, iotago.SlotIndex(1), manager.LatestCommittedSlot())
+	require.Equal(t, rootAtSlot1, manager.AccountsRoot())
+
+	accountData, exists, err := manager.Account(accountID(account1))
+	require.NoError(t, err)
+	require.True(t, exists)
+	require.Equal(t, int64(0), accountData.Credits.Value)
+
+	_, exists, err = manager.Account(accountID(account2))
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	// Slots after the target slot can be applied again.
+	require.NoError(t, manager.ApplyDiff(2, ledgerstate.Outputs{account2}, nil, nil))
+}
+
+func TestManager_Credits(t *testing.T) {
+	// every base token generates 1/4 credit per slot.
+	manager := accountsledger.New(mapdb.NewMapDB(), epochLength, accountsledger.WithBlockIssuanceCost(100), accountsledger.WithManaGenerationRateExponent(2))
+
+	account := tpkg.RandLedgerStateOutputOnAddressWithAmount(iotago.OutputAlias, tpkg.RandAddress(iotago.AddressEd25519), 1_000)
+	require.NoError(t, manager.ApplyDiff(1, ledgerstate.Outputs{account}, nil, nil))
+
+	// the credits are generated by the deposit of the account.
+	credits, exists, err := manager.Credits(accountID(account), 3)
+	require.NoError(t, err)
+	require.True(t, exists)
+	require.Equal(t, int64(500), credits)
+
+	require.NoError(t, manager.ApplyDiff(2, nil, nil, nil))
+	require.NoError(t, manager.ApplyDiff(3, nil, nil, nil))
+
+	// the blocks of the account burn the credits that were generated until their slot.
+	require.NoError(t, manager.ApplyDiff(4, nil, nil, map[iotago.AccountID]int64{accountID(account): 4}))
+
+	accountData, exists, err := manager.Account(accountID(account))
+	require.NoError(t, err)
+	require.True(t, exists)
+	require.Equal(t, int64(750-400), accountData.Credits.Value)
+	require.Equal(t, iotago.SlotIndex(4), accountData.Credits.UpdateTime)
+
+	credits, _, err = manager.Credits(accountID(account), 5)
+	require.NoError(t, err)
+	require.Equal(t, int64(350+250), credits)
+
+	// the burned credits are restored when rolling back.
+	require.NoError(t, manager.RollbackToSlot(3))
+
+	credits, _, err = manager.Credits(accountID(account), 4)
+	require.NoError(t, err)
+	require.Equal(t, int64(750), credits)
+}
+
+func TestManaDecayProvider(t *testing.T) {
+	// Mana halves every 10 slots and every base token generates 1/2 Mana per slot.
+	provider := accountsledger.NewManaDecayProvider(func() iotago.SlotIndex { return 10 }, 1<<31, 1)
+
+	require.Equal(t, uint64(1_000), provider.Decay(1_000, 10, 19))
+	require.Equal(t, uint64(500), provider.Decay(1_000, 19, 20))
+	require.Equal(t, uint64(250), provider.Decay(1_000, 5, 25))
+
+	require.Equal(t, uint64(50), provider.Generate(10, 10))
+
+	accountData := accountsledger.NewAccountData(iotago.AccountID{1}, tpkg.RandOutputID(), 10, 5)
+	accountData.Mana.Value = 1_000
+
+	require.Equal(t, uint64(1_000), provider.ManaAt(accountData, 5))
+	require.Equal(t, uint64(1_000+20), provider.ManaAt(accountData, 9))
+	require.Equal(t, uint64(250+100), provider.ManaAt(accountData, 25))
+}
+
+func epochLength() iotago.SlotIndex {
+	return 32
+}
+
+func accountID(output *ledgerstate.Output) iotago.AccountID {
+	//nolint:forcetypeassert // we only call this for alias outputs
+	return iotago.AccountID(output.Output().(*iotago.AliasOutput).AliasID)
+}
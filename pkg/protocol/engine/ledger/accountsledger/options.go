@@ -0,0 +1,26 @@
+package accountsledger
+
+import (
+	"github.com/iotaledger/hive.go/runtime/options"
+)
+
+// WithBlockIssuanceCost sets the amount of block issuance credits that are burned for every issued block.
+func WithBlockIssuanceCost(blockIssuanceCost int64) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsBlockIssuanceCost = blockIssuanceCost
+	}
+}
+
+// WithManaDecayFactor sets the factor (scaled by 2^32) that is applied to Mana at the end of every decay epoch.
+func WithManaDecayFactor(decayFactor uint32) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsManaDecayFactor = decayFactor
+	}
+}
+
+// WithManaGenerationRateExponent sets the exponent of the rate (1/2^x per slot and base token) at which Mana is generated.
+func WithManaGenerationRateExponent(exponent uint8) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsManaGenerationRateExponent = exponent
+	}
+}
@@ -0,0 +1,85 @@
+package accountsledger
+
+import (
+	"bytes"
+	"io"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/serializer/v2/stream"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// Import imports the accounts ledger from the given reader.
+func (m *Manager) Import(reader io.ReadSeeker) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	index, err := stream.Read[uint64](reader)
+	if err != nil {
+		return errors.Wrap(err, "failed to read accounts ledger index")
+	}
+
+	if err = stream.ReadCollection(reader, func(i int) error {
+		accountData := new(AccountData)
+		if err = stream.ReadSerializable(reader, accountData, AccountDataLength); err != nil {
+			return errors.Wrapf(err, "failed to read account %d", i)
+		}
+
+		m.accountsTree.Set(accountData.ID, accountData)
+
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "failed to read accounts")
+	}
+
+	return m.setLatestCommittedSlot(iotago.SlotIndex(index))
+}
+
+// Export exports the state of the accounts ledger at the given slot to the given writer.
+func (m *Manager) Export(writer io.WriteSeeker, targetIndex iotago.SlotIndex) error {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	if targetIndex > m.latestSlot {
+		return errors.Errorf("cannot export accounts ledger at slot %d, latest committed slot is %d", targetIndex, m.latestSlot)
+	}
+
+	accounts := make(map[iotago.AccountID]*AccountData)
+	if err := m.accountsTree.Stream(func(accountID iotago.AccountID, accountData *AccountData) bool {
+		accounts[accountID] = accountData
+
+		return true
+	}); err != nil {
+		return errors.Wrap(err, "failed to stream accounts")
+	}
+
+	for index := m.latestSlot; index > targetIndex; index-- {
+		if err := m.rollbackDiff(accounts, index); err != nil {
+			return errors.Wrapf(err, "failed to roll back accounts to slot %d", index-1)
+		}
+	}
+
+	if err := stream.Write(writer, uint64(targetIndex)); err != nil {
+		return errors.Wrap(err, "failed to write accounts ledger index")
+	}
+
+	accountIDs := lo.Keys(accounts)
+	sort.Slice(accountIDs, func(i, j int) bool {
+		return bytes.Compare(accountIDs[i][:], accountIDs[j][:]) < 0
+	})
+
+	return stream.WriteCollection(writer, func() (elementsCount uint64, err error) {
+		for _, accountID := range accountIDs {
+			if err = stream.WriteSerializable(writer, accounts[accountID], AccountDataLength); err != nil {
+				return 0, errors.Wrapf(err, "failed to write account %s", accountID)
+			}
+
+			elementsCount++
+		}
+
+		return elementsCount, nil
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/iotaledger/hive.go/runtime/module"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/booker"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledger/accountsledger"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool/conflictdag"
@@ -14,12 +15,22 @@ import (
 
 type Ledger interface {
 	AttachTransaction(block *blocks.Block) (transactionMetadata mempool.TransactionMetadata, containsTransaction bool)
+	TransactionMetadataByAttachment(blockID iotago.BlockID) (transactionMetadata mempool.TransactionMetadata, exists bool)
 	Output(id iotago.IndexedUTXOReferencer) (*ledgerstate.Output, error)
-	CommitSlot(index iotago.SlotIndex) (stateRoot iotago.Identifier, mutationRoot iotago.Identifier, err error)
+	CommitSlot(index iotago.SlotIndex, issuedBlocks map[iotago.AccountID]int64) (stateRoot iotago.Identifier, mutationRoot iotago.Identifier, accountRoot iotago.Identifier, err error)
 	ConflictDAG() conflictdag.ConflictDAG[iotago.TransactionID, iotago.OutputID, booker.BlockVotePower]
+	MemPool() mempool.MemPool[booker.BlockVotePower]
 	IsOutputSpent(outputID iotago.OutputID) (bool, error)
+	TransactionCommitted(transactionID iotago.TransactionID) (committed bool, err error)
 	StateDiffs(index iotago.SlotIndex) (*ledgerstate.SlotDiff, error)
 	AddUnspentOutput(unspentOutput *ledgerstate.Output) error
+	ForEachUnspentOutput(consumer func(output *ledgerstate.Output) bool) error
+	StateTreeProof(outputID iotago.OutputID) (proof *ledgerstate.StateTreeProof, root iotago.Identifier, err error)
+	SimulateTransaction(transaction *iotago.Transaction) (simulation *TransactionSimulation, err error)
+	Account(accountID iotago.AccountID) (accountData *accountsledger.AccountData, exists bool, err error)
+	ForEachAccount(consumer func(accountData *accountsledger.AccountData) bool) error
+	Credits(accountID iotago.AccountID, index iotago.SlotIndex) (credits int64, exists bool, err error)
+	Mana(accountID iotago.AccountID, index iotago.SlotIndex) (mana uint64, exists bool, err error)
 	Import(reader io.ReadSeeker) error
 	Export(writer io.WriteSeeker, targetIndex iotago.SlotIndex) error
 
@@ -0,0 +1,35 @@
+package ledger
+
+import (
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// TransactionSimulation is the result of executing a transaction against the current ledger state without attaching it.
+type TransactionSimulation struct {
+	// TransactionID is the ID of the simulated transaction.
+	TransactionID iotago.TransactionID
+	// Inputs contains the state of the inputs of the transaction (in the order of the transaction).
+	Inputs []*SimulatedInput
+	// Outputs contains the outputs that the transaction would create if it was valid.
+	Outputs []mempool.State
+	// Error is the reason why the transaction is invalid (nil if the VM executed it successfully).
+	Error error
+}
+
+// SimulatedInput describes the state of an input of a simulated transaction.
+type SimulatedInput struct {
+	// OutputID is the ID of the consumed output.
+	OutputID iotago.OutputID
+	// Exists is false if the output is neither part of the ledger state nor created by a transaction in the MemPool.
+	Exists bool
+	// Spent is true if the output was already spent by a committed or an accepted transaction.
+	Spent bool
+	// ConflictingTransactionIDs contains the transactions in the conflict DAG that already spend the output.
+	ConflictingTransactionIDs []iotago.TransactionID
+}
+
+// IsContested returns true if other transactions in the conflict DAG already spend the output.
+func (s *SimulatedInput) IsContested() bool {
+	return len(s.ConflictingTransactionIDs) > 0
+}
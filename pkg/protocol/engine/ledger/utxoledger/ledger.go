@@ -3,6 +3,7 @@ package utxoledger
 import (
 	"fmt"
 	"io"
+	"sync"
 
 	"github.com/pkg/errors"
 	"golang.org/x/xerrors"
@@ -16,6 +17,7 @@ import (
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/booker"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledger"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledger/accountsledger"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool/conflictdag"
@@ -27,30 +29,37 @@ import (
 var ErrUnexpectedUnderlyingType = errors.New("unexpected underlying type provided by the interface")
 
 type Ledger struct {
-	ledgerState  *ledgerstate.Manager
-	memPool      mempool.MemPool[booker.BlockVotePower]
-	conflictDAG  conflictdag.ConflictDAG[iotago.TransactionID, iotago.OutputID, booker.BlockVotePower]
-	errorHandler func(error)
+	ledgerState    *ledgerstate.Manager
+	accountsLedger *accountsledger.Manager
+	memPool        mempool.MemPool[booker.BlockVotePower]
+	conflictDAG    conflictdag.ConflictDAG[iotago.TransactionID, iotago.OutputID, booker.BlockVotePower]
+	vm             mempool.VM
+	errorHandler   func(error)
+
+	// commitMutex prevents slots from being committed while a transaction is simulated.
+	commitMutex sync.RWMutex
 
 	module.Module
 }
 
 func NewProvider() module.Provider[*engine.Engine, ledger.Ledger] {
 	return module.Provide(func(e *engine.Engine) ledger.Ledger {
-		l := New(e.Workers.CreateGroup("Ledger"), e.Storage.Ledger(), executeStardustVM, e.API, e.SybilProtection.OnlineCommittee(), e.ErrorHandler("ledger"))
+		l := New(e.Workers.CreateGroup("Ledger"), e.Storage.Ledger(), e.Storage.Accounts(), executeStardustVM, e.API, e.Storage.Settings().EpochLength, e.SybilProtection.OnlineCommittee(), e.ErrorHandler("ledger"))
 
-		// TODO: should this attach to RatifiedAccepted instead?
 		e.Events.BlockGadget.BlockAccepted.Hook(l.BlockAccepted)
+		e.Events.Orphanage.BlockOrphaned.Hook(l.BlockOrphaned)
 
 		return l
 	})
 }
 
-func New(workers *workerpool.Group, store kvstore.KVStore, vm mempool.VM, apiProviderFunc func() iotago.API, committee *account.SelectedAccounts[iotago.AccountID, *iotago.AccountID], errorHandler func(error)) *Ledger {
+func New(workers *workerpool.Group, store kvstore.KVStore, accountsStore kvstore.KVStore, vm mempool.VM, apiProviderFunc func() iotago.API, epochLengthFunc func() iotago.SlotIndex, committee *account.SelectedAccounts[iotago.AccountID, *iotago.AccountID], errorHandler func(error)) *Ledger {
 	l := &Ledger{
-		ledgerState:  ledgerstate.New(store, apiProviderFunc),
-		conflictDAG:  conflictdagv1.New[iotago.TransactionID, iotago.OutputID, booker.BlockVotePower](committee),
-		errorHandler: errorHandler,
+		ledgerState:    ledgerstate.New(store, apiProviderFunc),
+		accountsLedger: accountsledger.New(accountsStore, epochLengthFunc),
+		conflictDAG:    conflictdagv1.New[iotago.TransactionID, iotago.OutputID, booker.BlockVotePower](committee),
+		vm:             vm,
+		errorHandler:   errorHandler,
 	}
 
 	l.memPool = mempoolv1.New(vm, l.resolveState, workers.CreateGroup("MemPool"), l.conflictDAG, mempoolv1.WithForkAllTransactions[booker.BlockVotePower](true))
@@ -62,17 +71,37 @@ func (l *Ledger) ConflictDAG() conflictdag.ConflictDAG[iotago.TransactionID, iot
 	return l.conflictDAG
 }
 
+func (l *Ledger) MemPool() mempool.MemPool[booker.BlockVotePower] {
+	return l.memPool
+}
+
 func (l *Ledger) Shutdown() {
 	l.TriggerStopped()
 	l.conflictDAG.Shutdown()
 }
 
 func (l *Ledger) Import(reader io.ReadSeeker) error {
-	return l.ledgerState.Import(reader)
+	if err := l.ledgerState.Import(reader); err != nil {
+		return errors.Wrap(err, "failed to import ledger state")
+	}
+
+	if err := l.accountsLedger.Import(reader); err != nil {
+		return errors.Wrap(err, "failed to import accounts ledger")
+	}
+
+	return nil
 }
 
 func (l *Ledger) Export(writer io.WriteSeeker, targetIndex iotago.SlotIndex) error {
-	return l.ledgerState.Export(writer, targetIndex)
+	if err := l.ledgerState.Export(writer, targetIndex); err != nil {
+		return errors.Wrap(err, "failed to export ledger state")
+	}
+
+	if err := l.accountsLedger.Export(writer, targetIndex); err != nil {
+		return errors.Wrap(err, "failed to export accounts ledger")
+	}
+
+	return nil
 }
 
 func (l *Ledger) resolveState(stateRef iotago.IndexedUTXOReferencer) *promise.Promise[mempool.State] {
@@ -117,10 +146,16 @@ func (l *Ledger) Output(stateRef iotago.IndexedUTXOReferencer) (*ledgerstate.Out
 	}
 }
 
-func (l *Ledger) CommitSlot(index iotago.SlotIndex) (stateRoot iotago.Identifier, mutationRoot iotago.Identifier, err error) {
+// CommitSlot applies the executed transactions of the given slot to the ledger state and burns the block issuance
+// credits of the ratified accepted blocks that its commitment contains (issuedBlocks maps the issuers to their number of
+// blocks).
+func (l *Ledger) CommitSlot(index iotago.SlotIndex, issuedBlocks map[iotago.AccountID]int64) (stateRoot iotago.Identifier, mutationRoot iotago.Identifier, accountRoot iotago.Identifier, err error) {
+	l.commitMutex.Lock()
+	defer l.commitMutex.Unlock()
+
 	ledgerIndex, err := l.ledgerState.ReadLedgerIndex()
 	if err != nil {
-		return iotago.Identifier{}, iotago.Identifier{}, err
+		return iotago.Identifier{}, iotago.Identifier{}, iotago.Identifier{}, err
 	}
 
 	if index != ledgerIndex+1 {
@@ -172,11 +207,15 @@ func (l *Ledger) CommitSlot(index iotago.SlotIndex) (stateRoot iotago.Identifier
 	})
 
 	if innerErr != nil {
-		return iotago.Identifier{}, iotago.Identifier{}, innerErr
+		return iotago.Identifier{}, iotago.Identifier{}, iotago.Identifier{}, innerErr
 	}
 
-	if err := l.ledgerState.ApplyDiff(index, outputs, spents); err != nil {
-		return iotago.Identifier{}, iotago.Identifier{}, err
+	if err = l.ledgerState.ApplyDiff(index, outputs, spents); err != nil {
+		return iotago.Identifier{}, iotago.Identifier{}, iotago.Identifier{}, err
+	}
+
+	if err = l.accountsLedger.ApplyDiff(index, outputs, spents, issuedBlocks); err != nil {
+		return iotago.Identifier{}, iotago.Identifier{}, iotago.Identifier{}, errors.Wrapf(err, "failed to apply accounts diff of slot %d", index)
 	}
 
 	// Mark the transactions as committed so the mempool can evict it.
@@ -185,19 +224,67 @@ func (l *Ledger) CommitSlot(index iotago.SlotIndex) (stateRoot iotago.Identifier
 		return true
 	})
 
-	return l.ledgerState.StateTreeRoot(), iotago.Identifier(stateDiff.Mutations().Root()), nil
+	return l.ledgerState.StateTreeRoot(), iotago.Identifier(stateDiff.Mutations().Root()), l.accountsLedger.AccountsRoot(), nil
 }
 
 func (l *Ledger) IsOutputSpent(outputID iotago.OutputID) (bool, error) {
 	return l.ledgerState.IsOutputIDUnspentWithoutLocking(outputID)
 }
 
+// TransactionCommitted returns whether the outputs of the given transaction are part of the committed ledger state (the
+// outputs are kept after they were spent).
+func (l *Ledger) TransactionCommitted(transactionID iotago.TransactionID) (committed bool, err error) {
+	if _, err = l.ledgerState.ReadOutputByOutputID(iotago.OutputIDFromTransactionIDAndIndex(transactionID, 0)); err != nil {
+		if errors.Is(err, kvstore.ErrKeyNotFound) {
+			return false, nil
+		}
+
+		return false, errors.Wrapf(err, "failed to read first output of transaction %s", transactionID)
+	}
+
+	return true, nil
+}
+
 func (l *Ledger) StateDiffs(index iotago.SlotIndex) (*ledgerstate.SlotDiff, error) {
 	return l.ledgerState.SlotDiffWithoutLocking(index)
 }
 
 func (l *Ledger) AddUnspentOutput(unspentOutput *ledgerstate.Output) error {
-	return l.ledgerState.AddUnspentOutput(unspentOutput)
+	if err := l.ledgerState.AddUnspentOutput(unspentOutput); err != nil {
+		return err
+	}
+
+	return l.accountsLedger.AddAccountOutput(unspentOutput)
+}
+
+// Account returns the state of the given account as of the latest committed slot.
+func (l *Ledger) Account(accountID iotago.AccountID) (accountData *accountsledger.AccountData, exists bool, err error) {
+	return l.accountsLedger.Account(accountID)
+}
+
+// ForEachAccount iterates over the states of all accounts as of the latest committed slot.
+func (l *Ledger) ForEachAccount(consumer func(accountData *accountsledger.AccountData) bool) error {
+	return l.accountsLedger.ForEachAccount(consumer)
+}
+
+// Credits returns the block issuance credits of the given account at the given slot.
+func (l *Ledger) Credits(accountID iotago.AccountID, index iotago.SlotIndex) (credits int64, exists bool, err error) {
+	return l.accountsLedger.Credits(accountID, index)
+}
+
+// Mana returns the Mana of the given account at the given slot.
+func (l *Ledger) Mana(accountID iotago.AccountID, index iotago.SlotIndex) (mana uint64, exists bool, err error) {
+	return l.accountsLedger.Mana(accountID, index)
+}
+
+// ForEachUnspentOutput iterates over all unspent outputs of the latest committed ledger state.
+func (l *Ledger) ForEachUnspentOutput(consumer func(output *ledgerstate.Output) bool) error {
+	return l.ledgerState.ForEachUnspentOutput(consumer)
+}
+
+// StateTreeProof returns a Merkle proof for the given unspent output against the current root of the state tree.
+func (l *Ledger) StateTreeProof(outputID iotago.OutputID) (proof *ledgerstate.StateTreeProof, root iotago.Identifier, err error) {
+	return l.ledgerState.StateTreeProof(outputID)
 }
 
 func (l *Ledger) AttachTransaction(block *blocks.Block) (transactionMetadata mempool.TransactionMetadata, containsTransaction bool) {
@@ -217,6 +304,19 @@ func (l *Ledger) AttachTransaction(block *blocks.Block) (transactionMetadata mem
 	}
 }
 
+// TransactionMetadataByAttachment returns the metadata of the transaction that is contained in the given block.
+func (l *Ledger) TransactionMetadataByAttachment(blockID iotago.BlockID) (transactionMetadata mempool.TransactionMetadata, exists bool) {
+	return l.memPool.TransactionMetadataByAttachment(blockID)
+}
+
+// BlockOrphaned marks the attachment of the given block as orphaned, so that its transaction can be evicted from the
+// MemPool once none of its attachments can be accepted anymore.
+func (l *Ledger) BlockOrphaned(block *blocks.Block) {
+	if _, isTransaction := block.Transaction(); isTransaction {
+		l.memPool.MarkAttachmentOrphaned(block.ID())
+	}
+}
+
 func (l *Ledger) BlockAccepted(block *blocks.Block) {
 	switch block.Block().Payload.(type) {
 	case *iotago.Transaction:
@@ -0,0 +1,121 @@
+package utxoledger
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledger"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// SimulateTransaction executes the given transaction against the current ledger state (including the outputs of the
+// pending transactions in the MemPool) without attaching it. No slot can be committed while the inputs are resolved and
+// checked and the transaction is executed, so that the simulation is not affected by a slot that is committed
+// concurrently.
+func (l *Ledger) SimulateTransaction(transaction *iotago.Transaction) (simulation *ledger.TransactionSimulation, err error) {
+	l.commitMutex.RLock()
+	defer l.commitMutex.RUnlock()
+
+	transactionID, err := transaction.ID()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compute transaction ID")
+	}
+
+	inputReferences, err := transaction.Inputs()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve inputs of transaction")
+	}
+
+	simulation = &ledger.TransactionSimulation{
+		TransactionID: transactionID,
+		Inputs:        make([]*ledger.SimulatedInput, 0, len(inputReferences)),
+	}
+
+	// The MemPool resolves unknown inputs from the ledger state (which read locks the ledger itself), so the inputs
+	// are requested before the ledger is locked to not acquire the read lock recursively. The commit mutex still
+	// prevents the ledger state from changing in between.
+	stateMetadata := make([]mempool.StateMetadata, len(inputReferences))
+	for i, inputReference := range inputReferences {
+		metadata, metadataErr := l.memPool.StateMetadata(inputReference)
+		if metadataErr != nil {
+			if !errors.Is(metadataErr, mempool.ErrStateNotFound) {
+				return nil, errors.Wrapf(metadataErr, "failed to resolve input %s", inputReference.Ref().ToHex())
+			}
+
+			continue
+		}
+
+		stateMetadata[i] = metadata
+	}
+
+	l.ledgerState.ReadLockLedger()
+	defer l.ledgerState.ReadUnlockLedger()
+
+	inputStates := make([]mempool.State, 0, len(inputReferences))
+	for i, inputReference := range inputReferences {
+		input, inputState, inputErr := l.simulatedInput(transactionID, inputReference, stateMetadata[i])
+		if inputErr != nil {
+			return nil, errors.Wrapf(inputErr, "failed to resolve input %s", inputReference.Ref().ToHex())
+		}
+
+		simulation.Inputs = append(simulation.Inputs, input)
+		if inputState != nil {
+			inputStates = append(inputStates, inputState)
+		} else if simulation.Error == nil {
+			simulation.Error = errors.Wrapf(mempool.ErrStateNotFound, "input %s", input.OutputID.ToHex())
+		}
+	}
+
+	if simulation.Error != nil {
+		return simulation, nil
+	}
+
+	if simulation.Outputs, simulation.Error = l.vm(context.Background(), transaction, inputStates); simulation.Error == nil {
+		sort.Slice(simulation.Outputs, func(i, j int) bool {
+			return simulation.Outputs[i].OutputID().Index() < simulation.Outputs[j].OutputID().Index()
+		})
+	}
+
+	return simulation, nil
+}
+
+// simulatedInput determines whether the given input (with the metadata that was resolved by the MemPool) was already
+// spent or is contested by other transactions. The ledger needs to be read locked.
+func (l *Ledger) simulatedInput(transactionID iotago.TransactionID, inputReference iotago.IndexedUTXOReferencer, stateMetadata mempool.StateMetadata) (input *ledger.SimulatedInput, inputState mempool.State, err error) {
+	input = &ledger.SimulatedInput{
+		OutputID: inputReference.Ref(),
+	}
+
+	if stateMetadata == nil {
+		return input, nil, nil
+	}
+
+	input.Exists = true
+
+	if _, spentByAcceptedTransaction := stateMetadata.AcceptedSpender(); spentByAcceptedTransaction {
+		input.Spent = true
+	} else if _, isLedgerOutput := stateMetadata.State().(*ledgerstate.Output); isLedgerOutput {
+		unspent, unspentErr := l.ledgerState.IsOutputIDUnspentWithoutLocking(input.OutputID)
+		if unspentErr != nil {
+			return nil, nil, errors.Wrap(unspentErr, "failed to check if output is unspent")
+		}
+
+		input.Spent = !unspent
+	}
+
+	if spenders, exists := l.conflictDAG.ConflictSetMembers(input.OutputID); exists {
+		_ = spenders.ForEach(func(spenderID iotago.TransactionID) error {
+			if spenderID != transactionID {
+				input.ConflictingTransactionIDs = append(input.ConflictingTransactionIDs, spenderID)
+			}
+
+			return nil
+		})
+	}
+
+	return input, stateMetadata.State(), nil
+}
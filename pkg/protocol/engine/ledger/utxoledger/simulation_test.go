@@ -0,0 +1,146 @@
+package utxoledger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/core/account"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate"
+	ledgerstatetpkg "github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate/tpkg"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func TestLedger_SimulateTransaction(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	defer workers.Shutdown()
+
+	l := New(workers, mapdb.NewMapDB(), mapdb.NewMapDB(), executeStardustVM, ledgerstatetpkg.API, func() iotago.SlotIndex { return 32 }, account.NewAccounts[iotago.AccountID](mapdb.NewMapDB()).SelectAccounts(), func(err error) {
+		t.Error(err)
+	})
+
+	_, address, addressKeys := tpkg.RandEd25519Identity()
+	_, _, otherAddressKeys := tpkg.RandEd25519Identity()
+
+	unspentOutput := newLedgerOutput(address, 1_000_000)
+	spentOutput := newLedgerOutput(address, 1_000_000)
+	contestedOutput := newLedgerOutput(address, 1_000_000)
+	require.NoError(t, l.ledgerState.ApplyDiff(1, ledgerstate.Outputs{unspentOutput, spentOutput, contestedOutput}, ledgerstate.Spents{}))
+	require.NoError(t, l.ledgerState.ApplyDiff(2, ledgerstate.Outputs{}, ledgerstate.Spents{
+		ledgerstatetpkg.RandLedgerStateSpentWithOutput(spentOutput, 2, time.Now()),
+	}))
+
+	t.Run("valid", func(t *testing.T) {
+		transaction := newTransaction(t, addressKeys, unspentOutput)
+
+		simulation, err := l.SimulateTransaction(transaction)
+		require.NoError(t, err)
+		require.NoError(t, simulation.Error)
+		require.Equal(t, lo.PanicOnErr(transaction.ID()), simulation.TransactionID)
+		require.Len(t, simulation.Outputs, 1)
+		require.Equal(t, iotago.OutputIDFromTransactionIDAndIndex(simulation.TransactionID, 0), simulation.Outputs[0].OutputID())
+
+		require.Len(t, simulation.Inputs, 1)
+		require.Equal(t, unspentOutput.OutputID(), simulation.Inputs[0].OutputID)
+		require.True(t, simulation.Inputs[0].Exists)
+		require.False(t, simulation.Inputs[0].Spent)
+		require.False(t, simulation.Inputs[0].IsContested())
+
+		// the simulation does not attach the transaction.
+		_, exists := l.memPool.TransactionMetadata(simulation.TransactionID)
+		require.False(t, exists)
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		simulation, err := l.SimulateTransaction(newTransaction(t, otherAddressKeys, unspentOutput))
+		require.NoError(t, err)
+		require.Error(t, simulation.Error)
+		require.Empty(t, simulation.Outputs)
+		require.True(t, simulation.Inputs[0].Exists)
+		require.False(t, simulation.Inputs[0].Spent)
+	})
+
+	t.Run("spent input", func(t *testing.T) {
+		simulation, err := l.SimulateTransaction(newTransaction(t, addressKeys, spentOutput))
+		require.NoError(t, err)
+		require.True(t, simulation.Inputs[0].Exists)
+		require.True(t, simulation.Inputs[0].Spent)
+	})
+
+	t.Run("missing input", func(t *testing.T) {
+		simulation, err := l.SimulateTransaction(newTransaction(t, addressKeys, newLedgerOutput(address, 1_000_000)))
+		require.NoError(t, err)
+		require.ErrorIs(t, simulation.Error, mempool.ErrStateNotFound)
+		require.Empty(t, simulation.Outputs)
+		require.False(t, simulation.Inputs[0].Exists)
+	})
+
+	t.Run("contested input", func(t *testing.T) {
+		conflictingTransaction := newTransaction(t, addressKeys, contestedOutput)
+		conflictingTransactionID := lo.PanicOnErr(conflictingTransaction.ID())
+
+		_, err := l.memPool.AttachTransaction(conflictingTransaction, iotago.NewSlotIdentifier(1, iotago.Identifier{1}))
+		require.NoError(t, err)
+		require.Eventually(t, func() bool {
+			_, exists := l.conflictDAG.ConflictSetMembers(contestedOutput.OutputID())
+			return exists
+		}, 5*time.Second, 10*time.Millisecond)
+
+		simulation, err := l.SimulateTransaction(newTransaction(t, addressKeys, contestedOutput))
+		require.NoError(t, err)
+		require.NoError(t, simulation.Error)
+		require.False(t, simulation.Inputs[0].Spent)
+		require.True(t, simulation.Inputs[0].IsContested())
+		require.Equal(t, []iotago.TransactionID{conflictingTransactionID}, simulation.Inputs[0].ConflictingTransactionIDs)
+
+		// a transaction does not conflict with itself.
+		simulation, err = l.SimulateTransaction(conflictingTransaction)
+		require.NoError(t, err)
+		require.False(t, simulation.Inputs[0].IsContested())
+	})
+}
+
+// newLedgerOutput creates an unspent basic output that can be unlocked by the given address.
+func newLedgerOutput(address iotago.Address, amount uint64) *ledgerstate.Output {
+	return ledgerstate.CreateOutput(ledgerstatetpkg.API(), tpkg.RandOutputID(0), tpkg.RandBlockID(), 1, time.Now(), &iotago.BasicOutput{
+		Amount: amount,
+		Conditions: iotago.BasicOutputUnlockConditions{
+			&iotago.AddressUnlockCondition{Address: address},
+		},
+	})
+}
+
+// newTransaction creates a transaction that sends the funds of the given input to a new address (a different output is
+// created for every call, so that the transactions spending the same input conflict with each other).
+func newTransaction(t *testing.T, addressKeys iotago.AddressKeys, input *ledgerstate.Output) *iotago.Transaction {
+	essence := &iotago.TransactionEssence{
+		NetworkID:    ledgerstatetpkg.ProtocolParams().NetworkID(),
+		CreationTime: time.Now(),
+		Inputs:       iotago.OutputIDs{input.OutputID()}.UTXOInputs(),
+		Outputs: iotago.TxEssenceOutputs{
+			&iotago.BasicOutput{
+				Amount: input.Deposit(),
+				Conditions: iotago.BasicOutputUnlockConditions{
+					&iotago.AddressUnlockCondition{Address: tpkg.RandEd25519Address()},
+				},
+			},
+		},
+	}
+
+	inputs := iotago.OutputSet{input.OutputID(): input.Output()}
+	signatures, err := essence.Sign(iotago.OutputIDs{input.OutputID()}.OrderedSet(inputs).MustCommitment(), addressKeys)
+	require.NoError(t, err)
+
+	return &iotago.Transaction{
+		Essence: essence,
+		Unlocks: iotago.Unlocks{
+			&iotago.SignatureUnlock{Signature: signatures[0]},
+		},
+	}
+}
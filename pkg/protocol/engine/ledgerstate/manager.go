@@ -279,6 +279,40 @@ func (m *Manager) RollbackDiff(index iotago.SlotIndex, newOutputs Outputs, newSp
 	return m.RollbackDiffWithoutLocking(index, newOutputs, newSpents)
 }
 
+// RollbackToSlot reverts the ledger state to the given slot by rolling back the stored diffs of all later slots.
+func (m *Manager) RollbackToSlot(targetIndex iotago.SlotIndex) error {
+	m.WriteLockLedger()
+	defer m.WriteUnlockLedger()
+
+	ledgerIndex, err := m.ReadLedgerIndexWithoutLocking()
+	if err != nil {
+		return errors.Wrap(err, "failed to read ledger index")
+	}
+
+	if targetIndex > ledgerIndex {
+		return errors.Errorf("cannot roll back ledger to slot %d, ledger index is %d", targetIndex, ledgerIndex)
+	}
+
+	// load all diffs upfront so that the ledger is not modified if a diff is missing (e.g. because it was pruned).
+	slotDiffs := make([]*SlotDiff, 0, ledgerIndex-targetIndex)
+	for index := ledgerIndex; index > targetIndex; index-- {
+		slotDiff, err := m.SlotDiffWithoutLocking(index)
+		if err != nil {
+			return errors.Wrapf(err, "failed to load diff of slot %d", index)
+		}
+
+		slotDiffs = append(slotDiffs, slotDiff)
+	}
+
+	for _, slotDiff := range slotDiffs {
+		if err = m.RollbackDiffWithoutLocking(slotDiff.Index, slotDiff.Outputs, slotDiff.Spents); err != nil {
+			return errors.Wrapf(err, "failed to roll back diff of slot %d", slotDiff.Index)
+		}
+	}
+
+	return nil
+}
+
 func (m *Manager) CheckLedgerState(tokenSupply uint64) error {
 	total, _, err := m.ComputeLedgerBalance()
 	if err != nil {
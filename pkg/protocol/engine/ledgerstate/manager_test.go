@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate/tpkg"
 	iotago "github.com/iotaledger/iota.go/v4"
@@ -236,3 +237,49 @@ func TestConfirmationApplyAndRollbackToPreviousLedger(t *testing.T) {
 	}))
 	require.Empty(t, spentByOutputID)
 }
+
+func TestRollbackToSlot(t *testing.T) {
+	manager := ledgerstate.New(mapdb.NewMapDB(), tpkg.API)
+
+	genesisOutputs := ledgerstate.Outputs{
+		tpkg.RandLedgerStateOutputWithType(iotago.OutputBasic),
+		tpkg.RandLedgerStateOutputWithType(iotago.OutputBasic),
+	}
+	require.NoError(t, manager.ApplyDiffWithoutLocking(1, genesisOutputs, ledgerstate.Spents{}))
+
+	targetStateRoot := manager.StateTreeRoot()
+
+	slot2Outputs := ledgerstate.Outputs{
+		tpkg.RandLedgerStateOutputWithType(iotago.OutputNFT),
+	}
+	slot2Spents := ledgerstate.Spents{
+		tpkg.RandLedgerStateSpentWithOutput(genesisOutputs[0], 2, tpkg.RandTimestamp()),
+	}
+	require.NoError(t, manager.ApplyDiffWithoutLocking(2, slot2Outputs, slot2Spents))
+
+	slot3Outputs := ledgerstate.Outputs{
+		tpkg.RandLedgerStateOutputWithType(iotago.OutputAlias),
+	}
+	slot3Spents := ledgerstate.Spents{
+		tpkg.RandLedgerStateSpentWithOutput(slot2Outputs[0], 3, tpkg.RandTimestamp()),
+	}
+	require.NoError(t, manager.ApplyDiffWithoutLocking(3, slot3Outputs, slot3Spents))
+	require.NotEqual(t, targetStateRoot, manager.StateTreeRoot())
+
+	require.Error(t, manager.RollbackToSlot(4))
+
+	require.NoError(t, manager.RollbackToSlot(1))
+	require.True(t, manager.CheckStateTree())
+	require.Equal(t, targetStateRoot, manager.StateTreeRoot())
+
+	ledgerIndex, err := manager.ReadLedgerIndex()
+	require.NoError(t, err)
+	require.Equal(t, iotago.SlotIndex(1), ledgerIndex)
+
+	unspentOutputs, err := manager.UnspentOutputs()
+	require.NoError(t, err)
+	require.ElementsMatch(t, lo.Map(genesisOutputs, (*ledgerstate.Output).OutputID), lo.Map(unspentOutputs, (*ledgerstate.Output).OutputID))
+
+	_, err = manager.SlotDiff(2)
+	require.Error(t, err)
+}
@@ -87,6 +87,39 @@ func (o *Output) Deposit() uint64 {
 	return o.Output().Deposit()
 }
 
+// UnlockAddresses returns the addresses that are able to unlock the output (without duplicates).
+func (o *Output) UnlockAddresses() []iotago.Address {
+	unlockConditions := o.Output().UnlockConditionSet()
+
+	candidates := make([]iotago.Address, 0)
+	if addressUnlockCondition := unlockConditions.Address(); addressUnlockCondition != nil {
+		candidates = append(candidates, addressUnlockCondition.Address)
+	}
+	if stateControllerUnlockCondition := unlockConditions.StateControllerAddress(); stateControllerUnlockCondition != nil {
+		candidates = append(candidates, stateControllerUnlockCondition.Address)
+	}
+	if governorUnlockCondition := unlockConditions.GovernorAddress(); governorUnlockCondition != nil {
+		candidates = append(candidates, governorUnlockCondition.Address)
+	}
+	if immutableAliasUnlockCondition := unlockConditions.ImmutableAlias(); immutableAliasUnlockCondition != nil {
+		candidates = append(candidates, immutableAliasUnlockCondition.Address)
+	}
+	if expirationUnlockCondition := unlockConditions.Expiration(); expirationUnlockCondition != nil {
+		candidates = append(candidates, expirationUnlockCondition.ReturnAddress)
+	}
+
+	seenAddresses := make(map[string]struct{})
+	addresses := make([]iotago.Address, 0, len(candidates))
+	for _, address := range candidates {
+		if _, seen := seenAddresses[address.Key()]; !seen {
+			seenAddresses[address.Key()] = struct{}{}
+			addresses = append(addresses, address)
+		}
+	}
+
+	return addresses
+}
+
 type Outputs []*Output
 
 func (o Outputs) ToOutputSet() iotago.OutputSet {
@@ -4,19 +4,25 @@ import (
 	"bytes"
 	"time"
 
+	"golang.org/x/crypto/blake2b"
+
 	"github.com/iotaledger/hive.go/ads"
 	"github.com/iotaledger/hive.go/kvstore/mapdb"
 	"github.com/iotaledger/hive.go/serializer/v2/marshalutil"
 	iotago "github.com/iotaledger/iota.go/v4"
 )
 
+// stateTreeMetadata is the value of the leaf of an output in the state tree. It commits to the content of the output
+// through the hash of its serialized form, so that the output can be proven against the state root.
 type stateTreeMetadata struct {
-	Time time.Time
+	Time       time.Time
+	OutputHash iotago.Identifier
 }
 
 func newStateMetadata(output *Output) *stateTreeMetadata {
 	return &stateTreeMetadata{
-		Time: output.TimestampCreated(),
+		Time:       output.TimestampCreated(),
+		OutputHash: blake2b.Sum256(output.Bytes()),
 	}
 }
 
@@ -27,14 +33,21 @@ func (s *stateTreeMetadata) FromBytes(b []byte) (int, error) {
 		return 0, err
 	}
 
+	outputHash, err := ms.ReadBytes(iotago.IdentifierLength)
+	if err != nil {
+		return 0, err
+	}
+
 	s.Time = time.Unix(0, ts)
+	copy(s.OutputHash[:], outputHash)
 
-	return 8, nil
+	return ms.ReadOffset(), nil
 }
 
 func (s stateTreeMetadata) Bytes() ([]byte, error) {
-	ms := marshalutil.New(8)
+	ms := marshalutil.New(8 + iotago.IdentifierLength)
 	ms.WriteInt64(s.Time.UnixNano())
+	ms.WriteBytes(s.OutputHash[:])
 
 	return ms.Bytes(), nil
 }
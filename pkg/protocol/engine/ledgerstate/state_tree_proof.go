@@ -0,0 +1,80 @@
+package ledgerstate
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/iota-core/pkg/core/adsproof"
+	"github.com/iotaledger/iota-core/pkg/model"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// ErrOutputNotInStateTree is returned if a proof is requested for an output that is not unspent.
+var ErrOutputNotInStateTree = errors.New("output is not part of the state tree")
+
+// StateTreeProof is a Merkle proof that an unspent output is part of the state tree whose root is committed to in the
+// StateRoot of a commitment. The leaf of an output commits to its creation time and to the hash of the serialized
+// output, so the proof also proves the content of the output.
+type StateTreeProof struct {
+	// OutputID is the ID of the proven output.
+	OutputID iotago.OutputID
+	// Output is the serialized form of the proven output.
+	Output []byte
+	// TimestampCreated is the creation time of the output in nanoseconds.
+	TimestampCreated int64
+	// SideNodes are the non-empty sibling nodes on the path from the leaf to the root.
+	SideNodes []iotago.Identifier
+}
+
+// StateTreeProof returns a Merkle proof for the given unspent output against the current root of the state tree.
+func (m *Manager) StateTreeProof(outputID iotago.OutputID) (proof *StateTreeProof, root iotago.Identifier, err error) {
+	m.ReadLockLedger()
+	defer m.ReadUnlockLedger()
+
+	if _, exists := m.stateTree.Get(outputID); !exists {
+		return nil, iotago.Identifier{}, errors.Wrapf(ErrOutputNotInStateTree, "output %s", outputID.ToHex())
+	}
+
+	output, err := m.ReadOutputByOutputIDWithoutLocking(outputID)
+	if err != nil {
+		return nil, iotago.Identifier{}, errors.Wrapf(err, "failed to read output %s", outputID.ToHex())
+	}
+
+	root = m.StateTreeRoot()
+	sideNodes, err := adsproof.Prove(lo.PanicOnErr(m.store.WithExtendedRealm(kvstore.Realm{StoreKeyPrefixStateTree})), root, outputID[:])
+	if err != nil {
+		return nil, iotago.Identifier{}, errors.Wrapf(err, "failed to create proof for output %s", outputID.ToHex())
+	}
+
+	proof = &StateTreeProof{
+		OutputID:         outputID,
+		Output:           output.Bytes(),
+		TimestampCreated: output.TimestampCreated().UnixNano(),
+		SideNodes:        sideNodes,
+	}
+
+	return proof, root, nil
+}
+
+// Verify checks that the proven output is part of the state of the given commitment. The roots are the preimage of
+// the RootsID of the commitment.
+func (p *StateTreeProof) Verify(commitment *iotago.Commitment, roots *iotago.Roots) error {
+	if rootsID := model.RootsID(roots); rootsID != commitment.RootsID {
+		return errors.Errorf("roots %s do not match the roots %s of the commitment", rootsID, commitment.RootsID)
+	}
+
+	value, err := (&stateTreeMetadata{Time: time.Unix(0, p.TimestampCreated), OutputHash: blake2b.Sum256(p.Output)}).Bytes()
+	if err != nil {
+		return errors.Wrap(err, "failed to serialize leaf value")
+	}
+
+	if !adsproof.Verify(p.SideNodes, roots.StateRoot, p.OutputID[:], value) {
+		return errors.Errorf("output %s is not part of the state root %s", p.OutputID.ToHex(), roots.StateRoot)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package ledgerstate_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate/tpkg"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestStateTreeProof(t *testing.T) {
+	manager := ledgerstate.New(mapdb.NewMapDB(), tpkg.API)
+
+	outputs := ledgerstate.Outputs{
+		tpkg.RandLedgerStateOutputWithType(iotago.OutputBasic),
+		tpkg.RandLedgerStateOutputWithType(iotago.OutputNFT),
+		tpkg.RandLedgerStateOutputWithType(iotago.OutputAlias),
+	}
+	spents := ledgerstate.Spents{
+		tpkg.RandLedgerStateSpentWithOutput(outputs[2], 1, tpkg.RandTimestamp()),
+	}
+	require.NoError(t, manager.ApplyDiff(1, outputs, spents))
+
+	roots := iotago.NewRoots(iotago.Identifier{}, iotago.Identifier{}, iotago.Identifier{}, manager.StateTreeRoot(), iotago.Identifier{})
+	commitment := iotago.NewCommitment(1, iotago.CommitmentID{}, model.RootsID(roots), 0)
+
+	for _, output := range outputs[:2] {
+		proof, root, err := manager.StateTreeProof(output.OutputID())
+		require.NoError(t, err)
+		require.Equal(t, roots.StateRoot, root)
+		require.NoError(t, proof.Verify(commitment, roots))
+		require.Equal(t, output.Bytes(), proof.Output)
+
+		// the proof does not hold for a different output content.
+		tamperedProof := *proof
+		tamperedProof.Output = outputs[2].Bytes()
+		require.Error(t, tamperedProof.Verify(commitment, roots))
+
+		// the proof does not hold for a different creation time.
+		tamperedProof = *proof
+		tamperedProof.TimestampCreated++
+		require.Error(t, tamperedProof.Verify(commitment, roots))
+
+		// the proof does not hold for a different output.
+		tamperedProof = *proof
+		tamperedProof.OutputID = tpkg.RandOutputID(0)
+		require.Error(t, tamperedProof.Verify(commitment, roots))
+
+		// the roots must be the preimage of the commitment.
+		otherRoots := iotago.NewRoots(iotago.Identifier{1}, iotago.Identifier{}, iotago.Identifier{}, manager.StateTreeRoot(), iotago.Identifier{})
+		require.Error(t, proof.Verify(commitment, otherRoots))
+	}
+
+	_, _, err := manager.StateTreeProof(outputs[2].OutputID())
+	require.ErrorIs(t, err, ledgerstate.ErrOutputNotInStateTree)
+}
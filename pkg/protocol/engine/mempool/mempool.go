@@ -21,6 +21,10 @@ type MemPool[VotePower conflictdag.VotePowerType[VotePower]] interface {
 
 	TransactionMetadataByAttachment(blockID iotago.BlockID) (transaction TransactionMetadata, exists bool)
 
+	ForEachTransaction(consumer func(transaction TransactionMetadata) bool)
+
+	PendingStateRequests() []iotago.OutputID
+
 	StateDiff(index iotago.SlotIndex) StateDiff
 
 	Evict(slotIndex iotago.SlotIndex)
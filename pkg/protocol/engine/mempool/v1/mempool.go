@@ -132,6 +132,27 @@ func (m *MemPool[VotePower]) TransactionMetadataByAttachment(blockID iotago.Bloc
 	return m.transactionByAttachment(blockID)
 }
 
+// ForEachTransaction iterates over all transactions that are currently in the MemPool.
+func (m *MemPool[VotePower]) ForEachTransaction(consumer func(transaction mempool.TransactionMetadata) bool) {
+	m.cachedTransactions.ForEach(func(_ iotago.TransactionID, transaction *TransactionMetadata) bool {
+		return consumer(transaction)
+	})
+}
+
+// PendingStateRequests returns the IDs of the states that were requested but are not available yet.
+func (m *MemPool[VotePower]) PendingStateRequests() []iotago.OutputID {
+	pendingStateRequests := make([]iotago.OutputID, 0)
+	m.cachedStateRequests.ForEach(func(stateID iotago.OutputID, stateRequest *promise.Promise[*StateMetadata]) bool {
+		if !stateRequest.WasCompleted() {
+			pendingStateRequests = append(pendingStateRequests, stateID)
+		}
+
+		return true
+	})
+
+	return pendingStateRequests
+}
+
 // StateDiff returns the state diff for the given slot index.
 func (m *MemPool[VotePower]) StateDiff(index iotago.SlotIndex) mempool.StateDiff {
 	if stateDiff, exists := m.stateDiffs.Get(index); exists {
@@ -0,0 +1,51 @@
+package notarization
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/iota-core/pkg/core/adsproof"
+	"github.com/iotaledger/iota-core/pkg/model"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// ErrBlockNotIncluded is returned if an inclusion proof is requested for a block that is not part of the ratified
+// accepted blocks of a commitment.
+var ErrBlockNotIncluded = errors.New("block is not included in a commitment")
+
+// blockInclusionLeafValue is the value that an ads.Set stores for each of its elements.
+var blockInclusionLeafValue = []byte{1}
+
+// BlockInclusionProof is a Merkle proof that a block is part of the ratified accepted blocks whose root is committed
+// to in the TangleRoot of the commitment of the slot of the block.
+type BlockInclusionProof struct {
+	// BlockID is the ID of the proven block.
+	BlockID iotago.BlockID
+	// SideNodes are the non-empty sibling nodes on the path from the leaf to the root.
+	SideNodes []iotago.Identifier
+}
+
+// NewBlockInclusionProof creates a new BlockInclusionProof.
+func NewBlockInclusionProof(blockID iotago.BlockID, sideNodes []iotago.Identifier) *BlockInclusionProof {
+	return &BlockInclusionProof{
+		BlockID:   blockID,
+		SideNodes: sideNodes,
+	}
+}
+
+// Verify checks that the proven block is included in the given commitment. The roots are the preimage of the RootsID
+// of the commitment.
+func (p *BlockInclusionProof) Verify(commitment *iotago.Commitment, roots *iotago.Roots) error {
+	if p.BlockID.Index() != commitment.Index {
+		return errors.Errorf("block %s does not belong to the slot %d of the commitment", p.BlockID, commitment.Index)
+	}
+
+	if rootsID := model.RootsID(roots); rootsID != commitment.RootsID {
+		return errors.Errorf("roots %s do not match the roots %s of the commitment", rootsID, commitment.RootsID)
+	}
+
+	if !adsproof.Verify(p.SideNodes, roots.TangleRoot, p.BlockID[:], blockInclusionLeafValue) {
+		return errors.Errorf("block %s is not part of the tangle root %s", p.BlockID, roots.TangleRoot)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package notarization_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/ads"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/iota-core/pkg/core/adsproof"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/notarization"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func TestBlockInclusionProof(t *testing.T) {
+	store := mapdb.NewMapDB()
+	ratifiedAcceptedBlocks := ads.NewSet[iotago.BlockID](store)
+
+	blockIDs := make([]iotago.BlockID, 5)
+	for i := range blockIDs {
+		blockIDs[i] = iotago.NewSlotIdentifier(5, tpkg.Rand32ByteArray())
+		ratifiedAcceptedBlocks.Add(blockIDs[i])
+	}
+
+	roots := iotago.NewRoots(iotago.Identifier(ratifiedAcceptedBlocks.Root()), iotago.Identifier{}, iotago.Identifier{}, iotago.Identifier{}, iotago.Identifier{})
+	commitment := iotago.NewCommitment(5, iotago.CommitmentID{}, model.RootsID(roots), 0)
+
+	for _, blockID := range blockIDs {
+		sideNodes, err := adsproof.Prove(store, roots.TangleRoot, blockID[:])
+		require.NoError(t, err)
+
+		proof := notarization.NewBlockInclusionProof(blockID, sideNodes)
+		require.NoError(t, proof.Verify(commitment, roots))
+
+		// the proof does not hold for a different block of the same slot.
+		require.Error(t, notarization.NewBlockInclusionProof(iotago.NewSlotIdentifier(5, tpkg.Rand32ByteArray()), sideNodes).Verify(commitment, roots))
+
+		// the roots must be the preimage of the commitment.
+		otherRoots := iotago.NewRoots(roots.TangleRoot, iotago.Identifier{1}, iotago.Identifier{}, iotago.Identifier{}, iotago.Identifier{})
+		require.Error(t, proof.Verify(commitment, otherRoots))
+
+		// the block must belong to the slot of the commitment.
+		otherCommitment := iotago.NewCommitment(6, iotago.CommitmentID{}, model.RootsID(roots), 0)
+		require.Error(t, proof.Verify(otherCommitment, roots))
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/iotaledger/hive.go/ads"
 	"github.com/iotaledger/hive.go/runtime/module"
+	"github.com/iotaledger/iota-core/pkg/model"
 	iotago "github.com/iotaledger/iota.go/v4"
 )
 
@@ -18,6 +19,17 @@ type Notarization interface {
 
 	Export(writer io.WriteSeeker, targetSlot iotago.SlotIndex) (err error)
 
+	// ForceCommit commits the next slot regardless of the acceptance time (e.g. an empty slot that was verified by warp
+	// sync) and returns the resulting commitment.
+	ForceCommit(index iotago.SlotIndex) (commitment *model.Commitment, err error)
+
+	// IsBlockIncluded returns whether the given block is part of the ratified accepted blocks that the commitment of its
+	// slot commits to (blocks of slots that are not committed yet are not included).
+	IsBlockIncluded(blockID iotago.BlockID) (included bool, err error)
+
+	// BlockInclusionProof returns a Merkle proof that the given block is included in the commitment of its slot.
+	BlockInclusionProof(blockID iotago.BlockID) (proof *BlockInclusionProof, err error)
+
 	module.Interface
 }
 
@@ -147,7 +147,56 @@ func (a *Attestations) Get(index iotago.SlotIndex) (attestations *ads.Map[iotago
 	return a.attestations(index)
 }
 
+// Import imports the committed attestations of the slots of the attestation window that were written by Export.
 func (a *Attestations) Import(reader io.ReadSeeker) (err error) {
+	targetSlot, err := stream.Read[uint64](reader)
+	if err != nil {
+		return errors.Wrap(err, "failed to read target slot")
+	}
+
+	if err = stream.ReadCollection(reader, func(i int) error {
+		return a.importSlot(reader)
+	}); err != nil {
+		return errors.Wrapf(err, "failed to import attestations of the slots until %d", targetSlot)
+	}
+
+	a.SetLastCommittedSlot(iotago.SlotIndex(targetSlot))
+
+	a.TriggerInitialized()
+
+	return
+}
+
+// Export exports the committed attestations of the slots of the attestation window that ends at the target slot.
+// Slots whose storage has already been pruned are skipped.
+func (a *Attestations) Export(writer io.WriteSeeker, targetSlot iotago.SlotIndex, window iotago.SlotIndex) (err error) {
+	if err = stream.Write(writer, uint64(targetSlot)); err != nil {
+		return errors.Wrap(err, "failed to write target slot")
+	}
+
+	var startSlot iotago.SlotIndex
+	if targetSlot >= window {
+		startSlot = targetSlot - window + 1
+	}
+
+	return stream.WriteCollection(writer, func() (elementsCount uint64, writeErr error) {
+		for slot := startSlot; slot <= targetSlot; slot++ {
+			if a.bucketedStorage(slot) == nil {
+				continue
+			}
+
+			if writeErr = a.exportSlot(writer, slot); writeErr != nil {
+				return 0, errors.Wrapf(writeErr, "failed to export attestations for slot %d", slot)
+			}
+
+			elementsCount++
+		}
+
+		return elementsCount, nil
+	})
+}
+
+func (a *Attestations) importSlot(reader io.ReadSeeker) (err error) {
 	slotIndex, err := stream.Read[uint64](reader)
 	if err != nil {
 		return errors.Wrap(err, "failed to read slot")
@@ -155,7 +204,7 @@ func (a *Attestations) Import(reader io.ReadSeeker) (err error) {
 
 	weight, err := stream.Read[int64](reader)
 	if err != nil {
-		return errors.Wrap(err, "failed to read weight for slot")
+		return errors.Wrapf(err, "failed to read weight for slot %d", slotIndex)
 	}
 
 	attestations, err := a.attestations(iotago.SlotIndex(slotIndex))
@@ -163,8 +212,8 @@ func (a *Attestations) Import(reader io.ReadSeeker) (err error) {
 		return errors.Wrapf(err, "failed to import attestations for slot %d", slotIndex)
 	}
 
-	importedAttestation := new(iotago.Attestation)
 	if err = stream.ReadCollection(reader, func(i int) (err error) {
+		importedAttestation := new(iotago.Attestation)
 		if err = stream.ReadSerializable(reader, importedAttestation); err != nil {
 			return errors.Wrapf(err, "failed to read attestation %d", i)
 		}
@@ -180,28 +229,29 @@ func (a *Attestations) Import(reader io.ReadSeeker) (err error) {
 		return errors.Wrapf(err, "failed to set attestations weight of slot %d", slotIndex)
 	}
 
-	a.SetLastCommittedSlot(iotago.SlotIndex(slotIndex))
-
-	a.TriggerInitialized()
+	if err = a.flush(iotago.SlotIndex(slotIndex)); err != nil {
+		return errors.Wrapf(err, "failed to flush attestations for slot %d", slotIndex)
+	}
 
 	return
 }
 
-func (a *Attestations) Export(writer io.WriteSeeker, targetSlot iotago.SlotIndex) (err error) {
-	if err = stream.Write(writer, uint64(targetSlot)); err != nil {
+func (a *Attestations) exportSlot(writer io.WriteSeeker, slot iotago.SlotIndex) (err error) {
+	if err = stream.Write(writer, uint64(slot)); err != nil {
 		return errors.Wrap(err, "failed to write slot")
 	}
 
-	if weight, err := a.weight(targetSlot); targetSlot != 0 && err != nil {
+	weight, err := a.weight(slot)
+	if err != nil {
 		return errors.Wrap(err, "failed to obtain weight for slot")
 	} else if err = stream.Write(writer, weight); err != nil {
 		return errors.Wrap(err, "failed to write slot weight")
 	}
 
 	return stream.WriteCollection(writer, func() (elementsCount uint64, writeErr error) {
-		attestations, writeErr := a.attestations(targetSlot)
+		attestations, writeErr := a.attestations(slot)
 		if writeErr != nil {
-			return 0, errors.Wrapf(writeErr, "failed to export attestations for slot %d", targetSlot)
+			return 0, errors.Wrapf(writeErr, "failed to export attestations for slot %d", slot)
 		}
 
 		if streamErr := attestations.Stream(func(issuerID iotago.AccountID, attestation *iotago.Attestation) bool {
@@ -213,7 +263,7 @@ func (a *Attestations) Export(writer io.WriteSeeker, targetSlot iotago.SlotIndex
 
 			return writeErr == nil
 		}); streamErr != nil {
-			return 0, errors.Wrapf(streamErr, "failed to stream attestations of slot %d", targetSlot)
+			return 0, errors.Wrapf(streamErr, "failed to stream attestations of slot %d", slot)
 		}
 
 		return
@@ -6,14 +6,19 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"golang.org/x/crypto/blake2b"
 
 	"github.com/iotaledger/hive.go/ads"
 	"github.com/iotaledger/hive.go/core/account"
+	"github.com/iotaledger/hive.go/kvstore"
 	"github.com/iotaledger/hive.go/runtime/event"
 	"github.com/iotaledger/hive.go/runtime/module"
 	"github.com/iotaledger/hive.go/runtime/options"
 	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/hive.go/serializer/v2/byteutils"
 	"github.com/iotaledger/hive.go/serializer/v2/serix"
+	"github.com/iotaledger/hive.go/serializer/v2/stream"
+	"github.com/iotaledger/iota-core/pkg/core/adsproof"
 	"github.com/iotaledger/iota-core/pkg/model"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
@@ -25,6 +30,7 @@ import (
 
 const (
 	defaultMinSlotCommittableAge = 6
+	defaultAttestationWindow     = 6
 )
 
 // Manager is the component that manages the slot commitments.
@@ -44,6 +50,7 @@ type Manager struct {
 	acceptedTimeFunc          func() time.Time
 	slotTimeProviderFunc      func() *iotago.SlotTimeProvider
 	optsMinCommittableSlotAge iotago.SlotIndex
+	optsAttestationWindow     iotago.SlotIndex
 
 	module.Module
 }
@@ -55,6 +62,7 @@ func NewProvider(opts ...options.Option[Manager]) module.Provider[*engine.Engine
 			workers:                   e.Workers.CreateGroup("NotarizationManager"),
 			errorHandler:              e.ErrorHandler("notarization"),
 			optsMinCommittableSlotAge: defaultMinSlotCommittableAge,
+			optsAttestationWindow:     defaultAttestationWindow,
 		}, opts,
 			func(m *Manager) {
 				m.slotTimeProviderFunc = func() *iotago.SlotTimeProvider {
@@ -140,6 +148,9 @@ func (m *Manager) notarizeRatifiedAcceptedBlock(block *blocks.Block) (err error)
 	return
 }
 
+// Import imports the committed attestations of the attestation window, so that the next slots are committed in the
+// same way as by the node that created the snapshot, and the roots of the latest commitment. The weights that the slot
+// mutations are derived from are owned (and imported) by the SybilProtection.
 func (m *Manager) Import(reader io.ReadSeeker) (err error) {
 	m.commitmentMutex.Lock()
 	defer m.commitmentMutex.Unlock()
@@ -148,22 +159,98 @@ func (m *Manager) Import(reader io.ReadSeeker) (err error) {
 		return errors.Wrap(err, "failed to import attestations")
 	}
 
+	if err = m.importRoots(reader); err != nil {
+		return errors.Wrap(err, "failed to import roots")
+	}
+
 	m.TriggerInitialized()
 
 	return
 }
 
+// Export exports the committed attestations of the attestation window that ends at the target slot and the roots of
+// the commitment of the target slot.
 func (m *Manager) Export(writer io.WriteSeeker, targetSlot iotago.SlotIndex) (err error) {
 	m.commitmentMutex.RLock()
 	defer m.commitmentMutex.RUnlock()
 
-	if err = m.attestations.Export(writer, targetSlot); err != nil {
+	if err = m.attestations.Export(writer, targetSlot, m.optsAttestationWindow); err != nil {
 		return errors.Wrap(err, "failed to export attestations")
 	}
 
+	if err = m.exportRoots(writer, targetSlot); err != nil {
+		return errors.Wrap(err, "failed to export roots")
+	}
+
 	return
 }
 
+// importRoots imports the roots of the latest commitment (if the snapshot contains them) and checks that the latest
+// commitment commits to them.
+func (m *Manager) importRoots(reader io.ReadSeeker) (err error) {
+	rootsBytes, err := stream.ReadBlob(reader)
+	if err != nil {
+		return errors.Wrap(err, "failed to read roots")
+	} else if len(rootsBytes) == 0 {
+		return nil
+	}
+
+	latestCommitment := m.storage.Settings().LatestCommitment()
+
+	roots := new(iotago.Roots)
+	if _, err = m.storage.Settings().API(latestCommitment.Index()).Decode(rootsBytes, roots); err != nil {
+		return errors.Wrap(err, "failed to decode roots")
+	}
+
+	if rootsID := model.RootsID(roots); rootsID != latestCommitment.RootsID() {
+		return errors.Errorf("roots %s do not match the roots %s of the latest commitment %s", rootsID, latestCommitment.RootsID(), latestCommitment.ID())
+	}
+
+	rootsStorage := m.storage.Roots(latestCommitment.Index())
+	if rootsStorage == nil {
+		return errors.Errorf("failed to access roots storage for slot %d", latestCommitment.Index())
+	}
+
+	return rootsStorage.Store(roots)
+}
+
+// exportRoots exports the roots of the commitment of the given slot, so that the state of a snapshot can be verified
+// against its commitment. The roots are left empty if they are not available (e.g. for the genesis slot).
+func (m *Manager) exportRoots(writer io.WriteSeeker, targetSlot iotago.SlotIndex) (err error) {
+	var rootsBytes []byte
+	if rootsStorage := m.storage.Roots(targetSlot); rootsStorage != nil {
+		roots, loadErr := rootsStorage.Load()
+		if loadErr != nil && !errors.Is(loadErr, kvstore.ErrKeyNotFound) {
+			return errors.Wrapf(loadErr, "failed to load roots of slot %d", targetSlot)
+		}
+
+		if roots != nil {
+			if rootsBytes, err = m.storage.Settings().API(targetSlot).Encode(roots); err != nil {
+				return errors.Wrapf(err, "failed to encode roots of slot %d", targetSlot)
+			}
+		}
+	}
+
+	return stream.WriteBlob(writer, rootsBytes)
+}
+
+// ForceCommit commits the given slot regardless of the acceptance time, which allows to catch up with a chain that
+// contains slots without (accepted) blocks. The given slot has to be the next slot that is committed.
+func (m *Manager) ForceCommit(index iotago.SlotIndex) (commitment *model.Commitment, err error) {
+	m.commitmentMutex.Lock()
+	defer m.commitmentMutex.Unlock()
+
+	if latestIndex := m.storage.Settings().LatestCommitment().Index(); index != latestIndex+1 {
+		return nil, errors.Errorf("cannot force commit slot %d, latest commitment is for slot %d", index, latestIndex)
+	}
+
+	if !m.createCommitment(index) {
+		return nil, errors.Errorf("failed to force commit slot %d", index)
+	}
+
+	return m.storage.Settings().LatestCommitment(), nil
+}
+
 // MinCommittableSlotAge returns the minimum age of a slot to be committable.
 func (m *Manager) MinCommittableSlotAge() iotago.SlotIndex {
 	return m.optsMinCommittableSlotAge
@@ -227,26 +314,44 @@ func (m *Manager) createCommitment(index iotago.SlotIndex) (success bool) {
 		}
 	}
 
-	stateRoot, mutationRoot, err := m.ledger.CommitSlot(index)
+	// The credits are burned for the same blocks that the commitment contains as its ratified accepted blocks.
+	stateRoot, mutationRoot, accountRoot, err := m.ledger.CommitSlot(index, m.slotMutations.IssuedBlocks(index))
 	if err != nil {
 		m.errorHandler(errors.Wrap(err, "failed to commit ledger"))
 		return false
 	}
 
+	roots := iotago.NewRoots(
+		iotago.Identifier(ratifiedAcceptedBlocks.Root()),
+		mutationRoot,
+		iotago.Identifier(attestations.Root()),
+		stateRoot,
+		ManaRoot(accountRoot, iotago.Identifier(m.slotMutations.weights.Root())),
+	)
+
+	// Keep the ratified accepted blocks around so that we can prove the inclusion of blocks in the commitment.
+	if err = m.storeRatifiedAcceptedBlocks(index, ratifiedAcceptedBlocks); err != nil {
+		m.errorHandler(errors.Wrapf(err, "failed to store ratified accepted blocks for slot %d", index))
+		return false
+	}
+
+	// Keep the roots around so that we can prove the content of the slot (e.g. its attestations) to our peers.
+	if rootsStorage := m.storage.Roots(index); rootsStorage == nil {
+		m.errorHandler(errors.Errorf("failed to access roots storage for slot %d", index))
+		return false
+	} else if err = rootsStorage.Store(roots); err != nil {
+		m.errorHandler(errors.Wrapf(err, "failed to store roots for slot %d", index))
+		return false
+	}
+
 	newCommitment := iotago.NewCommitment(
 		index,
 		latestCommitment.ID(),
-		iotago.NewRoots(
-			iotago.Identifier(ratifiedAcceptedBlocks.Root()),
-			mutationRoot,
-			iotago.Identifier(attestations.Root()),
-			stateRoot,
-			iotago.Identifier(m.slotMutations.weights.Root()),
-		).ID(),
+		model.RootsID(roots),
 		m.storage.Settings().LatestCommitment().CumulativeWeight()+uint64(attestationsWeight),
 	)
 
-	newModelCommitment, err := model.CommitmentFromCommitment(newCommitment, m.storage.Settings().API(), serix.WithValidation())
+	newModelCommitment, err := model.CommitmentFromCommitment(newCommitment, m.storage.Settings().API(newCommitment.Index), serix.WithValidation())
 	if err != nil {
 		return false
 	}
@@ -274,6 +379,73 @@ func (m *Manager) createCommitment(index iotago.SlotIndex) (success bool) {
 	return true
 }
 
+// ManaRoot combines the root of the accounts ledger with the root of the weights of the sybil protection, as both of
+// them are derived from the Mana of the accounts and the commitment only has a single root for them.
+func ManaRoot(accountRoot, weightsRoot iotago.Identifier) iotago.Identifier {
+	return blake2b.Sum256(byteutils.ConcatBytes(accountRoot[:], weightsRoot[:]))
+}
+
+// storeRatifiedAcceptedBlocks persists a copy of the in-memory set of ratified accepted blocks of the given slot.
+func (m *Manager) storeRatifiedAcceptedBlocks(index iotago.SlotIndex, ratifiedAcceptedBlocks *ads.Set[iotago.BlockID, *iotago.BlockID]) error {
+	store := m.storage.RatifiedAcceptedBlocks(index)
+	if store == nil {
+		return errors.Errorf("failed to access ratified accepted blocks storage for slot %d", index)
+	}
+
+	// The slot might have been committed before a rollback, so we make sure to start from an empty set.
+	if err := store.Clear(); err != nil {
+		return errors.Wrap(err, "failed to clear ratified accepted blocks storage")
+	}
+
+	storedBlocks := ads.NewSet[iotago.BlockID](store)
+	if err := ratifiedAcceptedBlocks.Stream(func(blockID iotago.BlockID) bool {
+		storedBlocks.Add(blockID)
+		return true
+	}); err != nil {
+		return errors.Wrap(err, "failed to stream ratified accepted blocks")
+	}
+
+	if storedRoot, root := storedBlocks.Root(), ratifiedAcceptedBlocks.Root(); storedRoot != root {
+		return errors.Errorf("root %s of stored ratified accepted blocks does not match root %s", iotago.Identifier(storedRoot), iotago.Identifier(root))
+	}
+
+	return nil
+}
+
+// IsBlockIncluded returns whether the given block is part of the ratified accepted blocks that the commitment of its
+// slot commits to (blocks of slots that are not committed yet are not included).
+func (m *Manager) IsBlockIncluded(blockID iotago.BlockID) (included bool, err error) {
+	if latestCommitmentIndex := m.storage.Settings().LatestCommitment().Index(); blockID.Index() > latestCommitmentIndex {
+		return false, nil
+	}
+
+	store := m.storage.RatifiedAcceptedBlocks(blockID.Index())
+	if store == nil {
+		return false, errors.Errorf("ratified accepted blocks of slot %d are not available", blockID.Index())
+	}
+
+	return ads.NewSet[iotago.BlockID](store).Has(blockID), nil
+}
+
+// BlockInclusionProof returns a Merkle proof that the given block is included in the commitment of its slot.
+func (m *Manager) BlockInclusionProof(blockID iotago.BlockID) (proof *notarization.BlockInclusionProof, err error) {
+	if included, includedErr := m.IsBlockIncluded(blockID); includedErr != nil {
+		return nil, includedErr
+	} else if !included {
+		return nil, errors.Wrapf(notarization.ErrBlockNotIncluded, "block %s", blockID)
+	}
+
+	store := m.storage.RatifiedAcceptedBlocks(blockID.Index())
+	ratifiedAcceptedBlocks := ads.NewSet[iotago.BlockID](store)
+
+	sideNodes, err := adsproof.Prove(store, iotago.Identifier(ratifiedAcceptedBlocks.Root()), blockID[:])
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create inclusion proof for block %s", blockID)
+	}
+
+	return notarization.NewBlockInclusionProof(blockID, sideNodes), nil
+}
+
 func (m *Manager) PerformLocked(perform func(m notarization.Notarization)) {
 	m.commitmentMutex.Lock()
 	defer m.commitmentMutex.Unlock()
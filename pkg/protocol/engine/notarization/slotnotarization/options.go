@@ -11,3 +11,10 @@ func WithMinCommittableSlotAge(age iotago.SlotIndex) options.Option[Manager] {
 		manager.optsMinCommittableSlotAge = age
 	}
 }
+
+// WithAttestationWindow specifies the number of committed slots whose attestations are included in snapshots.
+func WithAttestationWindow(window iotago.SlotIndex) options.Option[Manager] {
+	return func(manager *Manager) {
+		manager.optsAttestationWindow = window
+	}
+}
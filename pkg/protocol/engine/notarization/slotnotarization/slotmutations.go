@@ -22,6 +22,10 @@ type SlotMutations struct {
 	// ratifiedAcceptedBlocksBySlot stores the accepted blocks per slot.
 	ratifiedAcceptedBlocksBySlot *shrinkingmap.ShrinkingMap[iotago.SlotIndex, *ads.Set[iotago.BlockID, *iotago.BlockID]]
 
+	// issuedBlocksBySlot stores the number of accepted blocks per issuer per slot (they burn block issuance credits).
+	issuedBlocksBySlot *shrinkingmap.ShrinkingMap[iotago.SlotIndex, map[iotago.AccountID]int64]
+	issuedBlocksMutex  sync.Mutex
+
 	// latestCommittedIndex stores the index of the latest committed slot.
 	latestCommittedIndex iotago.SlotIndex
 
@@ -33,11 +37,12 @@ func NewSlotMutations(weights *account.Accounts[iotago.AccountID, *iotago.Accoun
 	return &SlotMutations{
 		weights:                      weights,
 		ratifiedAcceptedBlocksBySlot: shrinkingmap.New[iotago.SlotIndex, *ads.Set[iotago.BlockID, *iotago.BlockID]](),
+		issuedBlocksBySlot:           shrinkingmap.New[iotago.SlotIndex, map[iotago.AccountID]int64](),
 		latestCommittedIndex:         lastCommittedSlot,
 	}
 }
 
-// AddRatifiedAcceptedBlock adds the given block to the set of accepted blocks.
+// AddRatifiedAcceptedBlock adds the given block to the set of accepted blocks and counts it for its issuer.
 func (m *SlotMutations) AddRatifiedAcceptedBlock(block *blocks.Block) (err error) {
 	m.evictionMutex.RLock()
 	defer m.evictionMutex.RUnlock()
@@ -47,7 +52,20 @@ func (m *SlotMutations) AddRatifiedAcceptedBlock(block *blocks.Block) (err error
 		return errors.Errorf("cannot add block %s: slot with %d is already committed", blockID, blockID.Index())
 	}
 
-	m.RatifiedAcceptedBlocks(blockID.Index(), true).Add(blockID)
+	m.issuedBlocksMutex.Lock()
+	defer m.issuedBlocksMutex.Unlock()
+
+	ratifiedAcceptedBlocks := m.RatifiedAcceptedBlocks(blockID.Index(), true)
+	if ratifiedAcceptedBlocks.Has(blockID) {
+		return
+	}
+
+	ratifiedAcceptedBlocks.Add(blockID)
+
+	issuedBlocks, _ := m.issuedBlocksBySlot.GetOrCreate(blockID.Index(), func() map[iotago.AccountID]int64 {
+		return make(map[iotago.AccountID]int64)
+	})
+	issuedBlocks[block.Block().IssuerID]++
 
 	return
 }
@@ -72,6 +90,7 @@ func (m *SlotMutations) Reset(index iotago.SlotIndex) {
 
 	for i := m.latestCommittedIndex; i > index; i-- {
 		m.ratifiedAcceptedBlocksBySlot.Delete(i)
+		m.issuedBlocksBySlot.Delete(i)
 	}
 
 	m.latestCommittedIndex = index
@@ -86,10 +105,25 @@ func (m *SlotMutations) RatifiedAcceptedBlocks(index iotago.SlotIndex, createIfM
 	return lo.Return1(m.ratifiedAcceptedBlocksBySlot.Get(index))
 }
 
+// IssuedBlocks returns the number of ratified accepted blocks per issuer for the given slot. It is derived from the same
+// blocks as the set of ratified accepted blocks, so that the credits that are burned match the blocks that are committed.
+func (m *SlotMutations) IssuedBlocks(index iotago.SlotIndex) map[iotago.AccountID]int64 {
+	m.issuedBlocksMutex.Lock()
+	defer m.issuedBlocksMutex.Unlock()
+
+	issuedBlocks, exists := m.issuedBlocksBySlot.Get(index)
+	if !exists {
+		return make(map[iotago.AccountID]int64)
+	}
+
+	return lo.MergeMaps(make(map[iotago.AccountID]int64), issuedBlocks)
+}
+
 // evictUntil removes all data for slots that are older than the given slot.
 func (m *SlotMutations) evictUntil(index iotago.SlotIndex) {
 	for i := m.latestCommittedIndex + 1; i <= index; i++ {
 		m.ratifiedAcceptedBlocksBySlot.Delete(i)
+		m.issuedBlocksBySlot.Delete(i)
 	}
 
 	m.latestCommittedIndex = index
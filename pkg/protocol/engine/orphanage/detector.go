@@ -0,0 +1,148 @@
+package orphanage
+
+import (
+	"sync"
+	"time"
+
+	"github.com/iotaledger/hive.go/core/memstorage"
+	"github.com/iotaledger/hive.go/ds/shrinkingmap"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// Detector keeps track of the booked blocks that were not accepted yet and marks them as orphaned as soon as they can no
+// longer be accepted, which is the case if:
+//   - their slot was committed without them being accepted,
+//   - they are older than the acceptance horizon (relative to the accepted time), or
+//   - they were not referenced by any other block within the maximum unreferenced time.
+type Detector struct {
+	Events *Events
+
+	isBootstrappedFunc func() bool
+	pendingBlocks      *memstorage.IndexedStorage[iotago.SlotIndex, iotago.BlockID, *blocks.Block]
+	lastCommittedSlot  iotago.SlotIndex
+	mutex              sync.Mutex
+
+	optsAcceptanceHorizon   time.Duration
+	optsMaxUnreferencedTime time.Duration
+}
+
+// New creates a new orphanage Detector.
+func New(isBootstrappedFunc func() bool, opts ...options.Option[Detector]) *Detector {
+	return options.Apply(&Detector{
+		Events:                  NewEvents(),
+		isBootstrappedFunc:      isBootstrappedFunc,
+		pendingBlocks:           memstorage.NewIndexedStorage[iotago.SlotIndex, iotago.BlockID, *blocks.Block](),
+		optsAcceptanceHorizon:   time.Minute,
+		optsMaxUnreferencedTime: 0,
+	}, opts)
+}
+
+// TrackBlock starts tracking the given block until it is either accepted or orphaned.
+func (d *Detector) TrackBlock(block *blocks.Block) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if block.IsAccepted() || block.IsOrphaned() {
+		return
+	}
+
+	if block.ID().Index() <= d.lastCommittedSlot {
+		d.orphan(block)
+		return
+	}
+
+	d.pendingBlocks.Get(block.ID().Index(), true).Set(block.ID(), block)
+}
+
+// UntrackBlock stops tracking the given block (e.g. because it was accepted).
+func (d *Detector) UntrackBlock(block *blocks.Block) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if pendingBlocks := d.pendingBlocks.Get(block.ID().Index()); pendingBlocks != nil {
+		pendingBlocks.Delete(block.ID())
+	}
+}
+
+// UpdateAcceptedTime orphans all pending blocks that fell below the acceptance horizon or that were not referenced in
+// time, relative to the given accepted time.
+func (d *Detector) UpdateAcceptedTime(acceptedTime time.Time) {
+	// If the node is not bootstrapped, blocks might still be accepted while we catch up, even if they are old.
+	if !d.isBootstrappedFunc() {
+		return
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	orphanedBlocks := make([]*blocks.Block, 0)
+	d.pendingBlocks.ForEach(func(_ iotago.SlotIndex, pendingBlocks *shrinkingmap.ShrinkingMap[iotago.BlockID, *blocks.Block]) {
+		pendingBlocks.ForEach(func(_ iotago.BlockID, block *blocks.Block) bool {
+			if d.isBelowAcceptanceHorizon(block, acceptedTime) || d.isUnreferencedForTooLong(block, acceptedTime) {
+				orphanedBlocks = append(orphanedBlocks, block)
+			}
+
+			return true
+		})
+	})
+
+	for _, block := range orphanedBlocks {
+		d.pendingBlocks.Get(block.ID().Index()).Delete(block.ID())
+		d.orphan(block)
+	}
+}
+
+// CommitSlot orphans all pending blocks of the given slot, as they can not be accepted after their slot was committed.
+func (d *Detector) CommitSlot(index iotago.SlotIndex) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	for ; d.lastCommittedSlot < index; d.lastCommittedSlot++ {
+		if pendingBlocks := d.pendingBlocks.Evict(d.lastCommittedSlot + 1); pendingBlocks != nil {
+			pendingBlocks.ForEach(func(_ iotago.BlockID, block *blocks.Block) bool {
+				d.orphan(block)
+				return true
+			})
+		}
+	}
+}
+
+// Initialize sets the slot of the latest commitment, below which all blocks are considered to be orphaned.
+func (d *Detector) Initialize(lastCommittedSlot iotago.SlotIndex) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.lastCommittedSlot = lastCommittedSlot
+}
+
+func (d *Detector) isBelowAcceptanceHorizon(block *blocks.Block, acceptedTime time.Time) bool {
+	return d.optsAcceptanceHorizon > 0 && block.IssuingTime().Before(acceptedTime.Add(-d.optsAcceptanceHorizon))
+}
+
+func (d *Detector) isUnreferencedForTooLong(block *blocks.Block, acceptedTime time.Time) bool {
+	return d.optsMaxUnreferencedTime > 0 && block.IssuingTime().Before(acceptedTime.Add(-d.optsMaxUnreferencedTime)) && len(block.Children()) == 0
+}
+
+func (d *Detector) orphan(block *blocks.Block) {
+	if !block.IsAccepted() && block.SetOrphaned() {
+		d.Events.BlockOrphaned.Trigger(block)
+	}
+}
+
+// WithAcceptanceHorizon sets the maximum age of a block (relative to the accepted time) after which it is considered
+// to be orphaned if it was not accepted yet (0 disables the check).
+func WithAcceptanceHorizon(acceptanceHorizon time.Duration) options.Option[Detector] {
+	return func(d *Detector) {
+		d.optsAcceptanceHorizon = acceptanceHorizon
+	}
+}
+
+// WithMaxUnreferencedTime sets the maximum age of a block (relative to the accepted time) after which it is considered
+// to be orphaned if it was not referenced by any other block yet (0 disables the check).
+func WithMaxUnreferencedTime(maxUnreferencedTime time.Duration) options.Option[Detector] {
+	return func(d *Detector) {
+		d.optsMaxUnreferencedTime = maxUnreferencedTime
+	}
+}
@@ -0,0 +1,86 @@
+package orphanage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate/tpkg"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestDetector(t *testing.T) {
+	detector := New(func() bool { return true }, WithAcceptanceHorizon(time.Minute), WithMaxUnreferencedTime(10*time.Second))
+
+	orphanedBlocks := make(map[iotago.BlockID]bool)
+	detector.Events.BlockOrphaned.Hook(func(block *blocks.Block) {
+		orphanedBlocks[block.ID()] = true
+	})
+
+	slotTimeProvider := tpkg.API().SlotTimeProvider()
+	newBlock := func(index iotago.SlotIndex, offset time.Duration, parents ...*blocks.Block) *blocks.Block {
+		strongParents := iotago.BlockIDs{iotago.EmptyBlockID()}
+		if len(parents) > 0 {
+			strongParents = iotago.BlockIDs{}
+			for _, parent := range parents {
+				strongParents = append(strongParents, parent.ID())
+			}
+		}
+
+		modelBlock, err := model.BlockFromBlock(&iotago.Block{
+			ProtocolVersion: tpkg.ProtocolParams().Version,
+			IssuerID:        iotago.AccountID{1},
+			IssuingTime:     slotTimeProvider.StartTime(index).Add(offset),
+			SlotCommitment:  iotago.NewEmptyCommitment(),
+			StrongParents:   strongParents,
+			Signature:       &iotago.Ed25519Signature{},
+		}, tpkg.API())
+		require.NoError(t, err)
+
+		block := blocks.NewBlock(modelBlock)
+		for _, parent := range parents {
+			parent.AppendChild(block, model.StrongParentType)
+		}
+
+		return block
+	}
+
+	referencedBlock := newBlock(10, 0)
+	unreferencedBlock := newBlock(10, time.Second)
+	acceptedBlock := newBlock(10, 2*time.Second)
+	child := newBlock(16, 0, referencedBlock)
+
+	for _, block := range []*blocks.Block{referencedBlock, unreferencedBlock, acceptedBlock, child} {
+		detector.TrackBlock(block)
+	}
+
+	acceptedBlock.SetAccepted()
+	detector.UntrackBlock(acceptedBlock)
+
+	// blocks that are not referenced within the maximum unreferenced time are orphaned.
+	detector.UpdateAcceptedTime(unreferencedBlock.IssuingTime().Add(5 * time.Second))
+	require.Empty(t, orphanedBlocks)
+
+	detector.UpdateAcceptedTime(unreferencedBlock.IssuingTime().Add(11 * time.Second))
+	require.Equal(t, map[iotago.BlockID]bool{unreferencedBlock.ID(): true}, orphanedBlocks)
+	require.True(t, unreferencedBlock.IsOrphaned())
+
+	// blocks that fall below the acceptance horizon are orphaned even if they were referenced.
+	detector.UpdateAcceptedTime(referencedBlock.IssuingTime().Add(61 * time.Second))
+	require.True(t, orphanedBlocks[referencedBlock.ID()])
+	require.False(t, orphanedBlocks[acceptedBlock.ID()])
+
+	// blocks whose slot was committed without them being accepted are orphaned.
+	require.False(t, orphanedBlocks[child.ID()])
+	detector.CommitSlot(16)
+	require.True(t, orphanedBlocks[child.ID()])
+
+	// blocks that are booked after their slot was committed are orphaned immediately.
+	lateBlock := newBlock(15, 0)
+	detector.TrackBlock(lateBlock)
+	require.True(t, lateBlock.IsOrphaned())
+	require.Len(t, orphanedBlocks, 4)
+}
@@ -0,0 +1,20 @@
+package orphanage
+
+import (
+	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
+)
+
+type Events struct {
+	// BlockOrphaned is triggered when a block can no longer be accepted.
+	BlockOrphaned *event.Event1[*blocks.Block]
+
+	event.Group[Events, *Events]
+}
+
+// NewEvents contains the constructor of the Events object (it is generated by a generic factory).
+var NewEvents = event.CreateGroupConstructor(func() (self *Events) {
+	return &Events{
+		BlockOrphaned: event.New1[*blocks.Block](),
+	}
+})
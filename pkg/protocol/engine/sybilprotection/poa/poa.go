@@ -1,9 +1,12 @@
 package poa
 
 import (
+	"io"
 	"sync"
 	"time"
 
+	"github.com/pkg/errors"
+
 	"github.com/iotaledger/hive.go/core/account"
 	"github.com/iotaledger/hive.go/ds/shrinkingmap"
 	"github.com/iotaledger/hive.go/kvstore/mapdb"
@@ -91,10 +94,35 @@ func (s *SybilProtection) OnlineCommittee() *account.SelectedAccounts[iotago.Acc
 	return s.onlineCommittee
 }
 
+// Weights returns the weights of the statically configured committee (which is active at every slot).
+func (s *SybilProtection) Weights(_ iotago.SlotIndex) (map[iotago.AccountID]int64, error) {
+	return s.accounts.Map()
+}
+
 func (s *SybilProtection) LastCommittedSlot() iotago.SlotIndex {
 	return 0
 }
 
+// Import reads the weights of the committee from the snapshot. The committee of the proof of authority is statically
+// configured, so the weights from the snapshot are ignored.
+func (s *SybilProtection) Import(reader io.ReadSeeker) error {
+	if _, err := sybilprotection.ReadWeights(reader); err != nil {
+		return errors.Wrap(err, "failed to import weights")
+	}
+
+	return nil
+}
+
+// Export writes the weights of the statically configured committee to the snapshot.
+func (s *SybilProtection) Export(writer io.WriteSeeker, targetSlot iotago.SlotIndex) error {
+	weights, err := s.Weights(targetSlot)
+	if err != nil {
+		return errors.Wrap(err, "failed to read weights")
+	}
+
+	return sybilprotection.WriteWeights(writer, weights)
+}
+
 func (s *SybilProtection) Shutdown() {
 	s.TriggerStopped()
 	s.stopInactivityManager()
@@ -0,0 +1,476 @@
+package pos
+
+import (
+	"bytes"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/core/account"
+	"github.com/iotaledger/hive.go/ds/shrinkingmap"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/hive.go/runtime/module"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/runtime/timed"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/hive.go/serializer/v2"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/clock"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledger"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledger/accountsledger"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/notarization"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/sybilprotection"
+	"github.com/iotaledger/iota-core/pkg/storage/permanent"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+const (
+	PrefixLastCommittedSlot byte = iota
+	PrefixWeights
+	PrefixCommittees
+)
+
+// SybilProtection is a sybil protection module for the engine that derives the weights of the validators from the
+// funds that are held by their accounts in the accounts ledger. The committee is rotated at the end of every epoch.
+type SybilProtection struct {
+	clock             clock.Clock
+	ledger            ledger.Ledger
+	settings          *permanent.Settings
+	workers           *workerpool.Group
+	store             kvstore.KVStore
+	accounts          *account.Accounts[iotago.AccountID, *iotago.AccountID]
+	committee         *account.SelectedAccounts[iotago.AccountID, *iotago.AccountID]
+	onlineCommittee   *account.SelectedAccounts[iotago.AccountID, *iotago.AccountID]
+	inactivityManager *timed.TaskExecutor[iotago.AccountID]
+	lastActivities    *shrinkingmap.ShrinkingMap[iotago.AccountID, time.Time]
+	lastCommittedSlot iotago.SlotIndex
+	mutex             sync.RWMutex
+
+	optsActivityWindow time.Duration
+
+	module.Module
+}
+
+// NewProvider returns a new sybil protection provider that uses the ProofOfStake module.
+func NewProvider(opts ...options.Option[SybilProtection]) module.Provider[*engine.Engine, sybilprotection.SybilProtection] {
+	return module.Provide(func(e *engine.Engine) sybilprotection.SybilProtection {
+		return options.Apply(
+			&SybilProtection{
+				workers:           e.Workers.CreateGroup("SybilProtection"),
+				settings:          e.Storage.Settings(),
+				store:             e.Storage.Permanent.SybilProtection(),
+				accounts:          account.NewAccounts[iotago.AccountID](e.Storage.Permanent.SybilProtection(PrefixWeights)),
+				inactivityManager: timed.NewTaskExecutor[iotago.AccountID](1),
+				lastActivities:    shrinkingmap.New[iotago.AccountID, time.Time](),
+
+				optsActivityWindow: time.Second * 30,
+			}, opts, func(s *SybilProtection) {
+				s.lastCommittedSlot = s.loadLastCommittedSlot()
+				s.committee = s.accounts.SelectAccounts(s.activeAccounts()...)
+				s.onlineCommittee = s.accounts.SelectAccounts()
+
+				e.HookConstructed(func() {
+					s.clock = e.Clock
+					s.ledger = e.Ledger
+
+					e.Clock.HookInitialized(func() {
+						for _, v := range s.committee.Members().Slice() {
+							s.markValidatorActive(v, e.Clock.Accepted().RelativeTime())
+						}
+					})
+
+					e.Events.BlockDAG.BlockSolid.Hook(func(block *blocks.Block) {
+						s.markValidatorActive(block.Block().IssuerID, block.IssuingTime())
+					}, event.WithWorkerPool(s.workers.CreatePool("SybilProtection", 1)))
+
+					// The committee needs to be rotated before the next slot is committed, so we hook synchronously.
+					e.Events.Notarization.SlotCommitted.Hook(func(details *notarization.SlotCommittedDetails) {
+						if err := s.slotCommitted(details.Commitment.Index()); err != nil {
+							e.ErrorHandler("sybilprotection")(errors.Wrapf(err, "failed to process committed slot %d", details.Commitment.Index()))
+						}
+					})
+				})
+			})
+	})
+}
+
+var _ sybilprotection.SybilProtection = &SybilProtection{}
+
+// Accounts returns all the known validators (validators that left the committee are kept with a weight of 0).
+func (s *SybilProtection) Accounts() *account.Accounts[iotago.AccountID, *iotago.AccountID] {
+	return s.accounts
+}
+
+// Committee returns the set of validators selected to be part of the committee of the current epoch.
+func (s *SybilProtection) Committee() *account.SelectedAccounts[iotago.AccountID, *iotago.AccountID] {
+	return s.committee
+}
+
+// OnlineCommittee returns the set of validators selected to be part of the committee that has been seen recently.
+func (s *SybilProtection) OnlineCommittee() *account.SelectedAccounts[iotago.AccountID, *iotago.AccountID] {
+	return s.onlineCommittee
+}
+
+// LastCommittedSlot returns the last slot whose commitment was processed by the SybilProtection.
+func (s *SybilProtection) LastCommittedSlot() iotago.SlotIndex {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.lastCommittedSlot
+}
+
+// Import imports the weights of the committee from the snapshot. The weights are the only source of the weights root
+// of the commitments, so they are imported as they are (including the accounts that left the committee). If the
+// snapshot does not contain a committee (e.g. a genesis snapshot), the committee is derived from the funds in the
+// (already imported) ledger.
+func (s *SybilProtection) Import(reader io.ReadSeeker) error {
+	weights, err := sybilprotection.ReadWeights(reader)
+	if err != nil {
+		return errors.Wrap(err, "failed to import weights")
+	}
+
+	latestCommittedSlot := s.settings.LatestCommitment().Index()
+
+	if len(weights) == 0 {
+		stakes, stakesErr := s.stakes()
+		if stakesErr != nil {
+			return errors.Wrap(stakesErr, "failed to derive stakes from ledger")
+		}
+
+		weights = s.selectCommittee(stakes)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.setCommittee(weights)
+
+	if err = s.storeWeights(s.epochStart(latestCommittedSlot)); err != nil {
+		return errors.Wrap(err, "failed to store imported weights")
+	}
+
+	return s.storeLastCommittedSlot(latestCommittedSlot)
+}
+
+// Weights returns the weights of the committee that is active at the given slot.
+func (s *SybilProtection) Weights(slot iotago.SlotIndex) (map[iotago.AccountID]int64, error) {
+	weights, err := s.loadWeights(s.epochStart(slot))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load weights for slot %d", slot)
+	}
+
+	// The weights of the epoch are not known if we did not process its start yet (e.g. when starting from genesis).
+	if len(weights) == 0 {
+		if weights, err = s.accounts.Map(); err != nil {
+			return nil, errors.Wrap(err, "failed to load current weights")
+		}
+	}
+
+	return weights, nil
+}
+
+// Export exports the weights of the committee that is active at the given slot.
+func (s *SybilProtection) Export(writer io.WriteSeeker, targetSlot iotago.SlotIndex) error {
+	weights, err := s.Weights(targetSlot)
+	if err != nil {
+		return err
+	}
+
+	return sybilprotection.WriteWeights(writer, weights)
+}
+
+// RollbackToSlot reverts the persisted state of the sybil protection in the given (offline) storage to the given slot.
+// The weights of the epochs that start after the slot was committed are removed and the current weights are restored
+// from the epoch that is active after the slot. It returns the root of the weights that the slot was committed with.
+func RollbackToSlot(store kvstore.KVStore, targetSlot iotago.SlotIndex) (weightsRoot iotago.Identifier, err error) {
+	s := &SybilProtection{store: store}
+
+	epochStarts := make(map[iotago.SlotIndex]struct{})
+	if err = store.IterateKeys(kvstore.KeyPrefix{PrefixCommittees}, func(key kvstore.Key) bool {
+		if len(key) < 1+serializer.UInt64ByteSize {
+			return true
+		}
+
+		if epochStart, epochStartErr := iotago.SlotIndexFromBytes(key[1 : 1+serializer.UInt64ByteSize]); epochStartErr == nil {
+			epochStarts[epochStart] = struct{}{}
+		}
+
+		return true
+	}); err != nil {
+		return iotago.Identifier{}, errors.Wrap(err, "failed to iterate over the weights of the epochs")
+	}
+
+	// The committee is rotated when the last slot of an epoch is committed, so the weights of the next epoch are kept.
+	committedEpochStart, activeEpochStart := iotago.SlotIndex(0), iotago.SlotIndex(0)
+	committedEpochFound := false
+	for epochStart := range epochStarts {
+		switch {
+		case epochStart > targetSlot+1:
+			if err = store.DeletePrefix(append([]byte{PrefixCommittees}, epochStart.Bytes()...)); err != nil {
+				return iotago.Identifier{}, errors.Wrapf(err, "failed to delete weights of epoch starting at slot %d", epochStart)
+			}
+		case epochStart > activeEpochStart:
+			activeEpochStart = epochStart
+		}
+
+		if epochStart <= targetSlot && (!committedEpochFound || epochStart > committedEpochStart) {
+			committedEpochStart, committedEpochFound = epochStart, true
+		}
+	}
+
+	if !committedEpochFound {
+		return iotago.Identifier{}, errors.Errorf("the weights of slot %d are not known", targetSlot)
+	}
+
+	weightsStore := lo.PanicOnErr(store.WithExtendedRealm([]byte{PrefixWeights}))
+	if err = weightsStore.Clear(); err != nil {
+		return iotago.Identifier{}, errors.Wrap(err, "failed to clear weights")
+	}
+
+	weights := account.NewAccounts[iotago.AccountID](weightsStore)
+	if err = account.NewAccounts[iotago.AccountID](s.committeeStore(activeEpochStart)).ForEach(func(id iotago.AccountID, weight int64) bool {
+		weights.Set(id, weight)
+
+		return true
+	}); err != nil {
+		return iotago.Identifier{}, errors.Wrapf(err, "failed to restore weights of epoch starting at slot %d", activeEpochStart)
+	}
+
+	if err = s.storeLastCommittedSlot(targetSlot); err != nil {
+		return iotago.Identifier{}, errors.Wrap(err, "failed to store last committed slot")
+	}
+
+	return iotago.Identifier(account.NewAccounts[iotago.AccountID](s.committeeStore(committedEpochStart)).Root()), nil
+}
+
+func (s *SybilProtection) Shutdown() {
+	s.TriggerStopped()
+	s.stopInactivityManager()
+	s.workers.Shutdown()
+}
+
+// slotCommitted rotates the committee if the given slot is the last slot of an epoch.
+func (s *SybilProtection) slotCommitted(index iotago.SlotIndex) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if index <= s.lastCommittedSlot {
+		return nil
+	}
+
+	if epochLength, nextSlot := s.settings.EpochLength(), index+1; epochLength != 0 && nextSlot%epochLength == 0 {
+		stakes, err := s.stakes()
+		if err != nil {
+			return errors.Wrap(err, "failed to derive stakes from ledger")
+		}
+
+		// We keep the current committee if nobody would be left to validate.
+		if weights := s.selectCommittee(stakes); len(weights) != 0 {
+			s.setCommittee(weights)
+		}
+
+		if err = s.storeWeights(nextSlot); err != nil {
+			return errors.Wrapf(err, "failed to store weights of epoch starting at slot %d", nextSlot)
+		}
+	}
+
+	return s.storeLastCommittedSlot(index)
+}
+
+// stakes returns the funds that are held by the account output of every account in the accounts ledger.
+func (s *SybilProtection) stakes() (stakes map[iotago.AccountID]uint64, err error) {
+	stakes = make(map[iotago.AccountID]uint64)
+
+	if err = s.ledger.ForEachAccount(func(accountData *accountsledger.AccountData) bool {
+		stakes[accountData.ID] = accountData.Deposit
+
+		return true
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate over accounts")
+	}
+
+	return stakes, nil
+}
+
+// selectCommittee selects the accounts with the highest stake (that hold at least the minimum stake) as the committee.
+func (s *SybilProtection) selectCommittee(stakes map[iotago.AccountID]uint64) (weights map[iotago.AccountID]int64) {
+	minimumStake, maxCommitteeSize := s.settings.MinimumStake(), int(s.settings.MaxCommitteeSize())
+
+	candidates := make([]iotago.AccountID, 0, len(stakes))
+	for accountID, stake := range stakes {
+		if stake >= minimumStake {
+			candidates = append(candidates, accountID)
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if stakes[candidates[i]] != stakes[candidates[j]] {
+			return stakes[candidates[i]] > stakes[candidates[j]]
+		}
+
+		return bytes.Compare(candidates[i][:], candidates[j][:]) < 0
+	})
+
+	if len(candidates) > maxCommitteeSize {
+		candidates = candidates[:maxCommitteeSize]
+	}
+
+	weights = make(map[iotago.AccountID]int64)
+	for _, accountID := range candidates {
+		weights[accountID] = int64(stakes[accountID])
+	}
+
+	return weights
+}
+
+// setCommittee replaces the committee with the given weights. Accounts that leave the committee keep a weight of 0, so
+// that the weights root only depends on the history of the committee (which is carried over in snapshots).
+func (s *SybilProtection) setCommittee(weights map[iotago.AccountID]int64) {
+	// The selected accounts cache their total weight, so we need to remove the members before changing their weights.
+	onlineMembers := s.onlineCommittee.Members()
+	for _, member := range s.committee.Members().Slice() {
+		s.committee.Delete(member)
+		s.onlineCommittee.Delete(member)
+	}
+
+	for _, accountID := range lo.Keys(lo.PanicOnErr(s.accounts.Map())) {
+		if _, isMember := weights[accountID]; !isMember {
+			s.accounts.Set(accountID, 0)
+		}
+	}
+
+	for accountID, weight := range weights {
+		s.accounts.Set(accountID, weight)
+
+		// Snapshots carry the accounts that left the committee with a weight of 0, which are not members.
+		if weight <= 0 {
+			continue
+		}
+
+		s.committee.Add(accountID)
+
+		if onlineMembers.Has(accountID) {
+			s.onlineCommittee.Add(accountID)
+		}
+	}
+
+	onlineMembers.ForEach(func(accountID iotago.AccountID) error {
+		if !s.committee.Has(accountID) {
+			s.lastActivities.Delete(accountID)
+			s.inactivityManager.Cancel(accountID)
+		}
+
+		return nil
+	})
+}
+
+// activeAccounts returns the accounts that have a weight (and are therefore part of the committee).
+func (s *SybilProtection) activeAccounts() (activeAccounts []iotago.AccountID) {
+	_ = s.accounts.ForEach(func(id iotago.AccountID, weight int64) bool {
+		if weight > 0 {
+			activeAccounts = append(activeAccounts, id)
+		}
+
+		return true
+	})
+
+	return activeAccounts
+}
+
+// epochStart returns the first slot of the epoch of the given slot (the committee is never rotated without an epoch
+// length, so all slots belong to the genesis epoch).
+func (s *SybilProtection) epochStart(index iotago.SlotIndex) iotago.SlotIndex {
+	epochLength := s.settings.EpochLength()
+	if epochLength == 0 {
+		return 0
+	}
+
+	return index - index%epochLength
+}
+
+// storeWeights persists the weights of all accounts for the epoch starting at the given slot.
+func (s *SybilProtection) storeWeights(epochStart iotago.SlotIndex) error {
+	store := s.committeeStore(epochStart)
+	if err := store.Clear(); err != nil {
+		return errors.Wrap(err, "failed to clear weights")
+	}
+
+	committee := account.NewAccounts[iotago.AccountID](store)
+
+	return s.accounts.ForEach(func(id iotago.AccountID, weight int64) bool {
+		committee.Set(id, weight)
+
+		return true
+	})
+}
+
+// loadWeights loads the persisted weights of the epoch starting at the given slot.
+func (s *SybilProtection) loadWeights(epochStart iotago.SlotIndex) (weights map[iotago.AccountID]int64, err error) {
+	return account.NewAccounts[iotago.AccountID](s.committeeStore(epochStart)).Map()
+}
+
+func (s *SybilProtection) committeeStore(epochStart iotago.SlotIndex) kvstore.KVStore {
+	return lo.PanicOnErr(s.store.WithExtendedRealm(append([]byte{PrefixCommittees}, epochStart.Bytes()...)))
+}
+
+func (s *SybilProtection) loadLastCommittedSlot() iotago.SlotIndex {
+	lastCommittedSlotBytes, err := s.store.Get([]byte{PrefixLastCommittedSlot})
+	if err != nil {
+		return 0
+	}
+
+	lastCommittedSlot, err := iotago.SlotIndexFromBytes(lastCommittedSlotBytes)
+	if err != nil {
+		return 0
+	}
+
+	return lastCommittedSlot
+}
+
+func (s *SybilProtection) storeLastCommittedSlot(index iotago.SlotIndex) error {
+	s.lastCommittedSlot = index
+
+	return s.store.Set([]byte{PrefixLastCommittedSlot}, index.Bytes())
+}
+
+func (s *SybilProtection) stopInactivityManager() {
+	s.inactivityManager.Shutdown(timed.CancelPendingElements)
+}
+
+func (s *SybilProtection) markValidatorActive(id iotago.AccountID, activityTime time.Time) {
+	if s.clock.WasStopped() {
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if !s.committee.Has(id) {
+		// Only track identities that are part of the committee
+		return
+	}
+
+	if lastActivity, exists := s.lastActivities.Get(id); exists && lastActivity.After(activityTime) {
+		return
+	} else if !exists {
+		s.onlineCommittee.Add(id)
+	}
+
+	s.lastActivities.Set(id, activityTime)
+
+	s.inactivityManager.ExecuteAfter(id, func() { s.markValidatorInactive(id) }, activityTime.Add(s.optsActivityWindow).Sub(s.clock.Accepted().RelativeTime()))
+}
+
+func (s *SybilProtection) markValidatorInactive(id iotago.AccountID) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.lastActivities.Delete(id)
+	s.onlineCommittee.Delete(id)
+}
@@ -0,0 +1,152 @@
+package pos
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/orcaman/writerseeker"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/core/account"
+	"github.com/iotaledger/hive.go/ds/shrinkingmap"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/runtime/timed"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/sybilprotection"
+	"github.com/iotaledger/iota-core/pkg/storage/permanent"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestSybilProtection_SelectCommittee(t *testing.T) {
+	s := newTestSybilProtection(t, 32, 2, 10)
+
+	weights := s.selectCommittee(map[iotago.AccountID]uint64{
+		{1}: 5,
+		{2}: 20,
+		{3}: 10,
+		{4}: 20,
+	})
+
+	// Validators are selected by stake, ties are broken by their AccountID.
+	require.Equal(t, map[iotago.AccountID]int64{{2}: 20, {4}: 20}, weights)
+
+	// Validators below the minimum stake are never selected.
+	require.Empty(t, s.selectCommittee(map[iotago.AccountID]uint64{{1}: 5}))
+}
+
+func TestSybilProtection_SetCommittee(t *testing.T) {
+	s := newTestSybilProtection(t, 32, 32, 1)
+	defer s.stopInactivityManager()
+
+	s.setCommittee(map[iotago.AccountID]int64{{1}: 10, {2}: 20})
+	s.onlineCommittee.Add(iotago.AccountID{1})
+	s.onlineCommittee.Add(iotago.AccountID{2})
+
+	require.EqualValues(t, 30, s.committee.TotalWeight())
+	require.EqualValues(t, 30, s.onlineCommittee.TotalWeight())
+
+	s.setCommittee(map[iotago.AccountID]int64{{2}: 5, {3}: 15})
+
+	require.EqualValues(t, 20, s.committee.TotalWeight())
+	require.False(t, s.committee.Has(iotago.AccountID{1}))
+
+	// Only validators that remain in the committee stay online.
+	require.EqualValues(t, 5, s.onlineCommittee.TotalWeight())
+	require.True(t, s.onlineCommittee.Has(iotago.AccountID{2}))
+
+	// Validators that left the committee are kept with a weight of 0.
+	require.Equal(t, map[iotago.AccountID]int64{{1}: 0, {2}: 5, {3}: 15}, lo.PanicOnErr(s.accounts.Map()))
+	require.ElementsMatch(t, []iotago.AccountID{{2}, {3}}, s.activeAccounts())
+
+	// Weights that are imported from a snapshot contain the validators that left the committee, which are no members.
+	s.setCommittee(map[iotago.AccountID]int64{{1}: 0, {2}: 5, {3}: 15})
+
+	require.EqualValues(t, 20, s.committee.TotalWeight())
+	require.False(t, s.committee.Has(iotago.AccountID{1}))
+}
+
+func TestSybilProtection_Weights(t *testing.T) {
+	s := newTestSybilProtection(t, 10, 32, 1)
+	defer s.stopInactivityManager()
+
+	s.setCommittee(map[iotago.AccountID]int64{{1}: 10, {2}: 20})
+	require.NoError(t, s.storeWeights(s.epochStart(13)))
+
+	s.setCommittee(map[iotago.AccountID]int64{{3}: 30})
+	require.NoError(t, s.storeWeights(s.epochStart(25)))
+
+	weights, err := s.loadWeights(s.epochStart(19))
+	require.NoError(t, err)
+	require.Equal(t, map[iotago.AccountID]int64{{1}: 10, {2}: 20}, weights)
+
+	writer := &writerseeker.WriterSeeker{}
+	require.NoError(t, s.Export(writer, 21))
+
+	importedWeights, err := sybilprotection.ReadWeights(writer.BytesReader())
+	require.NoError(t, err)
+	require.Equal(t, map[iotago.AccountID]int64{{1}: 0, {2}: 0, {3}: 30}, importedWeights)
+}
+
+func newTestSybilProtection(t *testing.T, epochLength iotago.SlotIndex, maxCommitteeSize uint32, minimumStake uint64) *SybilProtection {
+	settings := permanent.NewSettings(filepath.Join(t.TempDir(), "settings.bin"))
+	require.NoError(t, settings.SetEpochLength(epochLength))
+	require.NoError(t, settings.SetMaxCommitteeSize(maxCommitteeSize))
+	require.NoError(t, settings.SetMinimumStake(minimumStake))
+
+	s := &SybilProtection{
+		settings:          settings,
+		store:             mapdb.NewMapDB(),
+		accounts:          account.NewAccounts[iotago.AccountID](mapdb.NewMapDB()),
+		inactivityManager: timed.NewTaskExecutor[iotago.AccountID](1),
+		lastActivities:    shrinkingmap.New[iotago.AccountID, time.Time](),
+	}
+	s.committee = s.accounts.SelectAccounts()
+	s.onlineCommittee = s.accounts.SelectAccounts()
+
+	return s
+}
+
+func TestRollbackToSlot(t *testing.T) {
+	s := newTestSybilProtection(t, 10, 32, 1)
+	defer s.stopInactivityManager()
+	s.accounts = account.NewAccounts[iotago.AccountID](lo.PanicOnErr(s.store.WithExtendedRealm([]byte{PrefixWeights})))
+
+	s.setCommittee(map[iotago.AccountID]int64{{1}: 10, {2}: 20})
+	require.NoError(t, s.storeWeights(0))
+	epoch0Root := s.accounts.Root()
+
+	s.setCommittee(map[iotago.AccountID]int64{{3}: 30})
+	require.NoError(t, s.storeWeights(10))
+	epoch1Root := s.accounts.Root()
+
+	s.setCommittee(map[iotago.AccountID]int64{{4}: 40})
+	require.NoError(t, s.storeWeights(20))
+	require.NoError(t, s.storeLastCommittedSlot(25))
+
+	// the last slot of an epoch is committed with the weights of its epoch, while the next epoch is already selected.
+	weightsRoot, err := RollbackToSlot(s.store, 9)
+	require.NoError(t, err)
+	require.Equal(t, iotago.Identifier(epoch0Root), weightsRoot)
+	require.Equal(t, iotago.Identifier(epoch1Root), iotago.Identifier(account.NewAccounts[iotago.AccountID](lo.PanicOnErr(s.store.WithExtendedRealm([]byte{PrefixWeights}))).Root()))
+	require.Equal(t, iotago.SlotIndex(9), s.loadLastCommittedSlot())
+
+	weights, err := s.loadWeights(20)
+	require.NoError(t, err)
+	require.Empty(t, weights)
+
+	weightsRoot, err = RollbackToSlot(s.store, 5)
+	require.NoError(t, err)
+	require.Equal(t, iotago.Identifier(epoch0Root), weightsRoot)
+
+	restoredWeights, err := account.NewAccounts[iotago.AccountID](lo.PanicOnErr(s.store.WithExtendedRealm([]byte{PrefixWeights}))).Map()
+	require.NoError(t, err)
+	require.Equal(t, map[iotago.AccountID]int64{{1}: 10, {2}: 20}, restoredWeights)
+
+	weights, err = s.loadWeights(10)
+	require.NoError(t, err)
+	require.Empty(t, weights)
+
+	_, err = RollbackToSlot(mapdb.NewMapDB(), 5)
+	require.Error(t, err)
+}
@@ -1,6 +1,8 @@
 package sybilprotection
 
 import (
+	"io"
+
 	"github.com/iotaledger/hive.go/core/account"
 	"github.com/iotaledger/hive.go/runtime/module"
 	iotago "github.com/iotaledger/iota.go/v4"
@@ -17,9 +19,18 @@ type SybilProtection interface {
 	// OnlineCommittee returns the set of online validators that is used to track acceptance.
 	OnlineCommittee() *account.SelectedAccounts[iotago.AccountID, *iotago.AccountID]
 
+	// Weights returns the weights of the committee that is active at the given slot.
+	Weights(slot iotago.SlotIndex) (map[iotago.AccountID]int64, error)
+
 	// LastCommittedSlot returns the last committed slot.
 	LastCommittedSlot() iotago.SlotIndex
 
+	// Import imports the weights of the committee from the given reader.
+	Import(reader io.ReadSeeker) error
+
+	// Export exports the weights of the committee that is active at the given slot to the given writer.
+	Export(writer io.WriteSeeker, targetSlot iotago.SlotIndex) error
+
 	// Interface embeds the required methods of the module.Interface.
 	module.Interface
 }
@@ -0,0 +1,63 @@
+package sybilprotection
+
+import (
+	"bytes"
+	"io"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/serializer/v2/stream"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// WriteWeights writes the given weights to the writer. The weights are sorted by their AccountID so that the same
+// weights always result in the same snapshot.
+func WriteWeights(writer io.WriteSeeker, weights map[iotago.AccountID]int64) error {
+	accountIDs := lo.Keys(weights)
+	sort.Slice(accountIDs, func(i, j int) bool {
+		return bytes.Compare(accountIDs[i][:], accountIDs[j][:]) < 0
+	})
+
+	return stream.WriteCollection(writer, func() (elementsCount uint64, err error) {
+		for _, accountID := range accountIDs {
+			if err = stream.WriteSerializable(writer, accountID, iotago.IdentifierLength); err != nil {
+				return 0, errors.Wrapf(err, "failed to write account ID %s", accountID)
+			}
+
+			if err = stream.Write(writer, weights[accountID]); err != nil {
+				return 0, errors.Wrapf(err, "failed to write weight of account %s", accountID)
+			}
+
+			elementsCount++
+		}
+
+		return elementsCount, nil
+	})
+}
+
+// ReadWeights reads the weights that were written by WriteWeights from the given reader.
+func ReadWeights(reader io.ReadSeeker) (weights map[iotago.AccountID]int64, err error) {
+	weights = make(map[iotago.AccountID]int64)
+
+	if err = stream.ReadCollection(reader, func(i int) error {
+		var accountID iotago.AccountID
+		if err = stream.ReadSerializable(reader, &accountID, iotago.IdentifierLength); err != nil {
+			return errors.Wrapf(err, "failed to read account ID %d", i)
+		}
+
+		weight, err := stream.Read[int64](reader)
+		if err != nil {
+			return errors.Wrapf(err, "failed to read weight of account %s", accountID)
+		}
+
+		weights[accountID] = weight
+
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to read weights")
+	}
+
+	return weights, nil
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blockdag"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/booker"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/clock"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/congestioncontrol/scheduler"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/consensus/blockgadget"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/consensus/slotgadget"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/filter"
@@ -47,6 +48,7 @@ type EngineManager struct {
 	filterProvider          module.Provider[*engine.Engine, filter.Filter]
 	blockDAGProvider        module.Provider[*engine.Engine, blockdag.BlockDAG]
 	bookerProvider          module.Provider[*engine.Engine, booker.Booker]
+	schedulerProvider       module.Provider[*engine.Engine, scheduler.Scheduler]
 	clockProvider           module.Provider[*engine.Engine, clock.Clock]
 	sybilProtectionProvider module.Provider[*engine.Engine, sybilprotection.SybilProtection]
 	blockGadgetProvider     module.Provider[*engine.Engine, blockgadget.Gadget]
@@ -67,6 +69,7 @@ func New(
 	filterProvider module.Provider[*engine.Engine, filter.Filter],
 	blockDAGProvider module.Provider[*engine.Engine, blockdag.BlockDAG],
 	bookerProvider module.Provider[*engine.Engine, booker.Booker],
+	schedulerProvider module.Provider[*engine.Engine, scheduler.Scheduler],
 	clockProvider module.Provider[*engine.Engine, clock.Clock],
 	sybilProtectionProvider module.Provider[*engine.Engine, sybilprotection.SybilProtection],
 	blockGadgetProvider module.Provider[*engine.Engine, blockgadget.Gadget],
@@ -84,6 +87,7 @@ func New(
 		filterProvider:          filterProvider,
 		blockDAGProvider:        blockDAGProvider,
 		bookerProvider:          bookerProvider,
+		schedulerProvider:       schedulerProvider,
 		clockProvider:           clockProvider,
 		sybilProtectionProvider: sybilProtectionProvider,
 		blockGadgetProvider:     blockGadgetProvider,
@@ -168,6 +172,7 @@ func (e *EngineManager) loadEngineInstance(dirName string) *engine.Engine {
 		e.filterProvider,
 		e.blockDAGProvider,
 		e.bookerProvider,
+		e.schedulerProvider,
 		e.clockProvider,
 		e.sybilProtectionProvider,
 		e.blockGadgetProvider,
@@ -0,0 +1,79 @@
+package enginemanager
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	hivedb "github.com/iotaledger/hive.go/kvstore/database"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/blockdag/inmemoryblockdag"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/booker/inmemorybooker"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/clock/blocktime"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/congestioncontrol/scheduler/drr"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/consensus/blockgadget/thresholdblockgadget"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/consensus/slotgadget/totalweightslotgadget"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/filter/blockfilter"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledger/utxoledger"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/notarization/slotnotarization"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/sybilprotection/poa"
+	"github.com/iotaledger/iota-core/pkg/storage"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestEngineManager_LoadActiveEngine(t *testing.T) {
+	dir := t.TempDir()
+
+	newEngineManager := func(workers *workerpool.Group) *EngineManager {
+		return New(
+			workers,
+			func(err error) { require.NoError(t, err) },
+			dir,
+			1,
+			[]options.Option[storage.Storage]{storage.WithDBEngine(hivedb.EngineMapDB)},
+			[]options.Option[engine.Engine]{},
+			blockfilter.NewProvider(),
+			inmemoryblockdag.NewProvider(),
+			inmemorybooker.NewProvider(),
+			drr.NewProvider(),
+			blocktime.NewProvider(),
+			poa.NewProvider(map[iotago.AccountID]int64{}),
+			thresholdblockgadget.NewProvider(),
+			totalweightslotgadget.NewProvider(),
+			slotnotarization.NewProvider(),
+			utxoledger.NewProvider(),
+		)
+	}
+
+	workers := workerpool.NewGroup(t.Name())
+	defer workers.Shutdown()
+
+	engineInstance, err := newEngineManager(workers.CreateGroup("first")).LoadActiveEngine()
+	require.NoError(t, err)
+
+	// all modules of the engine are created using the providers that were passed to the EngineManager.
+	require.NotNil(t, engineInstance.Filter)
+	require.NotNil(t, engineInstance.BlockDAG)
+	require.NotNil(t, engineInstance.Booker)
+	require.NotNil(t, engineInstance.Scheduler)
+	require.NotNil(t, engineInstance.Clock)
+	require.NotNil(t, engineInstance.SybilProtection)
+	require.NotNil(t, engineInstance.BlockGadget)
+	require.NotNil(t, engineInstance.SlotGadget)
+	require.NotNil(t, engineInstance.Notarization)
+	require.NotNil(t, engineInstance.Ledger)
+
+	activeDirectory := filepath.Base(engineInstance.Storage.Directory())
+	engineInstance.Shutdown()
+
+	// the active engine is loaded again after a restart.
+	reloadedInstance, err := newEngineManager(workers.CreateGroup("second")).LoadActiveEngine()
+	require.NoError(t, err)
+	defer reloadedInstance.Shutdown()
+
+	require.Equal(t, activeDirectory, filepath.Base(reloadedInstance.Storage.Directory()))
+	require.NotNil(t, reloadedInstance.Scheduler)
+}
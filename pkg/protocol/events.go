@@ -6,26 +6,33 @@ import (
 	"github.com/iotaledger/iota-core/pkg/protocol/chainmanager"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine"
 	"github.com/iotaledger/iota-core/pkg/protocol/tipmanager"
+	"github.com/iotaledger/iota-core/pkg/protocol/warpsync"
 )
 
 type Events struct {
-	Error *event.Event1[error]
+	Error                    *event.Event1[error]
+	CandidateEngineActivated *event.Event1[*engine.Engine]
+	MainEngineSwitched       *event.Event1[*engine.Engine]
 
 	Network      *core.Events
 	Engine       *engine.Events
 	TipManager   *tipmanager.Events
 	ChainManager *chainmanager.Events
+	WarpSync     *warpsync.Events
 
 	event.Group[Events, *Events]
 }
 
 var NewEvents = event.CreateGroupConstructor(func() (newEvents *Events) {
 	return &Events{
-		Error: event.New1[error](),
+		Error:                    event.New1[error](),
+		CandidateEngineActivated: event.New1[*engine.Engine](),
+		MainEngineSwitched:       event.New1[*engine.Engine](),
 
 		Network:      core.NewEvents(),
 		Engine:       engine.NewEvents(),
 		TipManager:   tipmanager.NewEvents(),
 		ChainManager: chainmanager.NewEvents(),
+		WarpSync:     warpsync.NewEvents(),
 	}
 })
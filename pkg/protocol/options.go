@@ -8,6 +8,7 @@ import (
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blockdag"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/booker"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/clock"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/congestioncontrol/scheduler"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/consensus/blockgadget"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/consensus/slotgadget"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/filter"
@@ -15,7 +16,9 @@ import (
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/notarization"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/sybilprotection"
 	"github.com/iotaledger/iota-core/pkg/protocol/tipmanager"
+	"github.com/iotaledger/iota-core/pkg/protocol/warpsync"
 	"github.com/iotaledger/iota-core/pkg/storage"
+	"github.com/iotaledger/iota-core/pkg/storage/permanent"
 	iotago "github.com/iotaledger/iota.go/v4"
 )
 
@@ -61,6 +64,12 @@ func WithBookerProvider(optsBookerProvider module.Provider[*engine.Engine, booke
 	}
 }
 
+func WithSchedulerProvider(optsSchedulerProvider module.Provider[*engine.Engine, scheduler.Scheduler]) options.Option[Protocol] {
+	return func(n *Protocol) {
+		n.optsSchedulerProvider = optsSchedulerProvider
+	}
+}
+
 func WithClockProvider(optsClockProvider module.Provider[*engine.Engine, clock.Clock]) options.Option[Protocol] {
 	return func(n *Protocol) {
 		n.optsClockProvider = optsClockProvider
@@ -97,6 +106,13 @@ func WithLedgerProvider(optsLedgerProvider module.Provider[*engine.Engine, ledge
 	}
 }
 
+// WithProtocolUpgrades schedules the given protocol upgrades when the protocol is started.
+func WithProtocolUpgrades(upgrades ...permanent.ProtocolUpgrade) options.Option[Protocol] {
+	return func(p *Protocol) {
+		p.optsProtocolUpgrades = append(p.optsProtocolUpgrades, upgrades...)
+	}
+}
+
 func WithEngineOptions(opts ...options.Option[engine.Engine]) options.Option[Protocol] {
 	return func(p *Protocol) {
 		p.optsEngineOptions = append(p.optsEngineOptions, opts...)
@@ -109,6 +125,12 @@ func WithChainManagerOptions(opts ...options.Option[chainmanager.Manager]) optio
 	}
 }
 
+func WithWarpSyncManagerOptions(opts ...options.Option[warpsync.Manager]) options.Option[Protocol] {
+	return func(p *Protocol) {
+		p.optsWarpSyncManagerOptions = append(p.optsWarpSyncManagerOptions, opts...)
+	}
+}
+
 func WithStorageOptions(opts ...options.Option[storage.Storage]) options.Option[Protocol] {
 	return func(p *Protocol) {
 		p.optsStorageOptions = append(p.optsStorageOptions, opts...)
@@ -2,9 +2,13 @@ package protocol
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/pkg/errors"
 
+	"github.com/iotaledger/hive.go/ads"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/hive.go/runtime/event"
 	"github.com/iotaledger/hive.go/runtime/module"
 	"github.com/iotaledger/hive.go/runtime/options"
@@ -21,6 +25,8 @@ import (
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/booker/inmemorybooker"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/clock"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/clock/blocktime"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/congestioncontrol/scheduler"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/congestioncontrol/scheduler/drr"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/consensus/blockgadget"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/consensus/blockgadget/thresholdblockgadget"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/consensus/slotgadget"
@@ -37,8 +43,10 @@ import (
 	"github.com/iotaledger/iota-core/pkg/protocol/syncmanager"
 	"github.com/iotaledger/iota-core/pkg/protocol/syncmanager/trivialsyncmanager"
 	"github.com/iotaledger/iota-core/pkg/protocol/tipmanager"
-	"github.com/iotaledger/iota-core/pkg/protocol/tipmanager/trivialtipmanager"
+	"github.com/iotaledger/iota-core/pkg/protocol/tipmanager/conflicttipmanager"
+	"github.com/iotaledger/iota-core/pkg/protocol/warpsync"
 	"github.com/iotaledger/iota-core/pkg/storage"
+	"github.com/iotaledger/iota-core/pkg/storage/permanent"
 	iotago "github.com/iotaledger/iota.go/v4"
 )
 
@@ -49,24 +57,35 @@ type Protocol struct {
 	engineManager *enginemanager.EngineManager
 	ChainManager  *chainmanager.Manager
 
+	WarpSyncManager *warpsync.Manager
+
 	Workers         *workerpool.Group
 	dispatcher      network.Endpoint
 	networkProtocol *core.Protocol
 
-	mainEngine *engine.Engine
+	activeEngineMutex sync.RWMutex
+	mainEngine        *engine.Engine
+	candidateEngine   *engine.Engine
+	candidateWarpSync *warpsync.Manager
+	// candidateUnhook removes the hooks of the candidate engine once it is either switched to or replaced.
+	candidateUnhook func()
 
 	optsBaseDirectory string
 	optsSnapshotPath  string
 	optsPruningDelay  iotago.SlotIndex
 
-	optsEngineOptions       []options.Option[engine.Engine]
-	optsChainManagerOptions []options.Option[chainmanager.Manager]
-	optsStorageOptions      []options.Option[storage.Storage]
+	optsProtocolUpgrades []permanent.ProtocolUpgrade
+
+	optsEngineOptions          []options.Option[engine.Engine]
+	optsChainManagerOptions    []options.Option[chainmanager.Manager]
+	optsWarpSyncManagerOptions []options.Option[warpsync.Manager]
+	optsStorageOptions         []options.Option[storage.Storage]
 
 	optsFilterProvider          module.Provider[*engine.Engine, filter.Filter]
 	optsBlockDAGProvider        module.Provider[*engine.Engine, blockdag.BlockDAG]
 	optsTipManagerProvider      module.Provider[*engine.Engine, tipmanager.TipManager]
 	optsBookerProvider          module.Provider[*engine.Engine, booker.Booker]
+	optsSchedulerProvider       module.Provider[*engine.Engine, scheduler.Scheduler]
 	optsClockProvider           module.Provider[*engine.Engine, clock.Clock]
 	optsSybilProtectionProvider module.Provider[*engine.Engine, sybilprotection.SybilProtection]
 	optsBlockGadgetProvider     module.Provider[*engine.Engine, blockgadget.Gadget]
@@ -83,8 +102,9 @@ func New(workers *workerpool.Group, dispatcher network.Endpoint, opts ...options
 		dispatcher:                  dispatcher,
 		optsFilterProvider:          blockfilter.NewProvider(),
 		optsBlockDAGProvider:        inmemoryblockdag.NewProvider(),
-		optsTipManagerProvider:      trivialtipmanager.NewProvider(),
+		optsTipManagerProvider:      conflicttipmanager.NewProvider(),
 		optsBookerProvider:          inmemorybooker.NewProvider(),
+		optsSchedulerProvider:       drr.NewProvider(),
 		optsClockProvider:           blocktime.NewProvider(),
 		optsSybilProtectionProvider: poa.NewProvider(map[iotago.AccountID]int64{}),
 		optsBlockGadgetProvider:     thresholdblockgadget.NewProvider(),
@@ -98,20 +118,24 @@ func New(workers *workerpool.Group, dispatcher network.Endpoint, opts ...options
 	}, opts,
 		(*Protocol).initEngineManager,
 		(*Protocol).initChainManager,
+		(*Protocol).initWarpSyncManager,
 	)
 }
 
 // Run runs the protocol.
 func (p *Protocol) Run() {
-	p.Events.Engine.LinkTo(p.mainEngine.Events)
-	p.TipManager = p.optsTipManagerProvider(p.mainEngine)
-	p.Events.TipManager.LinkTo(p.TipManager.Events())
-	p.SyncManager = p.optsSyncManagerProvider(p.mainEngine)
+	p.linkToEngine(p.mainEngine)
 
 	if err := p.mainEngine.Initialize(p.optsSnapshotPath); err != nil {
 		panic(err)
 	}
 
+	for _, upgrade := range p.optsProtocolUpgrades {
+		if err := p.mainEngine.Storage.Settings().ScheduleProtocolUpgrade(upgrade); err != nil {
+			p.ErrorHandler()(errors.Wrapf(err, "failed to schedule protocol upgrade for epoch %d", upgrade.StartEpoch))
+		}
+	}
+
 	rootCommitment := p.mainEngine.EarliestRootCommitment()
 
 	// The root commitment is the earliest commitment we will ever need to know to solidify commitment chains, we can
@@ -128,7 +152,6 @@ func (p *Protocol) Run() {
 		}
 	}
 
-	// p.linkTo(p.mainrEngine) -> CC and TipManager
 	p.runNetworkProtocol()
 }
 
@@ -137,9 +160,18 @@ func (p *Protocol) Shutdown() {
 		p.networkProtocol.Shutdown()
 	}
 
-	p.Workers.Shutdown()
+	p.activeEngineMutex.RLock()
 	p.mainEngine.Shutdown()
+	if p.candidateEngine != nil {
+		p.candidateWarpSync.Shutdown()
+		p.candidateEngine.Shutdown()
+	}
+	p.activeEngineMutex.RUnlock()
+
+	p.Workers.Shutdown()
+
 	p.ChainManager.Shutdown()
+	p.WarpSyncManager.Shutdown()
 	p.TipManager.Shutdown()
 	p.SyncManager.Shutdown()
 }
@@ -186,6 +218,38 @@ func (p *Protocol) runNetworkProtocol() {
 	p.Events.ChainManager.RequestCommitment.Hook(func(commitmentID iotago.CommitmentID) {
 		p.networkProtocol.RequestCommitment(commitmentID)
 	}, event.WithWorkerPool(wpCommitments))
+
+	wpAttestations := p.Workers.CreatePool("NetworkEvents.Attestations", 1) // Using just 1 worker to avoid contention
+
+	p.Events.Network.AttestationsRequestReceived.Hook(func(event *core.AttestationsRequestReceivedEvent) {
+		p.ProcessAttestationsRequest(event.Commitment, event.EndIndex, event.Source)
+	}, event.WithWorkerPool(wpAttestations))
+
+	p.Events.Network.AttestationsReceived.Hook(func(event *core.AttestationsReceivedEvent) {
+		p.ProcessAttestations(event.ForkingPoint, event.BlockIDs, event.Attestations, event.Source)
+	}, event.WithWorkerPool(wpAttestations))
+
+	wpWarpSync := p.Workers.CreatePool("NetworkEvents.WarpSync")
+
+	p.Events.Network.WarpSyncRequestReceived.Hook(func(commitmentID iotago.CommitmentID, source network.PeerID) {
+		p.ProcessWarpSyncRequest(commitmentID, source)
+	}, event.WithWorkerPool(wpWarpSync))
+
+	p.Events.Network.WarpSyncResponseReceived.Hook(func(commitmentID iotago.CommitmentID, blockIDs iotago.BlockIDs, roots *iotago.Roots, source network.PeerID) {
+		if err := p.WarpSyncManager.ProcessResponse(commitmentID, blockIDs, roots); err != nil {
+			p.ErrorHandler()(errors.Wrapf(err, "failed to process warp sync response from %s", source))
+		}
+
+		if candidateWarpSync := p.candidateWarpSyncManager(); candidateWarpSync != nil {
+			if err := candidateWarpSync.ProcessResponse(commitmentID, blockIDs, roots); err != nil {
+				p.ErrorHandler()(errors.Wrapf(err, "failed to process warp sync response of candidate engine from %s", source))
+			}
+		}
+	}, event.WithWorkerPool(wpWarpSync))
+
+	p.Events.WarpSync.RequestSlot.Hook(func(commitmentID iotago.CommitmentID) {
+		p.networkProtocol.RequestWarpSync(commitmentID)
+	}, event.WithWorkerPool(wpWarpSync))
 }
 
 func (p *Protocol) initEngineManager() {
@@ -199,6 +263,7 @@ func (p *Protocol) initEngineManager() {
 		p.optsFilterProvider,
 		p.optsBlockDAGProvider,
 		p.optsBookerProvider,
+		p.optsSchedulerProvider,
 		p.optsClockProvider,
 		p.optsSybilProtectionProvider,
 		p.optsBlockGadgetProvider,
@@ -250,6 +315,55 @@ func (p *Protocol) initChainManager() {
 	p.Events.ChainManager.ForkDetected.Hook(p.onForkDetected, event.WithWorkerPool(wp))
 }
 
+func (p *Protocol) initWarpSyncManager() {
+	p.WarpSyncManager = warpsync.NewManager(p.optsWarpSyncManagerOptions...)
+	p.Events.WarpSync.LinkTo(p.WarpSyncManager.Events)
+
+	wp := p.Workers.CreatePool("WarpSync", 1) // Using just 1 worker to avoid contention
+
+	p.Events.Engine.Notarization.SlotCommitted.Hook(func(details *notarization.SlotCommittedDetails) {
+		p.WarpSyncManager.EvictUntil(details.Commitment.Index())
+
+		// Continue with the next slots of our chain (if we are still behind it).
+		if chain := p.ChainManager.Chain(details.Commitment.ID()); chain != nil {
+			p.WarpSyncManager.WarpSync(details.Commitment.Index(), chain)
+		}
+	}, event.WithWorkerPool(wp))
+
+	p.Events.WarpSync.SlotVerified.Hook(func(commitment *model.Commitment, blockIDs iotago.BlockIDs) {
+		p.processVerifiedSlot(p.MainEngineInstance(), commitment, blockIDs)
+	}, event.WithWorkerPool(wp))
+}
+
+// processVerifiedSlot requests the missing blocks of a slot that was verified by warp sync. A slot without blocks can't
+// be committed by accepting its blocks, so the engine commits it right away (once it committed the slot before it).
+func (p *Protocol) processVerifiedSlot(engineInstance *engine.Engine, commitment *model.Commitment, blockIDs iotago.BlockIDs) {
+	if len(blockIDs) == 0 {
+		// The slot is requested again once the engine committed the slots before it.
+		if commitment.Index() > engineInstance.Storage.Settings().LatestCommitment().Index()+1 {
+			return
+		}
+
+		emptySlotCommitment, err := engineInstance.Notarization.ForceCommit(commitment.Index())
+		if err != nil {
+			p.ErrorHandler()(errors.Wrapf(err, "failed to commit empty slot %d", commitment.Index()))
+		} else if emptySlotCommitment.ID() != commitment.ID() {
+			p.ErrorHandler()(errors.Errorf("commitment %s of empty slot does not match commitment %s of the chain", emptySlotCommitment.ID(), commitment.ID()))
+		}
+
+		return
+	}
+
+	missingBlockIDs := make(iotago.BlockIDs, 0, len(blockIDs))
+	for _, blockID := range blockIDs {
+		if _, exists := engineInstance.Block(blockID); !exists {
+			missingBlockIDs = append(missingBlockIDs, blockID)
+		}
+	}
+
+	engineInstance.BlockRequester.StartTickers(missingBlockIDs)
+}
+
 func (p *Protocol) ProcessOwnBlock(block *model.Block) error {
 	return p.ProcessBlock(block, p.dispatcher.LocalPeerID())
 }
@@ -275,19 +389,39 @@ func (p *Protocol) ProcessBlock(block *model.Block, src network.PeerID) error {
 	if mainChain := mainEngine.ChainID(); chain.ForkingPoint.ID() == mainChain || mainEngine.BlockRequester.HasTicker(block.ID()) {
 		mainEngine.ProcessBlockFromPeer(block, src)
 		processed = true
+
+		// If our main chain is far ahead of our engine, we download its committed slots in bulk.
+		if chain.ForkingPoint.ID() == mainChain {
+			p.WarpSyncManager.WarpSync(mainEngine.Storage.Settings().LatestCommitment().Index(), chain)
+		}
 	}
 
-	// if candidateEngine := p.CandidateEngineInstance(); candidateEngine != nil {
-	//	if candidateChain := candidateEngine.Storage.Settings.ChainID(); chain.ForkingPoint.ID() == candidateChain || candidateEngine.BlockRequester.HasTicker(block.ID()) {
-	//		candidateEngine.ProcessBlockFromPeer(block, src)
-	//		if candidateEngine.IsBootstrapped() &&
-	//			candidateEngine.Storage.Settings.LatestCommitment().Index() >= mainEngine.Storage.Settings.LatestCommitment().Index() &&
-	//			candidateEngine.Storage.Settings.LatestCommitment().CumulativeWeight() > mainEngine.Storage.Settings.LatestCommitment().CumulativeWeight() {
-	//			p.switchEngines()
-	//		}
-	//		processed = true
-	//	}
-	// }
+	if candidateEngine := p.CandidateEngineInstance(); candidateEngine != nil {
+		// Blocks that commit to the history that the candidate chain shares with our main chain belong to both chains.
+		candidateChain := candidateEngine.ChainID()
+		inSharedHistory := chain.ForkingPoint.ID() == mainEngine.ChainID() && block.SlotCommitment().Index() < candidateChain.Index()
+
+		if chain.ForkingPoint.ID() == candidateChain || inSharedHistory || candidateEngine.BlockRequester.HasTicker(block.ID()) {
+			candidateEngine.ProcessBlockFromPeer(block, src)
+
+			// If the candidate chain is far ahead of the candidate engine, we download its committed slots in bulk.
+			if candidateWarpSync := p.candidateWarpSyncManager(); candidateWarpSync != nil && chain.ForkingPoint.ID() == candidateChain {
+				candidateWarpSync.WarpSync(candidateEngine.Storage.Settings().LatestCommitment().Index(), chain)
+			}
+
+			if candidateEngine.IsBootstrapped() &&
+				candidateEngine.Storage.Settings().LatestCommitment().Index() >= mainEngine.Storage.Settings().LatestCommitment().Index() {
+				// We only switch to the candidate chain if the weight it was attested with still outweighs our main chain.
+				if isHeavier, err := p.isHeavierThanMainChain(candidateChain); err != nil {
+					p.ErrorHandler()(errors.Wrapf(err, "failed to compare candidate chain %s with the main chain", candidateChain))
+				} else if isHeavier {
+					p.switchEngines()
+				}
+			}
+
+			processed = true
+		}
+	}
 
 	if !processed {
 		return errors.Errorf("block from source %s was not processed: %s; commits to: %s", src, block.ID(), block.Block().SlotCommitment.MustID())
@@ -297,9 +431,27 @@ func (p *Protocol) ProcessBlock(block *model.Block, src network.PeerID) error {
 }
 
 func (p *Protocol) MainEngineInstance() *engine.Engine {
+	p.activeEngineMutex.RLock()
+	defer p.activeEngineMutex.RUnlock()
+
 	return p.mainEngine
 }
 
+func (p *Protocol) CandidateEngineInstance() *engine.Engine {
+	p.activeEngineMutex.RLock()
+	defer p.activeEngineMutex.RUnlock()
+
+	return p.candidateEngine
+}
+
+// candidateWarpSyncManager returns the warp sync manager of the candidate engine (or nil if there is none).
+func (p *Protocol) candidateWarpSyncManager() *warpsync.Manager {
+	p.activeEngineMutex.RLock()
+	defer p.activeEngineMutex.RUnlock()
+
+	return p.candidateWarpSync
+}
+
 func (p *Protocol) Network() *core.Protocol {
 	return p.networkProtocol
 }
@@ -312,12 +464,459 @@ func (p *Protocol) SupportedVersions() Versions {
 	return SupportedVersions
 }
 
+// NextPendingSupported tells whether the node supports the currently active protocol version and the version of the
+// next scheduled protocol upgrade.
+func (p *Protocol) NextPendingSupported() bool {
+	settings := p.MainEngineInstance().Storage.Settings()
+	if !SupportedVersions.Supports(settings.ProtocolParameters().Version) {
+		return false
+	}
+
+	if upgrade, exists := settings.NextProtocolUpgrade(); exists {
+		return SupportedVersions.Supports(upgrade.Parameters.Version)
+	}
+
+	return true
+}
+
 func (p *Protocol) ErrorHandler() func(error) {
 	return func(err error) {
 		p.Events.Error.Trigger(err)
 	}
 }
 
+func (p *Protocol) linkToEngine(engineInstance *engine.Engine) {
+	p.Events.Engine.LinkTo(engineInstance.Events)
+
+	p.TipManager = p.optsTipManagerProvider(engineInstance)
+	p.Events.TipManager.LinkTo(p.TipManager.Events())
+
+	p.SyncManager = p.optsSyncManagerProvider(engineInstance)
+}
+
 func (p *Protocol) onForkDetected(fork *chainmanager.Fork) {
-	fmt.Printf("================================================================\nFork detected: %s\n================================================================\n", fork)
+	if candidateEngine := p.CandidateEngineInstance(); candidateEngine != nil && candidateEngine.ChainID() == fork.ForkingPoint.ID() {
+		// We are already following the chain of this forking point.
+		return
+	}
+
+	// The weight claimed by the fork is only used as a first filter, the actual fork choice is based on the weight
+	// that we verify through the attestations of the fork.
+	mainChainCommitment, err := p.mainChainCommitment(fork.Commitment.Index())
+	if err != nil {
+		p.ErrorHandler()(err)
+		return
+	}
+
+	if fork.Commitment.CumulativeWeight() <= mainChainCommitment.CumulativeWeight() {
+		return
+	}
+
+	p.networkProtocol.RequestAttestations(fork.ForkingPoint, fork.Commitment.Index(), fork.Source)
+}
+
+// ProcessWarpSyncRequest answers a request for the block IDs of a slot that we committed to with the given commitment.
+func (p *Protocol) ProcessWarpSyncRequest(commitmentID iotago.CommitmentID, src network.PeerID) {
+	mainEngine := p.MainEngineInstance()
+
+	// We can only answer for commitments that we created ourselves (and did not prune yet).
+	commitment, err := mainEngine.Storage.Commitments().Load(commitmentID.Index())
+	if err != nil || commitment.ID() != commitmentID {
+		return
+	}
+
+	rootsStorage := mainEngine.Storage.Roots(commitmentID.Index())
+	ratifiedAcceptedBlocksStorage := mainEngine.Storage.RatifiedAcceptedBlocks(commitmentID.Index())
+	if rootsStorage == nil || ratifiedAcceptedBlocksStorage == nil {
+		return
+	}
+
+	roots, err := rootsStorage.Load()
+	if err != nil {
+		p.ErrorHandler()(errors.Wrapf(err, "failed to load roots for slot %d", commitmentID.Index()))
+		return
+	}
+
+	// We answer with the blocks that the TangleRoot commits to, as the block storage also contains the blocks that were
+	// accepted after the slot was committed.
+	blockIDs := make(iotago.BlockIDs, 0)
+	if err = ads.NewSet[iotago.BlockID](ratifiedAcceptedBlocksStorage).Stream(func(blockID iotago.BlockID) bool {
+		blockIDs = append(blockIDs, blockID)
+		return true
+	}); err != nil {
+		p.ErrorHandler()(errors.Wrapf(err, "failed to load block IDs for slot %d", commitmentID.Index()))
+		return
+	}
+
+	p.networkProtocol.SendWarpSyncResponse(commitmentID, blockIDs, roots, src)
+}
+
+// ProcessAttestationsRequest answers a request for the attestations of our chain starting at the given forking point.
+func (p *Protocol) ProcessAttestationsRequest(forkingPoint *iotago.Commitment, endIndex iotago.SlotIndex, src network.PeerID) {
+	mainEngine := p.MainEngineInstance()
+
+	if endIndex < forkingPoint.Index || endIndex > mainEngine.Storage.Settings().LatestCommitment().Index() {
+		return
+	}
+
+	// We can only provide attestations if the forking point is part of our chain.
+	ownForkingPoint, err := mainEngine.Storage.Commitments().Load(forkingPoint.Index)
+	if err != nil || ownForkingPoint.ID() != forkingPoint.MustID() {
+		return
+	}
+
+	blockIDs := make(iotago.BlockIDs, 0)
+	slotsAttestations := make([]*core.SlotAttestations, 0, endIndex-forkingPoint.Index+1)
+
+	for index := forkingPoint.Index; index <= endIndex; index++ {
+		rootsStorage := mainEngine.Storage.Roots(index)
+		if rootsStorage == nil {
+			p.ErrorHandler()(errors.Errorf("failed to access roots storage for slot %d", index))
+			return
+		}
+
+		roots, err := rootsStorage.Load()
+		if err != nil {
+			p.ErrorHandler()(errors.Wrapf(err, "failed to load roots for slot %d", index))
+			return
+		}
+
+		attestations, err := mainEngine.Notarization.Attestations().Get(index)
+		if err != nil {
+			p.ErrorHandler()(errors.Wrapf(err, "failed to load attestations for slot %d", index))
+			return
+		}
+
+		slotAttestations := &core.SlotAttestations{
+			Index: index,
+			Roots: roots,
+		}
+
+		var innerErr error
+		if err = attestations.Stream(func(_ iotago.AccountID, attestation *iotago.Attestation) bool {
+			blockID, blockIDErr := model.AttestationBlockID(attestation, mainEngine.API())
+			if blockIDErr != nil {
+				innerErr = errors.Wrapf(blockIDErr, "failed to compute block ID of attestation for slot %d", index)
+				return false
+			}
+
+			slotAttestations.Attestations = append(slotAttestations.Attestations, attestation)
+			blockIDs = append(blockIDs, blockID)
+
+			return true
+		}); err != nil {
+			p.ErrorHandler()(errors.Wrapf(err, "failed to stream attestations for slot %d", index))
+			return
+		} else if innerErr != nil {
+			p.ErrorHandler()(innerErr)
+			return
+		}
+
+		slotsAttestations = append(slotsAttestations, slotAttestations)
+	}
+
+	// The receiver only accepts block IDs that are sorted and free of duplicates (the attestation of an issuer can be
+	// part of several slots).
+	p.networkProtocol.SendAttestations(ownForkingPoint, blockIDs.RemoveDupsAndSort(), slotsAttestations, src)
+}
+
+// ProcessAttestations verifies the attestations of a fork and switches to the fork if its verified weight is higher
+// than the weight of our main chain.
+func (p *Protocol) ProcessAttestations(forkingPoint *model.Commitment, blockIDs iotago.BlockIDs, attestations []*core.SlotAttestations, src network.PeerID) {
+	fork, exists := p.ChainManager.ForkByForkingPoint(forkingPoint.ID())
+	if !exists {
+		p.ErrorHandler()(errors.Errorf("failed to find fork for forking point %s received from %s", forkingPoint.ID(), src))
+		return
+	}
+
+	mainEngine := p.MainEngineInstance()
+
+	// The cumulative weight of the fork builds on top of the last commitment that it shares with our main chain.
+	sharedCommitment, err := mainEngine.Storage.Commitments().Load(forkingPoint.Index() - 1)
+	if err != nil {
+		p.ErrorHandler()(errors.Wrapf(err, "failed to load commitment before forking point %s", forkingPoint.ID()))
+		return
+	}
+
+	if sharedCommitment.ID() != forkingPoint.PrevID() {
+		p.ErrorHandler()(errors.Errorf("forking point %s received from %s does not build on top of our main chain", forkingPoint.ID(), src))
+		return
+	}
+
+	// The commitments are returned from the head of the fork down to the forking point.
+	chainCommitments, err := p.ChainManager.Commitments(fork.Commitment.ID(), int(fork.Commitment.Index()-forkingPoint.Index())+1)
+	if err != nil {
+		p.ErrorHandler()(errors.Wrapf(err, "failed to load commitments of fork %s", fork.Commitment.ID()))
+		return
+	}
+
+	if len(attestations) != len(chainCommitments) {
+		p.ErrorHandler()(errors.Errorf("received attestations for %d slots from %s, expected %d", len(attestations), src, len(chainCommitments)))
+		return
+	}
+
+	// The attestations are weighed with the committee that was active at the forking point, which both chains agree on.
+	committee, err := mainEngine.SybilProtection.Weights(forkingPoint.Index())
+	if err != nil {
+		p.ErrorHandler()(errors.Wrapf(err, "failed to load committee at forking point %s", forkingPoint.ID()))
+		return
+	}
+
+	forkCommitmentIDs := make(map[iotago.SlotIndex]iotago.CommitmentID, len(chainCommitments))
+	for _, chainCommitment := range chainCommitments {
+		forkCommitmentIDs[chainCommitment.Commitment().Index()] = chainCommitment.ID()
+	}
+
+	// forkCommitmentID returns the ID of the commitment of the fork at the given slot (slots before the forking point
+	// are shared with our main chain).
+	forkCommitmentID := func(index iotago.SlotIndex) (iotago.CommitmentID, error) {
+		if index >= forkingPoint.Index() {
+			if commitmentID, exists := forkCommitmentIDs[index]; exists {
+				return commitmentID, nil
+			}
+
+			return iotago.CommitmentID{}, errors.Errorf("slot %d is not part of the fork", index)
+		}
+
+		commitment, loadErr := mainEngine.Storage.Commitments().Load(index)
+		if loadErr != nil {
+			return iotago.CommitmentID{}, errors.Wrapf(loadErr, "failed to load commitment of slot %d", index)
+		}
+
+		return commitment.ID(), nil
+	}
+
+	attestedWeight := sharedCommitment.CumulativeWeight()
+	for i, slotAttestations := range attestations {
+		weight, verifyErr := p.verifySlotAttestations(mainEngine, chainCommitments[len(chainCommitments)-1-i].Commitment(), slotAttestations, committee, forkCommitmentID)
+		if verifyErr != nil {
+			p.ErrorHandler()(errors.Wrapf(verifyErr, "failed to verify attestations received from %s", src))
+			return
+		}
+
+		attestedWeight += weight
+	}
+
+	if err = p.ChainManager.SetAttestedWeight(fork.Commitment.ID(), attestedWeight); err != nil {
+		p.ErrorHandler()(errors.Wrapf(err, "failed to set attested weight of fork %s", fork.Commitment.ID()))
+		return
+	}
+
+	isHeavier, err := p.isHeavierThanMainChain(fork.ForkingPoint.ID())
+	if err != nil {
+		p.ErrorHandler()(err)
+		return
+	}
+
+	if !isHeavier {
+		return
+	}
+
+	p.processFork(fork, blockIDs)
+}
+
+// isHeavierThanMainChain returns whether the attested weight of the fork with the given forking point exceeds the
+// cumulative weight of our main chain at the same slot.
+func (p *Protocol) isHeavierThanMainChain(forkingPoint iotago.CommitmentID) (bool, error) {
+	attestedCommitment, err := p.ChainManager.AttestedCommitment(forkingPoint)
+	if err != nil {
+		return false, err
+	}
+
+	mainChainCommitment, err := p.mainChainCommitment(attestedCommitment.Commitment().Index())
+	if err != nil {
+		return false, err
+	}
+
+	return attestedCommitment.AttestedWeight() > mainChainCommitment.CumulativeWeight(), nil
+}
+
+// verifySlotAttestations verifies the given attestations against the commitment of their slot and returns their weight
+// according to the given committee.
+func (p *Protocol) verifySlotAttestations(mainEngine *engine.Engine, commitment *model.Commitment, slotAttestations *core.SlotAttestations, committee map[iotago.AccountID]int64, forkCommitmentID func(iotago.SlotIndex) (iotago.CommitmentID, error)) (weight uint64, err error) {
+	if slotAttestations.Index != commitment.Index() {
+		return 0, errors.Errorf("attestations for slot %d do not belong to commitment %s", slotAttestations.Index, commitment.ID())
+	}
+
+	// The roots (including the ActivityRoot) are committed to by the commitment (see model.RootsID).
+	if slotAttestations.Roots == nil || model.RootsID(slotAttestations.Roots) != commitment.RootsID() {
+		return 0, errors.Errorf("roots of slot %d do not match commitment %s", slotAttestations.Index, commitment.ID())
+	}
+
+	attestationsTree := ads.NewMap[iotago.AccountID, iotago.Attestation](mapdb.NewMapDB())
+	for _, attestation := range slotAttestations.Attestations {
+		if valid, signatureErr := attestation.VerifySignature(); !valid {
+			return 0, errors.Errorf("invalid signature of attestation from %s in slot %d: %v", attestation.IssuerID, slotAttestations.Index, signatureErr)
+		}
+
+		if attestationsTree.Has(attestation.IssuerID) {
+			return 0, errors.Errorf("duplicate attestation from %s in slot %d", attestation.IssuerID, slotAttestations.Index)
+		}
+
+		// An attestation can only attest to the slot if it was issued at or before the slot and commits to the chain
+		// of the fork.
+		attestationSlot := mainEngine.API().SlotTimeProvider().IndexFromTime(attestation.IssuingTime)
+		if attestationSlot > slotAttestations.Index {
+			return 0, errors.Errorf("attestation from %s in slot %d was issued in later slot %d", attestation.IssuerID, slotAttestations.Index, attestationSlot)
+		}
+
+		if attestation.SlotCommitmentID.Index() > attestationSlot {
+			return 0, errors.Errorf("attestation from %s in slot %d commits to future slot %d", attestation.IssuerID, slotAttestations.Index, attestation.SlotCommitmentID.Index())
+		}
+
+		expectedCommitmentID, commitmentErr := forkCommitmentID(attestation.SlotCommitmentID.Index())
+		if commitmentErr != nil {
+			return 0, errors.Wrapf(commitmentErr, "failed to verify commitment of attestation from %s in slot %d", attestation.IssuerID, slotAttestations.Index)
+		} else if expectedCommitmentID != attestation.SlotCommitmentID {
+			return 0, errors.Errorf("attestation from %s in slot %d commits to %s which is not part of the fork", attestation.IssuerID, slotAttestations.Index, attestation.SlotCommitmentID)
+		}
+
+		issuerWeight, isMember := committee[attestation.IssuerID]
+		if !isMember || issuerWeight <= 0 {
+			return 0, errors.Errorf("attestation from %s in slot %d is not issued by a member of the committee", attestation.IssuerID, slotAttestations.Index)
+		}
+
+		attestationsTree.Set(attestation.IssuerID, attestation)
+		weight += uint64(issuerWeight)
+	}
+
+	if iotago.Identifier(attestationsTree.Root()) != slotAttestations.Roots.ActivityRoot {
+		return 0, errors.Errorf("attestations of slot %d do not match the attestations root", slotAttestations.Index)
+	}
+
+	return weight, nil
+}
+
+// mainChainCommitment returns the commitment of our main chain at the given index, or our latest commitment if we did
+// not reach that index yet.
+func (p *Protocol) mainChainCommitment(index iotago.SlotIndex) (*model.Commitment, error) {
+	mainEngine := p.MainEngineInstance()
+
+	latestCommitment := mainEngine.Storage.Settings().LatestCommitment()
+	if index >= latestCommitment.Index() {
+		return latestCommitment, nil
+	}
+
+	commitment, err := mainEngine.Storage.Commitments().Load(index)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load commitment of main chain at index %d", index)
+	}
+
+	return commitment, nil
+}
+
+func (p *Protocol) processFork(fork *chainmanager.Fork, blockIDs iotago.BlockIDs) {
+	if candidateEngine := p.CandidateEngineInstance(); candidateEngine != nil && candidateEngine.ChainID() == fork.ForkingPoint.ID() {
+		// We are already following the chain of this forking point.
+		return
+	}
+
+	candidateEngine, err := p.engineManager.ForkEngineAtSlot(fork.ForkingPoint.Index() - 1)
+	if err != nil {
+		p.ErrorHandler()(errors.Wrapf(err, "failed to fork engine at forking point %s", fork.ForkingPoint.ID()))
+		return
+	}
+
+	// The candidate engine follows the chain identified by the forking point.
+	candidateEngine.SetChainID(fork.ForkingPoint.ID())
+
+	// Forward the block requests of the candidate engine to the network and its commitments to the chain manager
+	// until it either becomes the main engine (and gets linked to the protocol) or is replaced by another candidate.
+	unhookRequestBlocks := candidateEngine.Events.BlockRequester.Tick.Hook(func(blockID iotago.BlockID) {
+		p.networkProtocol.RequestBlock(blockID)
+	}, event.WithWorkerPool(candidateEngine.Workers.CreatePool("CandidateBlockRequester", 2))).Unhook
+
+	unhookProcessCommitment := candidateEngine.Events.Notarization.SlotCommitted.Hook(func(details *notarization.SlotCommittedDetails) {
+		p.ChainManager.ProcessCandidateCommitment(details.Commitment)
+	}, event.WithWorkerPool(candidateEngine.Workers.CreatePool("ProcessCandidateCommitment", 1))).Unhook
+
+	// The candidate engine has its own warp sync manager, as the one of the main engine only requests the slots that
+	// follow the latest commitment of the main engine.
+	candidateWarpSync := warpsync.NewManager(p.optsWarpSyncManagerOptions...)
+	wpCandidateWarpSync := candidateEngine.Workers.CreatePool("CandidateWarpSync", 1) // Using just 1 worker to avoid contention
+
+	candidateWarpSync.Events.RequestSlot.Hook(func(commitmentID iotago.CommitmentID) {
+		p.networkProtocol.RequestWarpSync(commitmentID)
+	}, event.WithWorkerPool(wpCandidateWarpSync))
+
+	candidateWarpSync.Events.SlotVerified.Hook(func(commitment *model.Commitment, blockIDs iotago.BlockIDs) {
+		p.processVerifiedSlot(candidateEngine, commitment, blockIDs)
+	}, event.WithWorkerPool(wpCandidateWarpSync))
+
+	unhookWarpSync := candidateEngine.Events.Notarization.SlotCommitted.Hook(func(details *notarization.SlotCommittedDetails) {
+		candidateWarpSync.EvictUntil(details.Commitment.Index())
+
+		// Continue with the next slots of the candidate chain (if the candidate engine is still behind it).
+		if chain := p.ChainManager.Chain(details.Commitment.ID()); chain != nil {
+			candidateWarpSync.WarpSync(details.Commitment.Index(), chain)
+		}
+	}, event.WithWorkerPool(wpCandidateWarpSync)).Unhook
+
+	// The attested blocks are not necessarily gossiped to us again, so we request them for the candidate engine.
+	candidateEngine.BlockRequester.StartTickers(blockIDs)
+
+	p.activeEngineMutex.Lock()
+	oldCandidateEngine, oldCandidateWarpSync, oldCandidateUnhook := p.candidateEngine, p.candidateWarpSync, p.candidateUnhook
+	p.candidateEngine, p.candidateWarpSync = candidateEngine, candidateWarpSync
+	p.candidateUnhook = lo.Batch(unhookRequestBlocks, unhookProcessCommitment, unhookWarpSync)
+	p.activeEngineMutex.Unlock()
+
+	p.Events.CandidateEngineActivated.Trigger(candidateEngine)
+
+	if oldCandidateEngine != nil {
+		oldCandidateUnhook()
+		oldCandidateWarpSync.Shutdown()
+		oldCandidateEngine.Shutdown()
+		if err := oldCandidateEngine.RemoveFromFilesystem(); err != nil {
+			p.ErrorHandler()(errors.Wrap(err, "failed to remove storage directory of replaced candidate engine"))
+		}
+	}
+}
+
+// switchEngines promotes the candidate engine to be the main engine and discards the old main engine.
+func (p *Protocol) switchEngines() {
+	p.activeEngineMutex.Lock()
+
+	if p.candidateEngine == nil {
+		p.activeEngineMutex.Unlock()
+		return
+	}
+
+	if err := p.engineManager.SetActiveInstance(p.candidateEngine); err != nil {
+		p.activeEngineMutex.Unlock()
+		p.ErrorHandler()(errors.Wrap(err, "failed to set candidate engine as active instance"))
+
+		return
+	}
+
+	oldEngine, oldTipManager, oldSyncManager := p.mainEngine, p.TipManager, p.SyncManager
+	newEngine, candidateWarpSync, candidateUnhook := p.candidateEngine, p.candidateWarpSync, p.candidateUnhook
+
+	// The commitments of the candidate chain become part of the main chain, so the blocks that commit to them have to
+	// be processed by the new main engine.
+	if err := p.ChainManager.SwitchMainChain(newEngine.Storage.Settings().LatestCommitment().ID()); err != nil {
+		p.ErrorHandler()(errors.Wrap(err, "failed to switch main chain of chain manager"))
+	} else {
+		newEngine.SetChainID(p.ChainManager.RootCommitment().Chain().ForkingPoint.ID())
+	}
+
+	p.mainEngine = newEngine
+	p.candidateEngine, p.candidateWarpSync, p.candidateUnhook = nil, nil, nil
+	candidateUnhook()
+	p.linkToEngine(newEngine)
+
+	p.activeEngineMutex.Unlock()
+
+	p.Events.MainEngineSwitched.Trigger(newEngine)
+
+	// The new main engine is synced by the warp sync manager of the protocol from now on.
+	candidateWarpSync.Shutdown()
+
+	// The old engine is shut down first, as its modules keep triggering the events that the other components listen to.
+	oldEngine.Shutdown()
+	oldTipManager.Shutdown()
+	oldSyncManager.Shutdown()
+	if err := oldEngine.RemoveFromFilesystem(); err != nil {
+		p.ErrorHandler()(errors.Wrap(err, "failed to remove storage directory of old main engine"))
+	}
 }
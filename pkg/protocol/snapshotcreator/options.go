@@ -1,11 +1,13 @@
 package snapshotcreator
 
 import (
+	hivedb "github.com/iotaledger/hive.go/kvstore/database"
 	"github.com/iotaledger/hive.go/runtime/module"
 	"github.com/iotaledger/hive.go/runtime/options"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledger"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledger/utxoledger"
+	"github.com/iotaledger/iota-core/pkg/storage/permanent"
 	iotago "github.com/iotaledger/iota.go/v4"
 )
 
@@ -17,12 +19,34 @@ type Options struct {
 	// ProtocolParameters provides the protocol parameters used for the network.
 	ProtocolParameters iotago.ProtocolParameters
 
+	// EpochLength defines the amount of slots per epoch.
+	EpochLength iotago.SlotIndex
+
+	// MaxCommitteeSize defines the maximum amount of validators that are selected to be part of the committee.
+	MaxCommitteeSize uint32
+
+	// MinimumStake defines the minimum amount of funds that a validator needs to hold to be selected for the committee.
+	MinimumStake uint64
+
+	// ProtocolUpgrades defines the protocol upgrades that are scheduled in the snapshot.
+	ProtocolUpgrades []permanent.ProtocolUpgrade
+
 	// RootBlocks define the initial blocks to which new blocks can attach to.
 	RootBlocks map[iotago.BlockID]iotago.CommitmentID
 
 	// GenesisSeed defines the seed used to generate keypair that can spend Genesis outputs.
 	GenesisSeed []byte
 
+	// GenesisOutputs define the outputs that are created in the genesis. If no outputs are defined, the whole token
+	// supply is assigned to a single output that can be spent with the keypair derived from the GenesisSeed.
+	GenesisOutputs []iotago.Output
+
+	// Validators define the accounts that are part of the committee and their weights.
+	Validators map[iotago.AccountID]int64
+
+	// DatabaseEngine defines the database engine of the temporary storage that the snapshot is created from.
+	DatabaseEngine hivedb.Engine
+
 	DataBaseVersion byte
 	LedgerProvider  func() module.Provider[*engine.Engine, ledger.Ledger]
 }
@@ -31,7 +55,12 @@ func NewOptions(opts ...options.Option[Options]) *Options {
 	return options.Apply(&Options{
 		FilePath:           "snapshot.bin",
 		DataBaseVersion:    1,
+		DatabaseEngine:     hivedb.EngineRocksDB,
 		ProtocolParameters: iotago.ProtocolParameters{},
+		EpochLength:        32,
+		MaxCommitteeSize:   32,
+		MinimumStake:       1,
+		Validators:         make(map[iotago.AccountID]int64),
 		LedgerProvider:     utxoledger.NewProvider,
 	}, opts)
 }
@@ -48,6 +77,12 @@ func WithDatabaseVersion(dbVersion byte) options.Option[Options] {
 	}
 }
 
+func WithDatabaseEngine(dbEngine hivedb.Engine) options.Option[Options] {
+	return func(m *Options) {
+		m.DatabaseEngine = dbEngine
+	}
+}
+
 func WithFilePath(filePath string) options.Option[Options] {
 	return func(m *Options) {
 		m.FilePath = filePath
@@ -61,6 +96,34 @@ func WithProtocolParameters(params iotago.ProtocolParameters) options.Option[Opt
 	}
 }
 
+// WithEpochLength defines the amount of slots per epoch.
+func WithEpochLength(epochLength iotago.SlotIndex) options.Option[Options] {
+	return func(m *Options) {
+		m.EpochLength = epochLength
+	}
+}
+
+// WithMaxCommitteeSize defines the maximum amount of validators that are selected to be part of the committee.
+func WithMaxCommitteeSize(maxCommitteeSize uint32) options.Option[Options] {
+	return func(m *Options) {
+		m.MaxCommitteeSize = maxCommitteeSize
+	}
+}
+
+// WithMinimumStake defines the minimum amount of funds that a validator needs to hold to be selected for the committee.
+func WithMinimumStake(minimumStake uint64) options.Option[Options] {
+	return func(m *Options) {
+		m.MinimumStake = minimumStake
+	}
+}
+
+// WithProtocolUpgrades defines the protocol upgrades that are scheduled in the snapshot.
+func WithProtocolUpgrades(upgrades ...permanent.ProtocolUpgrade) options.Option[Options] {
+	return func(m *Options) {
+		m.ProtocolUpgrades = append(m.ProtocolUpgrades, upgrades...)
+	}
+}
+
 // WithRootBlocks define the initial blocks to which new blocks can attach to.
 func WithRootBlocks(rootBlocks map[iotago.BlockID]iotago.CommitmentID) options.Option[Options] {
 	return func(m *Options) {
@@ -74,3 +137,17 @@ func WithGenesisSeed(genesisSeed []byte) options.Option[Options] {
 		m.GenesisSeed = genesisSeed
 	}
 }
+
+// WithGenesisOutputs defines the outputs that are created in the genesis instead of the output of the GenesisSeed.
+func WithGenesisOutputs(outputs ...iotago.Output) options.Option[Options] {
+	return func(m *Options) {
+		m.GenesisOutputs = append(m.GenesisOutputs, outputs...)
+	}
+}
+
+// WithValidators defines the accounts that are part of the committee and their weights.
+func WithValidators(validators map[iotago.AccountID]int64) options.Option[Options] {
+	return func(m *Options) {
+		m.Validators = validators
+	}
+}
@@ -1,6 +1,7 @@
 package snapshotcreator
 
 import (
+	"math"
 	"os"
 
 	"github.com/pkg/errors"
@@ -13,6 +14,7 @@ import (
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blockdag/inmemoryblockdag"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/booker/inmemorybooker"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/clock/blocktime"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/congestioncontrol/scheduler/drr"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/consensus/blockgadget/thresholdblockgadget"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/consensus/slotgadget/totalweightslotgadget"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/filter/blockfilter"
@@ -44,7 +46,7 @@ func CreateSnapshot(opts ...options.Option[Options]) error {
 
 	workers := workerpool.NewGroup("CreateSnapshot")
 	defer workers.Shutdown()
-	s := storage.New(lo.PanicOnErr(os.MkdirTemp(os.TempDir(), "*")), opt.DataBaseVersion, errorHandler)
+	s := storage.New(lo.PanicOnErr(os.MkdirTemp(os.TempDir(), "*")), opt.DataBaseVersion, errorHandler, storage.WithDBEngine(opt.DatabaseEngine))
 	defer s.Shutdown()
 
 	if err := s.Commitments().Store(model.NewEmptyCommitment(api)); err != nil {
@@ -53,6 +55,20 @@ func CreateSnapshot(opts ...options.Option[Options]) error {
 	if err := s.Settings().SetProtocolParameters(opt.ProtocolParameters); err != nil {
 		return errors.Wrap(err, "failed to set the genesis time")
 	}
+	if err := s.Settings().SetEpochLength(opt.EpochLength); err != nil {
+		return errors.Wrap(err, "failed to set the epoch length")
+	}
+	if err := s.Settings().SetMaxCommitteeSize(opt.MaxCommitteeSize); err != nil {
+		return errors.Wrap(err, "failed to set the max committee size")
+	}
+	if err := s.Settings().SetMinimumStake(opt.MinimumStake); err != nil {
+		return errors.Wrap(err, "failed to set the minimum stake")
+	}
+	for _, upgrade := range opt.ProtocolUpgrades {
+		if err := s.Settings().ScheduleProtocolUpgrade(upgrade); err != nil {
+			return errors.Wrapf(err, "failed to schedule protocol upgrade for epoch %d", upgrade.StartEpoch)
+		}
+	}
 
 	engineInstance := engine.New(workers.CreateGroup("Engine"),
 		errorHandler,
@@ -60,8 +76,9 @@ func CreateSnapshot(opts ...options.Option[Options]) error {
 		blockfilter.NewProvider(),
 		inmemoryblockdag.NewProvider(),
 		inmemorybooker.NewProvider(),
+		drr.NewProvider(),
 		blocktime.NewProvider(),
-		poa.NewProvider(map[iotago.AccountID]int64{}),
+		poa.NewProvider(opt.Validators),
 		thresholdblockgadget.NewProvider(),
 		totalweightslotgadget.NewProvider(),
 		slotnotarization.NewProvider(),
@@ -73,7 +90,11 @@ func CreateSnapshot(opts ...options.Option[Options]) error {
 		engineInstance.EvictionState.AddRootBlock(blockID, commitmentID)
 	}
 
-	if err := createGenesisOutput(opt.ProtocolParameters.TokenSupply, opt.GenesisSeed, engineInstance); err != nil {
+	if len(opt.GenesisOutputs) > 0 {
+		if err := createGenesisOutputs(opt.GenesisOutputs, engineInstance); err != nil {
+			return errors.Wrap(err, "failed to create genesis outputs")
+		}
+	} else if err := createGenesisOutput(opt.ProtocolParameters.TokenSupply, opt.GenesisSeed, engineInstance); err != nil {
 		return errors.Wrap(err, "failed to create genesis outputs")
 	}
 
@@ -93,6 +114,27 @@ func createGenesisOutput(genesisTokenAmount uint64, genesisSeed []byte, engineIn
 	return nil
 }
 
+// MaxGenesisOutputs is the maximum amount of outputs that can be created in the genesis, as the outputs are identified
+// by their (uint16) index.
+const MaxGenesisOutputs = math.MaxUint16 + 1
+
+// createGenesisOutputs adds the given outputs to the ledger. The outputs are identified by their position in the genesis.
+func createGenesisOutputs(outputs []iotago.Output, engineInstance *engine.Engine) error {
+	if len(outputs) > MaxGenesisOutputs {
+		return errors.Errorf("the genesis must not contain more than %d outputs, got %d", MaxGenesisOutputs, len(outputs))
+	}
+
+	for index, output := range outputs {
+		outputID := iotago.OutputIDFromTransactionIDAndIndex(iotago.TransactionID{}, uint16(index))
+
+		if err := engineInstance.Ledger.AddUnspentOutput(ledgerstate.CreateOutput(engineInstance.API(), outputID, iotago.EmptyBlockID(), 0, engineInstance.API().SlotTimeProvider().GenesisTime(), output)); err != nil {
+			return errors.Wrapf(err, "failed to add genesis output %s", outputID)
+		}
+	}
+
+	return nil
+}
+
 func createOutput(address iotago.Address, tokenAmount uint64) (output iotago.Output) {
 	//switch ledgerVM.(type) {
 	//case *mockedvm.MockedVM:
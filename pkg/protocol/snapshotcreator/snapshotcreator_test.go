@@ -0,0 +1,48 @@
+package snapshotcreator
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	hivedb "github.com/iotaledger/hive.go/kvstore/database"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func TestCreateSnapshot_GenesisOutputs(t *testing.T) {
+	protocolParameters := iotago.ProtocolParameters{
+		Version:     3,
+		NetworkName: t.Name(),
+		Bech32HRP:   "rms",
+		RentStructure: iotago.RentStructure{
+			VByteCost:    100,
+			VBFactorData: 1,
+			VBFactorKey:  10,
+		},
+		TokenSupply:           1_000_0000,
+		GenesisUnixTimestamp:  uint32(time.Now().Unix()),
+		SlotDurationInSeconds: 10,
+	}
+
+	createSnapshot := func(outputs []iotago.Output) error {
+		return CreateSnapshot(
+			WithDatabaseEngine(hivedb.EngineMapDB),
+			WithFilePath(filepath.Join(t.TempDir(), "snapshot.bin")),
+			WithProtocolParameters(protocolParameters),
+			WithGenesisOutputs(outputs...),
+		)
+	}
+
+	outputs := make([]iotago.Output, 0, MaxGenesisOutputs+1)
+	for len(outputs) < MaxGenesisOutputs+1 {
+		outputs = append(outputs, createOutput(tpkg.RandEd25519Address(), 1_000_000))
+	}
+
+	// the genesis outputs are identified by their uint16 index, so the index of the last output would wrap around.
+	require.ErrorContains(t, createSnapshot(outputs), "must not contain more than")
+
+	require.NoError(t, createSnapshot(outputs[:2]))
+}
@@ -0,0 +1,546 @@
+package conflicttipmanager
+
+import (
+	"sync"
+	"time"
+
+	"github.com/iotaledger/hive.go/core/memstorage"
+	"github.com/iotaledger/hive.go/ds/advancedset"
+	"github.com/iotaledger/hive.go/ds/randommap"
+	"github.com/iotaledger/hive.go/ds/types"
+	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/hive.go/runtime/module"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/booker"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool/conflictdag"
+	"github.com/iotaledger/iota-core/pkg/protocol/tipmanager"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+type (
+	blockRetrieverFunc   func(id iotago.BlockID) (block *blocks.Block, exists bool)
+	latestRootBlocksFunc func() iotago.BlockIDs
+	acceptedTimeFunc     func() time.Time
+	isBootstrappedFunc   func() bool
+
+	conflictDAG           = conflictdag.ConflictDAG[iotago.TransactionID, iotago.OutputID, booker.BlockVotePower]
+	readLockedConflictDAG = conflictdag.ReadLockedConflictDAG[iotago.TransactionID, iotago.OutputID, booker.BlockVotePower]
+)
+
+// region TipManager ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// TipManager is an implementation of the TipManager interface that takes the opinion of the ConflictDAG into account.
+//
+// Blocks that are booked on liked conflicts are selected as strong parents. Strong tips whose conflicts became
+// disliked are corrected with shallow like references to an attachment of the liked conflicts, and transactions that
+// can not be approved strongly anymore are picked up via weak references, so they don't get orphaned.
+type TipManager struct {
+	events *tipmanager.Events
+
+	conflictDAG          conflictDAG
+	workers              *workerpool.Group
+	blockRetrieverFunc   blockRetrieverFunc
+	latestRootBlocksFunc latestRootBlocksFunc
+	acceptedTimeFunc     acceptedTimeFunc
+	isBootstrappedFunc   isBootstrappedFunc
+
+	walkerCache *memstorage.IndexedStorage[iotago.SlotIndex, iotago.BlockID, types.Empty]
+
+	// attachments contains the first seen attachment of every transaction that was added to the TipManager.
+	attachments       map[iotago.TransactionID]*blocks.Block
+	attachmentsBySlot *memstorage.IndexedStorage[iotago.SlotIndex, iotago.TransactionID, types.Empty]
+
+	mutex      sync.RWMutex
+	strongTips *randommap.RandomMap[iotago.BlockID, *blocks.Block]
+	weakTips   *randommap.RandomMap[iotago.BlockID, *blocks.Block]
+
+	optsTimeSinceConfirmationThreshold time.Duration
+
+	module.Module
+}
+
+// NewProvider creates a new TipManager provider.
+func NewProvider(opts ...options.Option[TipManager]) module.Provider[*engine.Engine, tipmanager.TipManager] {
+	return module.Provide(func(e *engine.Engine) tipmanager.TipManager {
+		t := New(e.Workers.CreateGroup("TipManager"), e.Ledger.ConflictDAG(), e.BlockCache.Block, e.EvictionState.LatestRootBlocks, func() time.Time {
+			return e.Clock.Accepted().Time()
+		}, e.IsBootstrapped, opts...)
+
+		e.Events.Scheduler.BlockScheduled.Hook(func(block *blocks.Block) {
+			_ = t.AddTip(block)
+		}, event.WithWorkerPool(t.workers.CreatePool("AddTip", 2)))
+
+		e.Events.Scheduler.BlockSkipped.Hook(t.skip, event.WithWorkerPool(t.workers.CreatePool("SkipTip", 1)))
+
+		e.Events.Orphanage.BlockOrphaned.Hook(t.orphan)
+
+		e.BlockCache.Evict.Hook(t.evict)
+
+		t.TriggerInitialized()
+
+		return t
+	})
+}
+
+// New creates a new TipManager.
+func New(workers *workerpool.Group, conflictDAG conflictDAG, blockRetriever blockRetrieverFunc, latestRootBlocks latestRootBlocksFunc, acceptedTime acceptedTimeFunc, isBootstrapped isBootstrappedFunc, opts ...options.Option[TipManager]) (t *TipManager) {
+	t = options.Apply(&TipManager{
+		events:                             tipmanager.NewEvents(),
+		conflictDAG:                        conflictDAG,
+		workers:                            workers,
+		blockRetrieverFunc:                 blockRetriever,
+		latestRootBlocksFunc:               latestRootBlocks,
+		acceptedTimeFunc:                   acceptedTime,
+		isBootstrappedFunc:                 isBootstrapped,
+		walkerCache:                        memstorage.NewIndexedStorage[iotago.SlotIndex, iotago.BlockID, types.Empty](),
+		attachments:                        make(map[iotago.TransactionID]*blocks.Block),
+		attachmentsBySlot:                  memstorage.NewIndexedStorage[iotago.SlotIndex, iotago.TransactionID, types.Empty](),
+		strongTips:                         randommap.New[iotago.BlockID, *blocks.Block](),
+		weakTips:                           randommap.New[iotago.BlockID, *blocks.Block](),
+		optsTimeSinceConfirmationThreshold: time.Minute,
+	}, opts,
+		(*TipManager).TriggerConstructed,
+	)
+
+	return
+}
+
+// Events returns the events of the TipManager.
+func (t *TipManager) Events() *tipmanager.Events {
+	return t.events
+}
+
+// AddTip adds a Block to the tip pool.
+//
+// Blocks that are booked on disliked conflicts are not added as strong tips. If their payload is still liked, they are
+// added as weak tips instead, so that the contained transaction can still be picked up by the network.
+func (t *TipManager) AddTip(block *blocks.Block) (added bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if block.IsOrphaned() {
+		return false
+	}
+
+	t.trackAttachment(block)
+
+	var conflictsLiked, payloadLiked bool
+	_ = t.conflictDAG.ReadConsistent(func(conflictDAG readLockedConflictDAG) error {
+		conflictsLiked = conflictDAG.LikedInstead(conflictIDs(block)).IsEmpty()
+		payloadLiked = conflictDAG.LikedInstead(payloadConflictIDs(block)).IsEmpty()
+
+		return nil
+	})
+
+	switch {
+	case conflictsLiked:
+		return t.addStrongTip(block)
+	case payloadLiked && !payloadConflictIDs(block).IsEmpty():
+		return t.addWeakTip(block)
+	default:
+		return false
+	}
+}
+
+// RemoveTip removes a tip from the TipManager.
+func (t *TipManager) RemoveTip(blockID iotago.BlockID) (removed bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	block, exists := t.blockRetrieverFunc(blockID)
+	if !exists {
+		return false
+	}
+
+	return t.removeTip(block)
+}
+
+// Tips returns up to count strong parents, together with the weak and shallow like parents that are required to make
+// the resulting block like the preferred conflicts of the ConflictDAG.
+func (t *TipManager) Tips(count int) (references model.ParentReferences) {
+	if count > iotago.BlockMaxParents {
+		count = iotago.BlockMaxParents
+	}
+	if count < 1 {
+		count = 1
+	}
+
+	t.mutex.Lock() // removeTip might get called, so we need a write-lock here
+	defer t.mutex.Unlock()
+
+	references = make(model.ParentReferences)
+	_ = t.conflictDAG.ReadConsistent(func(conflictDAG readLockedConflictDAG) error {
+		selection := newTipSelection(conflictDAG)
+
+		t.selectStrongParents(selection, count)
+		t.selectWeakParents(selection)
+
+		references = selection.References()
+
+		return nil
+	})
+
+	return references
+}
+
+// AllTips returns a list of all tips that are stored in the TipManger.
+func (t *TipManager) AllTips() (allTips []*blocks.Block) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	allTips = make([]*blocks.Block, 0, t.strongTips.Size()+t.weakTips.Size())
+	for _, tips := range []*randommap.RandomMap[iotago.BlockID, *blocks.Block]{t.strongTips, t.weakTips} {
+		tips.ForEach(func(_ iotago.BlockID, value *blocks.Block) bool {
+			allTips = append(allTips, value)
+			return true
+		})
+	}
+
+	return
+}
+
+// TipCount the amount of tips.
+func (t *TipManager) TipCount() int {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.strongTips.Size() + t.weakTips.Size()
+}
+
+func (t *TipManager) Shutdown() {
+	t.workers.Shutdown()
+	t.TriggerStopped()
+}
+
+func (t *TipManager) addStrongTip(block *blocks.Block) (added bool) {
+	if t.strongTips.Has(block.ID()) {
+		return false
+	}
+
+	t.strongTips.Set(block.ID(), block)
+	t.events.TipAdded.Trigger(block)
+
+	// a tip loses its tip status if it is referenced by another block
+	t.removeReferencedTips(block)
+
+	return true
+}
+
+func (t *TipManager) addWeakTip(block *blocks.Block) (added bool) {
+	if t.weakTips.Has(block.ID()) {
+		return false
+	}
+
+	// weak tips do not remove their parents from the tip pool, as a weak reference only includes the payload
+	t.weakTips.Set(block.ID(), block)
+	t.events.TipAdded.Trigger(block)
+
+	return true
+}
+
+func (t *TipManager) removeTip(block *blocks.Block) (deleted bool) {
+	if _, deleted = t.strongTips.Delete(block.ID()); !deleted {
+		_, deleted = t.weakTips.Delete(block.ID())
+	}
+
+	if deleted {
+		t.events.TipRemoved.Trigger(block)
+	}
+
+	return
+}
+
+// removeReferencedTips removes the strong tips that are strongly approved and the weak tips whose payload is
+// included by the given block.
+func (t *TipManager) removeReferencedTips(block *blocks.Block) {
+	if block.IsRootBlock() {
+		return
+	}
+
+	for _, parent := range block.ModelBlock().ParentsWithType() {
+		parentBlock, exists := t.blockRetrieverFunc(parent.ID)
+		if !exists {
+			continue
+		}
+
+		switch parent.Type {
+		case model.StrongParentType:
+			t.removeTip(parentBlock)
+		case model.WeakParentType:
+			if _, deleted := t.weakTips.Delete(parentBlock.ID()); deleted {
+				t.events.TipRemoved.Trigger(parentBlock)
+			}
+		}
+	}
+}
+
+// selectStrongParents selects up to count strong parents and adds the shallow like parents that are necessary to
+// override the disliked conflicts of the selected tips.
+func (t *TipManager) selectStrongParents(selection *tipSelection, count int) {
+	for _, tip := range t.strongTips.RandomUniqueEntries(count) {
+		if err := t.isValidTip(tip); err != nil {
+			t.removeTip(tip)
+
+			continue
+		}
+
+		if shallowLikeParents, liked := t.shallowLikeParents(selection, tip); liked {
+			selection.AddStrongParent(tip, shallowLikeParents...)
+
+			continue
+		}
+
+		// the tip can not be corrected to like the preferred conflicts, so we only keep its payload
+		t.removeTip(tip)
+		if selection.IsPayloadLiked(tip) && !payloadConflictIDs(tip).IsEmpty() {
+			t.addWeakTip(tip)
+		}
+	}
+
+	// We fall back to the latest root blocks if there is no valid tip. They are the anchor of the BlockDAG, so they
+	// are not subject to the TSC check.
+	if len(selection.strongParents) == 0 {
+		for _, blockID := range t.latestRootBlocksFunc() {
+			if block, exists := t.blockRetrieverFunc(blockID); exists {
+				selection.AddStrongParent(block)
+			}
+		}
+	}
+}
+
+// shallowLikeParents returns the shallow like parents that are required to make the given tip like the preferred
+// conflicts and a flag that indicates whether the tip can be used as a strong parent.
+func (t *TipManager) shallowLikeParents(selection *tipSelection, tip *blocks.Block) (shallowLikeParents []*blocks.Block, liked bool) {
+	if !selection.CanAddShallowLikeParents() {
+		return nil, selection.IsLikedWithStrongParent(tip)
+	}
+
+	likedInstead := selection.LikedInstead(tip)
+	for _, likedConflictID := range likedInstead.Slice() {
+		attachment, exists := t.attachments[likedConflictID]
+		if !exists || t.isValidTip(attachment) != nil {
+			return nil, false
+		}
+
+		shallowLikeParents = append(shallowLikeParents, attachment)
+	}
+
+	return shallowLikeParents, selection.IsLikedWithStrongParent(tip, shallowLikeParents...)
+}
+
+// selectWeakParents picks up the weak tips whose transactions are still liked.
+func (t *TipManager) selectWeakParents(selection *tipSelection) {
+	for _, weakTip := range t.weakTips.RandomUniqueEntries(iotago.BlockMaxParents) {
+		if weakTip.IsAccepted() || !selection.IsPayloadLiked(weakTip) || t.isValidTip(weakTip) != nil {
+			t.removeTip(weakTip)
+
+			continue
+		}
+
+		selection.AddWeakParent(weakTip)
+	}
+}
+
+// trackAttachment keeps track of the attachments of transactions so that they can be referenced via shallow likes.
+func (t *TipManager) trackAttachment(block *blocks.Block) {
+	if block.IsRootBlock() {
+		return
+	}
+
+	for _, transactionID := range block.PayloadConflictIDs().Slice() {
+		if _, exists := t.attachments[transactionID]; exists {
+			continue
+		}
+
+		t.attachments[transactionID] = block
+		t.attachmentsBySlot.Get(block.ID().Index(), true).Set(transactionID, types.Void)
+	}
+}
+
+// skip removes the tips that are referenced by the given block, as it was accepted before it was scheduled and
+// therefore never becomes a tip itself.
+func (t *TipManager) skip(block *blocks.Block) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.removeReferencedTips(block)
+}
+
+// orphan removes the given block from the tip pool and forgets about it as an attachment of its transaction, as it
+// can no longer be accepted.
+func (t *TipManager) orphan(block *blocks.Block) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.removeTip(block)
+
+	for _, transactionID := range payloadConflictIDs(block).Slice() {
+		if attachment, exists := t.attachments[transactionID]; exists && attachment.ID() == block.ID() {
+			delete(t.attachments, transactionID)
+		}
+	}
+}
+
+func (t *TipManager) evict(index iotago.SlotIndex) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.walkerCache.Evict(index)
+
+	if evictedAttachments := t.attachmentsBySlot.Evict(index); evictedAttachments != nil {
+		evictedAttachments.ForEachKey(func(transactionID iotago.TransactionID) bool {
+			// the transaction might have been attached again in a later slot after its attachment was orphaned.
+			if attachment, exists := t.attachments[transactionID]; exists && attachment.ID().Index() <= index {
+				delete(t.attachments, transactionID)
+			}
+
+			return true
+		})
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region tipSelection /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// tipSelection keeps track of the parents that were selected for a new block and of the conflicts that the block
+// would inherit from them (following the same rules as the Booker).
+type tipSelection struct {
+	conflictDAG readLockedConflictDAG
+
+	strongParents      iotago.BlockIDs
+	weakParents        iotago.BlockIDs
+	shallowLikeParents iotago.BlockIDs
+	selectedParents    *advancedset.AdvancedSet[iotago.BlockID]
+	conflictIDs        *advancedset.AdvancedSet[iotago.TransactionID]
+}
+
+func newTipSelection(conflictDAG readLockedConflictDAG) *tipSelection {
+	return &tipSelection{
+		conflictDAG:        conflictDAG,
+		strongParents:      make(iotago.BlockIDs, 0),
+		weakParents:        make(iotago.BlockIDs, 0),
+		shallowLikeParents: make(iotago.BlockIDs, 0),
+		selectedParents:    advancedset.New[iotago.BlockID](),
+		conflictIDs:        advancedset.New[iotago.TransactionID](),
+	}
+}
+
+// LikedInstead returns the conflicts that are liked instead of the conflicts of the given block.
+func (s *tipSelection) LikedInstead(block *blocks.Block) *advancedset.AdvancedSet[iotago.TransactionID] {
+	return s.conflictDAG.LikedInstead(conflictIDs(block))
+}
+
+// IsPayloadLiked returns true if the payload of the given block is liked.
+func (s *tipSelection) IsPayloadLiked(block *blocks.Block) bool {
+	return s.conflictDAG.LikedInstead(payloadConflictIDs(block)).IsEmpty()
+}
+
+// CanAddShallowLikeParents returns true if there is still room for further shallow like parents.
+func (s *tipSelection) CanAddShallowLikeParents() bool {
+	return len(s.shallowLikeParents) < iotago.BlockMaxParents
+}
+
+// IsLikedWithStrongParent returns true if the selected parents would still be liked after adding the given strong
+// parent together with its shallow like parents.
+func (s *tipSelection) IsLikedWithStrongParent(strongParent *blocks.Block, shallowLikeParents ...*blocks.Block) bool {
+	if len(s.shallowLikeParents)+len(shallowLikeParents) > iotago.BlockMaxParents {
+		return false
+	}
+
+	return s.conflictDAG.LikedInstead(s.inheritedConflictIDs(strongParent, shallowLikeParents...)).IsEmpty()
+}
+
+// AddStrongParent adds the given strong parent and its shallow like parents to the selection.
+func (s *tipSelection) AddStrongParent(strongParent *blocks.Block, shallowLikeParents ...*blocks.Block) {
+	if !s.selectedParents.Add(strongParent.ID()) {
+		return
+	}
+
+	s.strongParents = append(s.strongParents, strongParent.ID())
+	for _, shallowLikeParent := range shallowLikeParents {
+		if s.selectedParents.Add(shallowLikeParent.ID()) {
+			s.shallowLikeParents = append(s.shallowLikeParents, shallowLikeParent.ID())
+		}
+	}
+
+	s.conflictIDs = s.inheritedConflictIDs(strongParent, shallowLikeParents...)
+}
+
+// AddWeakParent adds the given weak parent to the selection if it doesn't turn the selection into a disliked one.
+func (s *tipSelection) AddWeakParent(weakParent *blocks.Block) (added bool) {
+	if len(s.weakParents) >= iotago.BlockMaxParents || s.selectedParents.Has(weakParent.ID()) {
+		return false
+	}
+
+	conflictIDs := s.conflictIDs.Clone()
+	conflictIDs.AddAll(payloadConflictIDs(weakParent))
+	if !s.conflictDAG.LikedInstead(conflictIDs).IsEmpty() {
+		return false
+	}
+
+	s.selectedParents.Add(weakParent.ID())
+	s.weakParents = append(s.weakParents, weakParent.ID())
+	s.conflictIDs = conflictIDs
+
+	return true
+}
+
+// References returns the selected parents.
+func (s *tipSelection) References() model.ParentReferences {
+	references := model.ParentReferences{
+		model.StrongParentType: s.strongParents,
+	}
+
+	if len(s.weakParents) > 0 {
+		references[model.WeakParentType] = s.weakParents
+	}
+
+	if len(s.shallowLikeParents) > 0 {
+		references[model.ShallowLikeParentType] = s.shallowLikeParents
+	}
+
+	return references
+}
+
+// inheritedConflictIDs returns the conflicts that a block would inherit if the given parents were added.
+func (s *tipSelection) inheritedConflictIDs(strongParent *blocks.Block, shallowLikeParents ...*blocks.Block) *advancedset.AdvancedSet[iotago.TransactionID] {
+	inheritedConflictIDs := s.conflictIDs.Clone()
+	inheritedConflictIDs.AddAll(conflictIDs(strongParent))
+
+	for _, shallowLikeParent := range shallowLikeParents {
+		inheritedConflictIDs.AddAll(payloadConflictIDs(shallowLikeParent))
+
+		for _, conflictID := range payloadConflictIDs(shallowLikeParent).Slice() {
+			if conflictingConflicts, exists := s.conflictDAG.ConflictingConflicts(conflictID); exists {
+				inheritedConflictIDs.DeleteAll(conflictingConflicts)
+			}
+		}
+	}
+
+	return s.conflictDAG.UnacceptedConflicts(inheritedConflictIDs)
+}
+
+// conflictIDs returns the conflicts of the given block (root blocks are not booked on any conflicts).
+func conflictIDs(block *blocks.Block) *advancedset.AdvancedSet[iotago.TransactionID] {
+	if block.IsRootBlock() || block.ConflictIDs() == nil {
+		return advancedset.New[iotago.TransactionID]()
+	}
+
+	return block.ConflictIDs()
+}
+
+// payloadConflictIDs returns the conflicts of the payload of the given block.
+func payloadConflictIDs(block *blocks.Block) *advancedset.AdvancedSet[iotago.TransactionID] {
+	if block.IsRootBlock() || block.PayloadConflictIDs() == nil {
+		return advancedset.New[iotago.TransactionID]()
+	}
+
+	return block.PayloadConflictIDs()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
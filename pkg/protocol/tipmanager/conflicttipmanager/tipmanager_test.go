@@ -0,0 +1,255 @@
+package conflicttipmanager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/core/account"
+	"github.com/iotaledger/hive.go/ds/advancedset"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/iota-core/pkg/core/acceptance"
+	"github.com/iotaledger/iota-core/pkg/core/vote"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/booker"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate/tpkg"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool/conflictdag/conflictdagv1"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestTipManager_ShallowLikeParents(t *testing.T) {
+	tf := newTestFramework(t)
+
+	conflictA, conflictB := tpkg.RandTransactionID(), tpkg.RandTransactionID()
+	tf.CreateConflicts(conflictA, conflictB)
+	tf.Vote(iotago.AccountID{1}, conflictA)
+
+	blockA := tf.NewBlock(ids(conflictA), ids(conflictA), tf.Genesis)
+	blockAChild := tf.NewBlock(ids(conflictA), ids(), blockA)
+	blockB := tf.NewBlock(ids(conflictB), ids(conflictB), tf.Genesis)
+
+	require.True(t, tf.TipManager.AddTip(blockA))
+	require.True(t, tf.TipManager.AddTip(blockAChild))
+
+	// blocks that are booked on disliked conflicts are not added to the tip pool.
+	require.False(t, tf.TipManager.AddTip(blockB))
+	require.Equal(t, []*blocks.Block{blockAChild}, tf.TipManager.AllTips())
+	require.Equal(t, model.ParentReferences{
+		model.StrongParentType: iotago.BlockIDs{blockAChild.ID()},
+	}, tf.TipManager.Tips(iotago.BlockMaxParents))
+
+	// once the opinion changes, the tip is corrected with a shallow like reference to the liked conflict.
+	tf.Vote(iotago.AccountID{2}, conflictB)
+
+	require.Equal(t, model.ParentReferences{
+		model.StrongParentType:      iotago.BlockIDs{blockAChild.ID()},
+		model.ShallowLikeParentType: iotago.BlockIDs{blockB.ID()},
+	}, tf.TipManager.Tips(iotago.BlockMaxParents))
+}
+
+func TestTipManager_WeakParents(t *testing.T) {
+	tf := newTestFramework(t)
+
+	conflictA, conflictB, conflictC := tpkg.RandTransactionID(), tpkg.RandTransactionID(), tpkg.RandTransactionID()
+	tf.CreateConflicts(conflictA, conflictB)
+	tf.Vote(iotago.AccountID{1}, conflictA)
+
+	blockA := tf.NewBlock(ids(conflictA), ids(conflictA), tf.Genesis)
+	require.True(t, tf.TipManager.AddTip(blockA))
+
+	tf.Vote(iotago.AccountID{2}, conflictB)
+
+	// the transaction of a block that builds on a disliked conflict is picked up via a weak reference.
+	blockC := tf.NewBlock(ids(conflictA, conflictC), ids(conflictC), blockA)
+	require.True(t, tf.TipManager.AddTip(blockC))
+
+	// blockA can not be corrected as we don't know an attachment of the liked conflict.
+	require.Equal(t, model.ParentReferences{
+		model.StrongParentType: iotago.BlockIDs{tf.Genesis.ID()},
+		model.WeakParentType:   iotago.BlockIDs{blockC.ID()},
+	}, tf.TipManager.Tips(iotago.BlockMaxParents))
+	require.Equal(t, []*blocks.Block{blockC}, tf.TipManager.AllTips())
+
+	// strongly approving the weak tip removes it from the tip pool.
+	blockB := tf.NewBlock(ids(conflictB), ids(conflictB), tf.Genesis)
+	blockBChild := tf.NewBlockWithParents(ids(conflictB, conflictC), model.ParentReferences{
+		model.StrongParentType: iotago.BlockIDs{blockB.ID()},
+		model.WeakParentType:   iotago.BlockIDs{blockC.ID()},
+	})
+	require.True(t, tf.TipManager.AddTip(blockB))
+	require.True(t, tf.TipManager.AddTip(blockBChild))
+	require.Equal(t, []*blocks.Block{blockBChild}, tf.TipManager.AllTips())
+}
+
+func TestTipManager_TimeSinceConfirmationThreshold(t *testing.T) {
+	tf := newTestFramework(t, WithTimeSinceConfirmationThreshold(time.Minute))
+
+	oldBlock := tf.NewBlock(ids(), ids(), tf.Genesis)
+	require.True(t, tf.TipManager.AddTip(oldBlock))
+
+	tf.AcceptedTime = oldBlock.IssuingTime().Add(30 * time.Second)
+	require.Equal(t, iotago.BlockIDs{oldBlock.ID()}, tf.TipManager.Tips(1)[model.StrongParentType])
+
+	// tips whose past cone is too old are removed from the tip pool.
+	tf.AcceptedTime = oldBlock.IssuingTime().Add(2 * time.Minute)
+	require.Equal(t, iotago.BlockIDs{tf.Genesis.ID()}, tf.TipManager.Tips(1)[model.StrongParentType])
+	require.Zero(t, tf.TipManager.TipCount())
+}
+
+func TestTipManager_RootBlocksInPastCone(t *testing.T) {
+	tf := newTestFramework(t, WithTimeSinceConfirmationThreshold(time.Minute))
+
+	// the root blocks are the anchor of the BlockDAG, so referencing them is valid no matter how old they are.
+	tf.AcceptedTime = tf.Genesis.IssuingTime().Add(2 * time.Minute)
+	tf.issuingTime = tf.AcceptedTime
+
+	block := tf.NewBlock(ids(), ids(), tf.Genesis)
+	require.True(t, tf.TipManager.AddTip(block))
+	require.Equal(t, iotago.BlockIDs{block.ID()}, tf.TipManager.Tips(1)[model.StrongParentType])
+	require.Equal(t, 1, tf.TipManager.TipCount())
+}
+
+func TestTipManager_SkippedBlocks(t *testing.T) {
+	tf := newTestFramework(t)
+
+	parent := tf.NewBlock(ids(), ids(), tf.Genesis)
+	require.True(t, tf.TipManager.AddTip(parent))
+
+	// a block that was accepted before it was scheduled still references its parents.
+	tf.TipManager.skip(tf.NewBlock(ids(), ids(), parent))
+	require.Zero(t, tf.TipManager.TipCount())
+}
+
+func TestTipManager_EvictedTips(t *testing.T) {
+	tf := newTestFramework(t)
+
+	evictedBlock := tf.NewBlock(ids(), ids(), tf.Genesis)
+	require.True(t, tf.TipManager.AddTip(evictedBlock))
+
+	// tips that are not in the cache anymore can't be referenced, so they are removed from the tip pool.
+	delete(tf.blocks, evictedBlock.ID())
+	require.Equal(t, iotago.BlockIDs{tf.Genesis.ID()}, tf.TipManager.Tips(1)[model.StrongParentType])
+	require.Zero(t, tf.TipManager.TipCount())
+}
+
+func TestTipManager_EvictedAttachments(t *testing.T) {
+	tf := newTestFramework(t)
+
+	transactionID := tpkg.RandTransactionID()
+	orphanedAttachment := tf.NewBlock(ids(), ids(transactionID), tf.Genesis)
+	tf.TipManager.trackAttachment(orphanedAttachment)
+	tf.TipManager.orphan(orphanedAttachment)
+
+	// the transaction is attached again in a later slot, which is not affected by the eviction of the earlier slot.
+	tf.issuingTime = tpkg.API().SlotTimeProvider().StartTime(orphanedAttachment.ID().Index() + 1)
+	laterAttachment := tf.NewBlock(ids(), ids(transactionID), tf.Genesis)
+	tf.TipManager.trackAttachment(laterAttachment)
+
+	tf.TipManager.evict(orphanedAttachment.ID().Index())
+	require.Equal(t, laterAttachment, tf.TipManager.attachments[transactionID])
+
+	tf.TipManager.evict(laterAttachment.ID().Index())
+	require.NotContains(t, tf.TipManager.attachments, transactionID)
+}
+
+type testFramework struct {
+	TipManager   *TipManager
+	Genesis      *blocks.Block
+	AcceptedTime time.Time
+
+	test        *testing.T
+	conflictDAG *conflictdagv1.ConflictDAG[iotago.TransactionID, iotago.OutputID, booker.BlockVotePower]
+	blocks      map[iotago.BlockID]*blocks.Block
+	issuingTime time.Time
+}
+
+func newTestFramework(t *testing.T, opts ...options.Option[TipManager]) *testFramework {
+	accounts := account.NewAccounts[iotago.AccountID, *iotago.AccountID](mapdb.NewMapDB())
+	accounts.Set(iotago.AccountID{1}, 10)
+	accounts.Set(iotago.AccountID{2}, 20)
+
+	genesisTime := tpkg.API().SlotTimeProvider().StartTime(1)
+
+	tf := &testFramework{
+		Genesis:      blocks.NewRootBlock(iotago.EmptyBlockID(), iotago.NewEmptyCommitment().MustID(), genesisTime),
+		AcceptedTime: genesisTime,
+		test:         t,
+		conflictDAG:  conflictdagv1.New[iotago.TransactionID, iotago.OutputID, booker.BlockVotePower](accounts.SelectAccounts(iotago.AccountID{1}, iotago.AccountID{2})),
+		blocks:       make(map[iotago.BlockID]*blocks.Block),
+		issuingTime:  genesisTime,
+	}
+	tf.blocks[tf.Genesis.ID()] = tf.Genesis
+
+	tf.TipManager = New(workerpool.NewGroup(t.Name()), tf.conflictDAG, func(id iotago.BlockID) (*blocks.Block, bool) {
+		block, exists := tf.blocks[id]
+		return block, exists
+	}, func() iotago.BlockIDs {
+		return iotago.BlockIDs{tf.Genesis.ID()}
+	}, func() time.Time {
+		return tf.AcceptedTime
+	}, func() bool {
+		return true
+	}, opts...)
+
+	return tf
+}
+
+// CreateConflicts creates the given conflicts that all spend the same resource.
+func (tf *testFramework) CreateConflicts(conflictIDs ...iotago.TransactionID) {
+	resourceID := tpkg.RandOutputID()
+	for _, conflictID := range conflictIDs {
+		require.NoError(tf.test, tf.conflictDAG.CreateOrUpdateConflict(conflictID, advancedset.New(resourceID), acceptance.Pending))
+	}
+}
+
+// Vote casts a vote of the given validator for the given conflicts.
+func (tf *testFramework) Vote(validatorID iotago.AccountID, conflictIDs ...iotago.TransactionID) {
+	tf.issuingTime = tf.issuingTime.Add(time.Millisecond)
+
+	require.NoError(tf.test, tf.conflictDAG.CastVotes(vote.NewVote(validatorID, booker.NewBlockVotePower(tpkg.RandBlockID(), tf.issuingTime)), advancedset.New(conflictIDs...)))
+}
+
+// NewBlock creates a new block with the given conflicts that strongly approves the given parents.
+func (tf *testFramework) NewBlock(conflictIDs, payloadConflictIDs *advancedset.AdvancedSet[iotago.TransactionID], parents ...*blocks.Block) *blocks.Block {
+	strongParents := make(iotago.BlockIDs, 0, len(parents))
+	for _, parent := range parents {
+		strongParents = append(strongParents, parent.ID())
+	}
+
+	block := tf.NewBlockWithParents(conflictIDs, model.ParentReferences{model.StrongParentType: strongParents})
+	block.SetPayloadConflictIDs(payloadConflictIDs)
+
+	return block
+}
+
+// NewBlockWithParents creates a new block with the given conflicts and parents.
+func (tf *testFramework) NewBlockWithParents(conflictIDs *advancedset.AdvancedSet[iotago.TransactionID], references model.ParentReferences) *blocks.Block {
+	tf.issuingTime = tf.issuingTime.Add(time.Millisecond)
+
+	modelBlock, err := model.BlockFromBlock(&iotago.Block{
+		ProtocolVersion:    tpkg.ProtocolParams().Version,
+		IssuerID:           iotago.AccountID{1},
+		IssuingTime:        tf.issuingTime,
+		SlotCommitment:     iotago.NewEmptyCommitment(),
+		StrongParents:      references[model.StrongParentType],
+		WeakParents:        references[model.WeakParentType],
+		ShallowLikeParents: references[model.ShallowLikeParentType],
+		Signature:          &iotago.Ed25519Signature{},
+	}, tpkg.API())
+	require.NoError(tf.test, err)
+
+	block := blocks.NewBlock(modelBlock)
+	block.SetConflictIDs(conflictIDs)
+
+	tf.blocks[block.ID()] = block
+
+	return block
+}
+
+func ids(transactionIDs ...iotago.TransactionID) *advancedset.AdvancedSet[iotago.TransactionID] {
+	return advancedset.New(transactionIDs...)
+}
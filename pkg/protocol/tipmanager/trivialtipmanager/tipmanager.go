@@ -54,10 +54,14 @@ func NewProvider(opts ...options.Option[TipManager]) module.Provider[*engine.Eng
 	return module.Provide(func(e *engine.Engine) tipmanager.TipManager {
 		t := New(e.Workers.CreateGroup("TipManager"), e.EvictionState, e.BlockCache.Block, e.IsBootstrapped, opts...)
 
-		e.Events.Booker.BlockBooked.Hook(func(block *blocks.Block) {
+		e.Events.Scheduler.BlockScheduled.Hook(func(block *blocks.Block) {
 			_ = t.AddTip(block)
 		}, event.WithWorkerPool(t.workers.CreatePool("AddTip", 2)))
 
+		e.Events.Orphanage.BlockOrphaned.Hook(func(block *blocks.Block) {
+			_ = t.RemoveTip(block.ID())
+		})
+
 		e.BlockCache.Evict.Hook(t.evict)
 
 		t.TriggerInitialized()
@@ -106,6 +110,10 @@ func (t *TipManager) AddTip(block *blocks.Block) (added bool) {
 // TODO: we might not need this at all
 // AddTipNonMonotonic adds a tip to the TipManager without checking for monotonicity.
 func (t *TipManager) AddTipNonMonotonic(block *blocks.Block) (added bool) {
+	if block.IsOrphaned() {
+		return
+	}
+
 	// TODO: add when we have a way to check if a block is invalid
 	// if block.IsSubjectivelyInvalid() {
 	// 	return
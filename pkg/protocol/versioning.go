@@ -1,3 +1,3 @@
 package protocol
 
-const DatabaseVersion byte = 1
+const DatabaseVersion byte = 4
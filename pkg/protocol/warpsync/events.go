@@ -0,0 +1,25 @@
+package warpsync
+
+import (
+	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/iota-core/pkg/model"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+type Events struct {
+	// RequestSlot is triggered when the block IDs of the slot of the given commitment should be requested.
+	RequestSlot *event.Event1[iotago.CommitmentID]
+
+	// SlotVerified is triggered when the block IDs of a committed slot were received and verified against its commitment.
+	SlotVerified *event.Event2[*model.Commitment, iotago.BlockIDs]
+
+	event.Group[Events, *Events]
+}
+
+// NewEvents contains the constructor of the Events object (it is generated by a generic factory).
+var NewEvents = event.CreateGroupConstructor(func() (newEvents *Events) {
+	return &Events{
+		RequestSlot:  event.New1[iotago.CommitmentID](),
+		SlotVerified: event.New2[*model.Commitment, iotago.BlockIDs](),
+	}
+})
@@ -0,0 +1,183 @@
+package warpsync
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/ads"
+	"github.com/iotaledger/hive.go/core/eventticker"
+	"github.com/iotaledger/hive.go/core/memstorage"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/chainmanager"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+var (
+	ErrRootsMismatch    = errors.New("roots do not match commitment")
+	ErrBlockIDsMismatch = errors.New("block IDs do not match tangle root")
+)
+
+// Manager requests the block IDs of committed slots in bulk and verifies them against the commitments of the slots.
+type Manager struct {
+	Events *Events
+
+	slotRequester      *eventticker.EventTicker[iotago.SlotIndex, iotago.CommitmentID]
+	pendingCommitments *memstorage.IndexedStorage[iotago.SlotIndex, iotago.CommitmentID, *model.Commitment]
+	lastEvictedSlot    *model.EvictionIndex
+	evictionMutex      sync.RWMutex
+
+	optsSlotRequester []options.Option[eventticker.EventTicker[iotago.SlotIndex, iotago.CommitmentID]]
+	optsSyncThreshold iotago.SlotIndex
+	optsSyncWindow    iotago.SlotIndex
+}
+
+func NewManager(opts ...options.Option[Manager]) *Manager {
+	return options.Apply(&Manager{
+		Events:             NewEvents(),
+		pendingCommitments: memstorage.NewIndexedStorage[iotago.SlotIndex, iotago.CommitmentID, *model.Commitment](),
+		lastEvictedSlot:    model.NewEvictionIndex(),
+
+		optsSyncThreshold: 2,
+		optsSyncWindow:    10,
+	}, opts, func(m *Manager) {
+		m.slotRequester = eventticker.New(m.optsSlotRequester...)
+
+		m.Events.RequestSlot.LinkTo(m.slotRequester.Events.Tick)
+	})
+}
+
+// WarpSync requests the slots of the given chain that follow the given latest commitment index, if we are more than
+// the sync threshold behind the chain. At most the slots within the sync window are requested at once.
+func (m *Manager) WarpSync(latestCommitmentIndex iotago.SlotIndex, chain *chainmanager.Chain) {
+	latestChainCommitment := chain.LatestCommitment()
+	if latestChainCommitment == nil || latestChainCommitment.ID().Index() <= latestCommitmentIndex+m.optsSyncThreshold {
+		return
+	}
+
+	for index := latestCommitmentIndex + 1; index <= latestChainCommitment.ID().Index() && index <= latestCommitmentIndex+m.optsSyncWindow; index++ {
+		chainCommitment := chain.Commitment(index)
+		if chainCommitment == nil || chainCommitment.Commitment() == nil {
+			return
+		}
+
+		m.RequestSlot(chainCommitment.Commitment())
+	}
+}
+
+// RequestSlot starts requesting the block IDs of the slot of the given commitment until they are received.
+func (m *Manager) RequestSlot(commitment *model.Commitment) {
+	m.evictionMutex.RLock()
+	defer m.evictionMutex.RUnlock()
+
+	if m.lastEvictedSlot.IsEvicted(commitment.Index()) {
+		return
+	}
+
+	if m.pendingCommitments.Get(commitment.Index(), true).Set(commitment.ID(), commitment) {
+		m.slotRequester.StartTicker(commitment.ID())
+	}
+}
+
+// IsRequested returns whether the block IDs of the slot of the given commitment are currently requested.
+func (m *Manager) IsRequested(commitmentID iotago.CommitmentID) bool {
+	m.evictionMutex.RLock()
+	defer m.evictionMutex.RUnlock()
+
+	pending := m.pendingCommitments.Get(commitmentID.Index())
+
+	return pending != nil && pending.Has(commitmentID)
+}
+
+// ProcessResponse verifies the block IDs of a requested slot against its commitment and triggers SlotVerified if they
+// are valid. Responses for slots that are not (or no longer) requested are ignored.
+func (m *Manager) ProcessResponse(commitmentID iotago.CommitmentID, blockIDs iotago.BlockIDs, roots *iotago.Roots) error {
+	m.evictionMutex.RLock()
+	defer m.evictionMutex.RUnlock()
+
+	pending := m.pendingCommitments.Get(commitmentID.Index())
+	if pending == nil {
+		return nil
+	}
+
+	commitment, exists := pending.Get(commitmentID)
+	if !exists {
+		return nil
+	}
+
+	if err := verifySlot(commitment, blockIDs, roots); err != nil {
+		return errors.Wrapf(err, "failed to verify slot of commitment %s", commitmentID)
+	}
+
+	// Another response for the same slot might have been processed concurrently.
+	if !pending.Delete(commitmentID) {
+		return nil
+	}
+
+	m.slotRequester.StopTicker(commitmentID)
+
+	m.Events.SlotVerified.Trigger(commitment, blockIDs)
+
+	return nil
+}
+
+// EvictUntil stops requesting the slots up to (and including) the given index.
+func (m *Manager) EvictUntil(index iotago.SlotIndex) {
+	m.evictionMutex.Lock()
+	defer m.evictionMutex.Unlock()
+
+	for currentIndex := m.lastEvictedSlot.NextIndex(); currentIndex <= index; currentIndex++ {
+		m.pendingCommitments.Evict(currentIndex)
+		m.lastEvictedSlot.MarkEvicted(currentIndex)
+	}
+
+	m.slotRequester.EvictUntil(index)
+}
+
+func (m *Manager) Shutdown() {
+	m.slotRequester.Shutdown()
+}
+
+// verifySlot checks that the given roots belong to the commitment and that the block IDs make up its tangle root.
+func verifySlot(commitment *model.Commitment, blockIDs iotago.BlockIDs, roots *iotago.Roots) error {
+	if roots == nil || model.RootsID(roots) != commitment.RootsID() {
+		return ErrRootsMismatch
+	}
+
+	tangle := ads.NewSet[iotago.BlockID, *iotago.BlockID](mapdb.NewMapDB())
+	for _, blockID := range blockIDs {
+		if blockID.Index() != commitment.Index() {
+			return errors.Errorf("block %s does not belong to slot %d", blockID, commitment.Index())
+		}
+
+		tangle.Add(blockID)
+	}
+
+	if iotago.Identifier(tangle.Root()) != roots.TangleRoot {
+		return ErrBlockIDsMismatch
+	}
+
+	return nil
+}
+
+// WithSyncThreshold sets the amount of slots that we need to be behind a chain before we start to warp sync.
+func WithSyncThreshold(threshold iotago.SlotIndex) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsSyncThreshold = threshold
+	}
+}
+
+// WithSyncWindow sets the maximum amount of slots ahead of our latest commitment that are requested at once.
+func WithSyncWindow(window iotago.SlotIndex) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsSyncWindow = window
+	}
+}
+
+func WithSlotRequesterOptions(opts ...options.Option[eventticker.EventTicker[iotago.SlotIndex, iotago.CommitmentID]]) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsSlotRequester = append(m.optsSlotRequester, opts...)
+	}
+}
@@ -0,0 +1,88 @@
+package warpsync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/ads"
+	"github.com/iotaledger/hive.go/core/eventticker"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/iota-core/pkg/model"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestManager_ProcessResponse(t *testing.T) {
+	manager := NewManager(WithSlotRequesterOptions(eventticker.RetryInterval[iotago.SlotIndex, iotago.CommitmentID](time.Hour)))
+	defer manager.Shutdown()
+
+	blockIDs := iotago.BlockIDs{
+		iotago.NewSlotIdentifier(5, iotago.Identifier{1}),
+		iotago.NewSlotIdentifier(5, iotago.Identifier{2}),
+		iotago.NewSlotIdentifier(5, iotago.Identifier{3}),
+	}
+	commitment, roots := newCommitment(t, 5, blockIDs)
+
+	var verifiedBlockIDs iotago.BlockIDs
+	manager.Events.SlotVerified.Hook(func(verifiedCommitment *model.Commitment, ids iotago.BlockIDs) {
+		require.Equal(t, commitment.ID(), verifiedCommitment.ID())
+		verifiedBlockIDs = ids
+	})
+
+	// Responses for slots that were not requested are ignored.
+	require.NoError(t, manager.ProcessResponse(commitment.ID(), blockIDs, roots))
+	require.Nil(t, verifiedBlockIDs)
+
+	manager.RequestSlot(commitment)
+	require.True(t, manager.IsRequested(commitment.ID()))
+
+	// Roots that do not belong to the commitment are rejected.
+	require.ErrorIs(t, manager.ProcessResponse(commitment.ID(), blockIDs, iotago.NewRoots(iotago.Identifier{}, iotago.Identifier{}, iotago.Identifier{}, iotago.Identifier{}, iotago.Identifier{})), ErrRootsMismatch)
+
+	// Incomplete block IDs are rejected.
+	require.ErrorIs(t, manager.ProcessResponse(commitment.ID(), blockIDs[:2], roots), ErrBlockIDsMismatch)
+
+	// Block IDs of a different slot are rejected.
+	require.Error(t, manager.ProcessResponse(commitment.ID(), append(blockIDs[:2:2], iotago.NewSlotIdentifier(6, iotago.Identifier{3})), roots))
+
+	require.True(t, manager.IsRequested(commitment.ID()))
+	require.Nil(t, verifiedBlockIDs)
+
+	require.NoError(t, manager.ProcessResponse(commitment.ID(), blockIDs, roots))
+	require.Equal(t, blockIDs, verifiedBlockIDs)
+	require.False(t, manager.IsRequested(commitment.ID()))
+}
+
+func TestManager_EvictUntil(t *testing.T) {
+	manager := NewManager(WithSlotRequesterOptions(eventticker.RetryInterval[iotago.SlotIndex, iotago.CommitmentID](time.Hour)))
+	defer manager.Shutdown()
+
+	commitment5, _ := newCommitment(t, 5, iotago.BlockIDs{iotago.NewSlotIdentifier(5, iotago.Identifier{1})})
+	commitment6, _ := newCommitment(t, 6, iotago.BlockIDs{iotago.NewSlotIdentifier(6, iotago.Identifier{1})})
+
+	manager.RequestSlot(commitment5)
+	manager.RequestSlot(commitment6)
+
+	manager.EvictUntil(5)
+	require.False(t, manager.IsRequested(commitment5.ID()))
+	require.True(t, manager.IsRequested(commitment6.ID()))
+
+	// Evicted slots are not requested anymore.
+	manager.RequestSlot(commitment5)
+	require.False(t, manager.IsRequested(commitment5.ID()))
+}
+
+func newCommitment(t *testing.T, index iotago.SlotIndex, blockIDs iotago.BlockIDs) (*model.Commitment, *iotago.Roots) {
+	tangle := ads.NewSet[iotago.BlockID, *iotago.BlockID](mapdb.NewMapDB())
+	for _, blockID := range blockIDs {
+		tangle.Add(blockID)
+	}
+
+	roots := iotago.NewRoots(iotago.Identifier(tangle.Root()), iotago.Identifier{}, iotago.Identifier{}, iotago.Identifier{}, iotago.Identifier{})
+
+	commitment, err := model.CommitmentFromCommitment(iotago.NewCommitment(index, iotago.NewEmptyCommitment().MustID(), model.RootsID(roots), 0), iotago.LatestAPI(&iotago.ProtocolParameters{}))
+	require.NoError(t, err)
+
+	return commitment, roots
+}
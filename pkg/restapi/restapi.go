@@ -21,4 +21,7 @@ const (
 
 	// ParameterPeerID is used to identify a peer.
 	ParameterPeerID = "peerID"
+
+	// ParameterJobID is used to identify a background job of the control API.
+	ParameterJobID = "jobID"
 )
@@ -3,6 +3,7 @@ package permanent
 import (
 	"encoding/binary"
 	"io"
+	"os"
 
 	"github.com/pkg/errors"
 
@@ -12,16 +13,19 @@ import (
 	iotago "github.com/iotaledger/iota.go/v4"
 )
 
+// commitmentsHeaderSize is the size of the header of the byte slice, which contains the slot of the first commitment.
+const commitmentsHeaderSize = 8
+
 type Commitments struct {
-	apiProviderFunc func() iotago.API
+	apiProviderFunc func(index iotago.SlotIndex) iotago.API
 	slice           *storable.ByteSlice
 	filePath        string
 
 	module.Module
 }
 
-func NewCommitments(path string, apiProviderFunc func() iotago.API) *Commitments {
-	commitmentsSlice, err := storable.NewByteSlice(path, uint64(len(model.NewEmptyCommitment(apiProviderFunc()).Data())))
+func NewCommitments(path string, apiProviderFunc func(index iotago.SlotIndex) iotago.API) *Commitments {
+	commitmentsSlice, err := storable.NewByteSlice(path, uint64(len(model.NewEmptyCommitment(apiProviderFunc(0)).Data())))
 	if err != nil {
 		panic(errors.Wrap(err, "failed to create commitments file"))
 	}
@@ -51,7 +55,53 @@ func (c *Commitments) Load(index iotago.SlotIndex) (commitment *model.Commitment
 		return nil, errors.Wrapf(err, "failed to get commitment for slot %d", index)
 	}
 
-	return model.CommitmentFromBytes(bytes, c.apiProviderFunc())
+	return model.CommitmentFromBytes(bytes, c.apiProviderFunc(index))
+}
+
+// RollbackToSlot removes the commitments of all slots after the given slot by truncating the underlying file.
+func (c *Commitments) RollbackToSlot(targetSlot iotago.SlotIndex) (err error) {
+	if _, err = c.Load(targetSlot); err != nil {
+		return errors.Wrapf(err, "failed to load commitment of target slot %d", targetSlot)
+	}
+
+	startOffset, err := c.startOffset()
+	if err != nil {
+		return errors.Wrap(err, "failed to read start offset of commitments file")
+	} else if uint64(targetSlot) < startOffset {
+		return errors.Errorf("target slot %d is before the first slot %d of the commitments file", targetSlot, startOffset)
+	}
+
+	entrySize := c.slice.EntrySize()
+	if err = c.slice.Close(); err != nil {
+		return errors.Wrap(err, "failed to close commitments file")
+	}
+
+	// the file starts with an 8 byte header that is followed by the commitments (starting at the start offset).
+	if err = os.Truncate(c.filePath, int64(commitmentsHeaderSize+(uint64(targetSlot)-startOffset+1)*entrySize)); err != nil {
+		return errors.Wrap(err, "failed to truncate commitments file")
+	}
+
+	if c.slice, err = storable.NewByteSlice(c.filePath, entrySize); err != nil {
+		return errors.Wrap(err, "failed to reopen commitments file")
+	}
+
+	return nil
+}
+
+// startOffset reads the slot of the first commitment in the file from the header of the underlying byte slice.
+func (c *Commitments) startOffset() (uint64, error) {
+	file, err := os.Open(c.filePath)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	header := make([]byte, commitmentsHeaderSize)
+	if _, err = file.ReadAt(header, 0); err != nil {
+		return 0, err
+	}
+
+	return binary.LittleEndian.Uint64(header), nil
 }
 
 func (c *Commitments) Close() (err error) {
@@ -95,7 +145,7 @@ func (c *Commitments) Import(reader io.ReadSeeker) (err error) {
 			return errors.Wrapf(err, "failed to read commitment bytes for slot %d", slotIndex)
 		}
 
-		newCommitment, err := model.CommitmentFromBytes(commitmentBytes, c.apiProviderFunc())
+		newCommitment, err := model.CommitmentFromBytes(commitmentBytes, c.apiProviderFunc(iotago.SlotIndex(slotIndex)))
 		if err != nil {
 			return errors.Wrapf(err, "failed to parse commitment of slot %d", slotIndex)
 		}
@@ -0,0 +1,126 @@
+package permanent
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/core/storable"
+	"github.com/iotaledger/iota-core/pkg/model"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func testAPI() iotago.API {
+	return iotago.LatestAPI(&iotago.ProtocolParameters{
+		Version:               3,
+		NetworkName:           "test",
+		Bech32HRP:             "rms",
+		TokenSupply:           5000,
+		GenesisUnixTimestamp:  uint32(time.Now().Unix()),
+		SlotDurationInSeconds: 10,
+	})
+}
+
+func TestCommitments_RollbackToSlot(t *testing.T) {
+	api := testAPI()
+	apiProviderFunc := func(iotago.SlotIndex) iotago.API { return api }
+
+	filePath := filepath.Join(t.TempDir(), "commitments.bin")
+	commitments := NewCommitments(filePath, apiProviderFunc)
+
+	commitment := model.NewEmptyCommitment(api)
+	require.NoError(t, commitments.Store(commitment))
+	for index := iotago.SlotIndex(1); index <= 5; index++ {
+		var err error
+		commitment, err = model.CommitmentFromCommitment(iotago.NewCommitment(index, commitment.ID(), iotago.Identifier{}, uint64(index)), api)
+		require.NoError(t, err)
+		require.NoError(t, commitments.Store(commitment))
+	}
+
+	require.Error(t, commitments.RollbackToSlot(6))
+	require.NoError(t, commitments.RollbackToSlot(3))
+
+	rolledBackCommitment, err := commitments.Load(3)
+	require.NoError(t, err)
+	require.Equal(t, iotago.SlotIndex(3), rolledBackCommitment.Index())
+
+	_, err = commitments.Load(4)
+	require.Error(t, err)
+
+	fileInfo, err := os.Stat(filePath)
+	require.NoError(t, err)
+	require.Equal(t, int64(8+4*len(commitment.Data())), fileInfo.Size())
+
+	require.NoError(t, commitments.Close())
+}
+
+func TestCommitments_RollbackToSlotWithStartOffset(t *testing.T) {
+	api := testAPI()
+	apiProviderFunc := func(iotago.SlotIndex) iotago.API { return api }
+	entrySize := uint64(len(model.NewEmptyCommitment(api).Data()))
+
+	// a commitments file that starts at slot 3 (e.g. because the node was started from a snapshot).
+	filePath := filepath.Join(t.TempDir(), "commitments.bin")
+	slice, err := storable.NewByteSlice(filePath, entrySize, storable.WithOffset(storable.SliceOffsetAuto))
+	require.NoError(t, err)
+
+	commitments := &Commitments{
+		apiProviderFunc: apiProviderFunc,
+		slice:           slice,
+		filePath:        filePath,
+	}
+
+	commitment := model.NewEmptyCommitment(api)
+	for index := iotago.SlotIndex(3); index <= 8; index++ {
+		commitment, err = model.CommitmentFromCommitment(iotago.NewCommitment(index, commitment.ID(), iotago.Identifier{}, uint64(index)), api)
+		require.NoError(t, err)
+		require.NoError(t, commitments.Store(commitment))
+	}
+
+	require.NoError(t, commitments.RollbackToSlot(5))
+
+	rolledBackCommitment, err := commitments.Load(5)
+	require.NoError(t, err)
+	require.Equal(t, iotago.SlotIndex(5), rolledBackCommitment.Index())
+
+	_, err = commitments.Load(6)
+	require.Error(t, err)
+
+	fileInfo, err := os.Stat(filePath)
+	require.NoError(t, err)
+	require.Equal(t, int64(8+3*entrySize), fileInfo.Size())
+
+	require.NoError(t, commitments.Close())
+}
+
+// TestCommitments_ByteSliceLayout asserts the layout of the file written by the ByteSlice, which RollbackToSlot relies on
+// to truncate the file.
+func TestCommitments_ByteSliceLayout(t *testing.T) {
+	const entrySize = 4
+
+	filePath := filepath.Join(t.TempDir(), "commitments.bin")
+	slice, err := storable.NewByteSlice(filePath, entrySize, storable.WithOffset(storable.SliceOffsetAuto))
+	require.NoError(t, err)
+
+	require.NoError(t, slice.Set(7, bytes.Repeat([]byte{7}, entrySize)))
+	require.NoError(t, slice.Set(8, bytes.Repeat([]byte{8}, entrySize)))
+	require.NoError(t, slice.Close())
+
+	// the file starts with the slot of the first entry as a little endian uint64, followed by the entries.
+	fileBytes, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+	require.Len(t, fileBytes, commitmentsHeaderSize+2*entrySize)
+	require.Equal(t, uint64(7), binary.LittleEndian.Uint64(fileBytes[:commitmentsHeaderSize]))
+	require.Equal(t, bytes.Repeat([]byte{7}, entrySize), fileBytes[commitmentsHeaderSize:commitmentsHeaderSize+entrySize])
+	require.Equal(t, bytes.Repeat([]byte{8}, entrySize), fileBytes[commitmentsHeaderSize+entrySize:])
+
+	commitments := &Commitments{filePath: filePath}
+	startOffset, err := commitments.startOffset()
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), startOffset)
+}
@@ -15,6 +15,7 @@ const (
 	sybilProtectionPrefix byte = iota
 	attestationsPrefix
 	ledgerPrefix
+	accountsPrefix
 )
 
 type Permanent struct {
@@ -29,6 +30,7 @@ type Permanent struct {
 	sybilProtection kvstore.KVStore
 	attestations    kvstore.KVStore
 	ledger          kvstore.KVStore
+	accounts        kvstore.KVStore
 }
 
 // New returns a new permanent storage instance.
@@ -56,6 +58,7 @@ func New(baseDir *utils.Directory, dbConfig database.Config, errorHandler func(e
 		p.sybilProtection = lo.PanicOnErr(p.store.WithExtendedRealm(kvstore.Realm{sybilProtectionPrefix}))
 		p.attestations = lo.PanicOnErr(p.store.WithExtendedRealm(kvstore.Realm{attestationsPrefix}))
 		p.ledger = lo.PanicOnErr(p.store.WithExtendedRealm(kvstore.Realm{ledgerPrefix}))
+		p.accounts = lo.PanicOnErr(p.store.WithExtendedRealm(kvstore.Realm{accountsPrefix}))
 	})
 }
 
@@ -94,6 +97,15 @@ func (p *Permanent) Ledger(optRealm ...byte) kvstore.KVStore {
 	return lo.PanicOnErr(p.ledger.WithExtendedRealm(optRealm))
 }
 
+// Accounts returns the accounts ledger storage (or a specialized sub-storage if a realm is provided).
+func (p *Permanent) Accounts(optRealm ...byte) kvstore.KVStore {
+	if len(optRealm) == 0 {
+		return p.accounts
+	}
+
+	return lo.PanicOnErr(p.accounts.WithExtendedRealm(optRealm))
+}
+
 // Size returns the size of the permanent storage.
 func (p *Permanent) Size() int64 {
 	dbSize, err := ioutils.FolderSize(p.dbConfig.Directory)
@@ -0,0 +1,18 @@
+package permanent
+
+import (
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// ProtocolUpgrade is a set of protocol parameters that becomes active at the start of the given epoch.
+type ProtocolUpgrade struct {
+	// StartEpoch is the epoch from which on the protocol parameters are valid.
+	StartEpoch uint64 `serix:"0"`
+	// Parameters are the protocol parameters that become active.
+	Parameters iotago.ProtocolParameters `serix:"1"`
+}
+
+// StartSlot returns the first slot in which the protocol parameters are valid.
+func (p ProtocolUpgrade) StartSlot(epochLength iotago.SlotIndex) iotago.SlotIndex {
+	return iotago.SlotIndex(p.StartEpoch) * epochLength
+}
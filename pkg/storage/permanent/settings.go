@@ -23,7 +23,8 @@ type Settings struct {
 	*settingsModel
 	mutex sync.RWMutex
 
-	api iotago.API
+	// apis contains the API of the genesis parameters followed by the APIs of the scheduled protocol upgrades.
+	apis []iotago.API
 
 	latestCommitment *model.Commitment
 
@@ -38,6 +39,10 @@ func NewSettings(path string) (settings *Settings) {
 			LatestCommitment:        iotago.NewEmptyCommitment(),
 			LatestStateMutationSlot: 0,
 			LatestFinalizedSlot:     0,
+			EpochLength:             0,
+			ProtocolUpgrades:        make([]ProtocolUpgrade, 0),
+			MaxCommitteeSize:        0,
+			MinimumStake:            0,
 		}, path),
 	}
 
@@ -46,11 +51,20 @@ func NewSettings(path string) (settings *Settings) {
 	return s
 }
 
-func (s *Settings) API() iotago.API {
+// API returns the API that is valid for the given slot.
+func (s *Settings) API(index iotago.SlotIndex) iotago.API {
 	s.mutex.RLock()
 	defer s.mutex.RUnlock()
 
-	return s.api
+	return s.apiForSlot(index)
+}
+
+// CurrentAPI returns the API that is valid for the slot following the latest commitment.
+func (s *Settings) CurrentAPI() iotago.API {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.apiForSlot(s.currentSlot())
 }
 
 func (s *Settings) SnapshotImported() (initialized bool) {
@@ -73,13 +87,23 @@ func (s *Settings) SetSnapshotImported(initialized bool) (err error) {
 	return nil
 }
 
+// ProtocolParameters returns the protocol parameters that are valid for the slot following the latest commitment.
 func (s *Settings) ProtocolParameters() *iotago.ProtocolParameters {
 	s.mutex.RLock()
 	defer s.mutex.RUnlock()
 
-	return &s.settingsModel.ProtocolParameters
+	return s.protocolParametersForSlot(s.currentSlot())
 }
 
+// ProtocolParametersForSlot returns the protocol parameters that are valid for the given slot.
+func (s *Settings) ProtocolParametersForSlot(index iotago.SlotIndex) *iotago.ProtocolParameters {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.protocolParametersForSlot(index)
+}
+
+// SetProtocolParameters sets the genesis protocol parameters.
 func (s *Settings) SetProtocolParameters(params iotago.ProtocolParameters) (err error) {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
@@ -94,6 +118,148 @@ func (s *Settings) SetProtocolParameters(params iotago.ProtocolParameters) (err
 	return nil
 }
 
+// EpochLength returns the amount of slots per epoch. Epochs define when the committee is rotated, when Mana decays and
+// when protocol upgrades start.
+func (s *Settings) EpochLength() iotago.SlotIndex {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.settingsModel.EpochLength
+}
+
+// SetEpochLength sets the amount of slots per epoch.
+func (s *Settings) SetEpochLength(epochLength iotago.SlotIndex) (err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if len(s.settingsModel.ProtocolUpgrades) > 0 && epochLength != s.settingsModel.EpochLength {
+		return errors.New("cannot change the epoch length after protocol upgrades were scheduled")
+	}
+
+	s.settingsModel.EpochLength = epochLength
+
+	if err = s.ToFile(); err != nil {
+		return errors.Wrap(err, "failed to persist epoch length")
+	}
+
+	return nil
+}
+
+// MaxCommitteeSize returns the maximum amount of validators that are selected to be part of the committee.
+func (s *Settings) MaxCommitteeSize() uint32 {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.settingsModel.MaxCommitteeSize
+}
+
+// SetMaxCommitteeSize sets the maximum amount of validators that are selected to be part of the committee.
+func (s *Settings) SetMaxCommitteeSize(maxCommitteeSize uint32) (err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.settingsModel.MaxCommitteeSize = maxCommitteeSize
+
+	if err = s.ToFile(); err != nil {
+		return errors.Wrap(err, "failed to persist max committee size")
+	}
+
+	return nil
+}
+
+// MinimumStake returns the minimum amount of funds that a validator needs to hold to be selected for the committee.
+func (s *Settings) MinimumStake() uint64 {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.settingsModel.MinimumStake
+}
+
+// SetMinimumStake sets the minimum amount of funds that a validator needs to hold to be selected for the committee.
+func (s *Settings) SetMinimumStake(minimumStake uint64) (err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.settingsModel.MinimumStake = minimumStake
+
+	if err = s.ToFile(); err != nil {
+		return errors.Wrap(err, "failed to persist minimum stake")
+	}
+
+	return nil
+}
+
+// ProtocolUpgrades returns the scheduled protocol upgrades ordered by their start epoch.
+func (s *Settings) ProtocolUpgrades() []ProtocolUpgrade {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return append(make([]ProtocolUpgrade, 0, len(s.settingsModel.ProtocolUpgrades)), s.settingsModel.ProtocolUpgrades...)
+}
+
+// NextProtocolUpgrade returns the earliest protocol upgrade that is not active yet.
+func (s *Settings) NextProtocolUpgrade() (upgrade ProtocolUpgrade, exists bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	currentSlot := s.currentSlot()
+	for _, upgrade = range s.settingsModel.ProtocolUpgrades {
+		if upgrade.StartSlot(s.settingsModel.EpochLength) > currentSlot {
+			return upgrade, true
+		}
+	}
+
+	return ProtocolUpgrade{}, false
+}
+
+// ScheduleProtocolUpgrade schedules the given protocol upgrade. Scheduling an upgrade that is already known is a no-op.
+func (s *Settings) ScheduleProtocolUpgrade(upgrade ProtocolUpgrade) (err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.settingsModel.EpochLength == 0 {
+		return errors.New("cannot schedule protocol upgrades without an epoch length")
+	}
+
+	insertAt := len(s.settingsModel.ProtocolUpgrades)
+	for i, scheduledUpgrade := range s.settingsModel.ProtocolUpgrades {
+		if scheduledUpgrade.StartEpoch == upgrade.StartEpoch {
+			if scheduledUpgrade.Parameters != upgrade.Parameters {
+				return errors.Errorf("a different protocol upgrade is already scheduled for epoch %d", upgrade.StartEpoch)
+			}
+
+			return nil
+		}
+
+		if scheduledUpgrade.StartEpoch > upgrade.StartEpoch {
+			insertAt = i
+			break
+		}
+	}
+
+	if startSlot := upgrade.StartSlot(s.settingsModel.EpochLength); startSlot <= s.currentSlot() {
+		return errors.Errorf("cannot schedule protocol upgrade for epoch %d as its start slot %d is not in the future", upgrade.StartEpoch, startSlot)
+	}
+
+	genesisParameters := s.settingsModel.ProtocolParameters
+	if upgrade.Parameters.GenesisUnixTimestamp != genesisParameters.GenesisUnixTimestamp || upgrade.Parameters.SlotDurationInSeconds != genesisParameters.SlotDurationInSeconds {
+		return errors.Errorf("protocol upgrade for epoch %d must not change the genesis time or the slot duration", upgrade.StartEpoch)
+	}
+
+	if previousVersion := s.protocolParametersForSlot(upgrade.StartSlot(s.settingsModel.EpochLength) - 1).Version; upgrade.Parameters.Version < previousVersion {
+		return errors.Errorf("protocol upgrade for epoch %d must not downgrade the protocol version from %d to %d", upgrade.StartEpoch, previousVersion, upgrade.Parameters.Version)
+	}
+
+	s.settingsModel.ProtocolUpgrades = append(s.settingsModel.ProtocolUpgrades[:insertAt], append([]ProtocolUpgrade{upgrade}, s.settingsModel.ProtocolUpgrades[insertAt:]...)...)
+	s.UpdateAPI()
+
+	if err = s.ToFile(); err != nil {
+		return errors.Wrap(err, "failed to persist protocol upgrades")
+	}
+
+	return nil
+}
+
 func (s *Settings) LatestCommitment() *model.Commitment {
 	s.mutex.RLock()
 	if s.latestCommitment == nil {
@@ -101,10 +267,10 @@ func (s *Settings) LatestCommitment() *model.Commitment {
 		s.mutex.Lock()
 		defer s.mutex.Unlock()
 
-		if s.api.SlotTimeProvider().Duration() == 0 {
+		if s.apis[0].SlotTimeProvider().Duration() == 0 {
 			panic("accessing the LatestCommitment before the settings are initialized")
 		}
-		s.latestCommitment = lo.PanicOnErr(model.CommitmentFromCommitment(s.settingsModel.LatestCommitment, s.api))
+		s.latestCommitment = lo.PanicOnErr(model.CommitmentFromCommitment(s.settingsModel.LatestCommitment, s.apiForSlot(s.settingsModel.LatestCommitment.Index)))
 
 		return s.latestCommitment
 	}
@@ -168,11 +334,22 @@ func (s *Settings) SetLatestFinalizedSlot(index iotago.SlotIndex) (err error) {
 	return nil
 }
 
-func (s *Settings) Export(writer io.WriteSeeker) (err error) {
+// Export writes the settings to the given writer, rewinding the slot related fields to the given target commitment.
+func (s *Settings) Export(writer io.WriteSeeker, targetCommitment *iotago.Commitment) (err error) {
 	s.mutex.RLock()
 	defer s.mutex.RUnlock()
 
-	settingsBytes, err := s.Bytes()
+	settingsBytes, err := settingsModel{
+		SnapshotImported:        s.settingsModel.SnapshotImported,
+		ProtocolParameters:      s.settingsModel.ProtocolParameters,
+		LatestCommitment:        targetCommitment,
+		LatestStateMutationSlot: earlierSlot(s.settingsModel.LatestStateMutationSlot, targetCommitment.Index),
+		LatestFinalizedSlot:     earlierSlot(s.settingsModel.LatestFinalizedSlot, targetCommitment.Index),
+		EpochLength:             s.settingsModel.EpochLength,
+		ProtocolUpgrades:        s.settingsModel.ProtocolUpgrades,
+		MaxCommitteeSize:        s.settingsModel.MaxCommitteeSize,
+		MinimumStake:            s.settingsModel.MinimumStake,
+	}.Bytes()
 	if err != nil {
 		return errors.Wrap(err, "failed to convert settings to bytes")
 	}
@@ -208,6 +385,10 @@ func (s *Settings) String() string {
 	builder.AddField(stringify.NewStructField("LatestCommitment", s.settingsModel.LatestCommitment))
 	builder.AddField(stringify.NewStructField("LatestStateMutationSlot", s.settingsModel.LatestStateMutationSlot))
 	builder.AddField(stringify.NewStructField("LatestFinalizedSlot", s.settingsModel.LatestFinalizedSlot))
+	builder.AddField(stringify.NewStructField("EpochLength", s.settingsModel.EpochLength))
+	builder.AddField(stringify.NewStructField("ProtocolUpgrades", s.settingsModel.ProtocolUpgrades))
+	builder.AddField(stringify.NewStructField("MaxCommitteeSize", s.settingsModel.MaxCommitteeSize))
+	builder.AddField(stringify.NewStructField("MinimumStake", s.settingsModel.MinimumStake))
 
 	return builder.String()
 }
@@ -243,9 +424,61 @@ func (s *Settings) tryImport(reader io.ReadSeeker) (err error) {
 	return
 }
 
+// earlierSlot returns the smaller of the two given slots (lo.Min compares against the zero value and can't be used for
+// unsigned types).
+func earlierSlot(a, b iotago.SlotIndex) iotago.SlotIndex {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+// UpdateAPI creates the APIs of all scheduled protocol parameters and updates the internal API of iota.go to the API of
+// the genesis parameters. Protocol upgrades only change the APIs of the engine, which are retrieved through API.
 func (s *Settings) UpdateAPI() {
-	s.api = iotago.LatestAPI(&s.settingsModel.ProtocolParameters)
-	iotago.SwapInternalAPI(s.api)
+	s.apis = make([]iotago.API, 0, len(s.settingsModel.ProtocolUpgrades)+1)
+	s.apis = append(s.apis, iotago.LatestAPI(&s.settingsModel.ProtocolParameters))
+	for i := range s.settingsModel.ProtocolUpgrades {
+		s.apis = append(s.apis, iotago.LatestAPI(&s.settingsModel.ProtocolUpgrades[i].Parameters))
+	}
+
+	iotago.SwapInternalAPI(s.apis[0])
+}
+
+// currentSlot returns the slot following the latest commitment.
+func (s *Settings) currentSlot() iotago.SlotIndex {
+	if s.settingsModel.LatestCommitment == nil {
+		return 0
+	}
+
+	return s.settingsModel.LatestCommitment.Index + 1
+}
+
+// protocolUpgradeIndex returns the position of the protocol parameters that are valid for the given slot within the
+// apis, where 0 denotes the genesis parameters.
+func (s *Settings) protocolUpgradeIndex(index iotago.SlotIndex) (upgradeIndex int) {
+	for i, upgrade := range s.settingsModel.ProtocolUpgrades {
+		if upgrade.StartSlot(s.settingsModel.EpochLength) > index {
+			break
+		}
+
+		upgradeIndex = i + 1
+	}
+
+	return upgradeIndex
+}
+
+func (s *Settings) protocolParametersForSlot(index iotago.SlotIndex) *iotago.ProtocolParameters {
+	if upgradeIndex := s.protocolUpgradeIndex(index); upgradeIndex > 0 {
+		return &s.settingsModel.ProtocolUpgrades[upgradeIndex-1].Parameters
+	}
+
+	return &s.settingsModel.ProtocolParameters
+}
+
+func (s *Settings) apiForSlot(index iotago.SlotIndex) iotago.API {
+	return s.apis[s.protocolUpgradeIndex(index)]
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -258,6 +491,10 @@ type settingsModel struct {
 	LatestCommitment        *iotago.Commitment        `serix:"2"`
 	LatestStateMutationSlot iotago.SlotIndex          `serix:"3"`
 	LatestFinalizedSlot     iotago.SlotIndex          `serix:"4"`
+	EpochLength             iotago.SlotIndex          `serix:"5"`
+	ProtocolUpgrades        []ProtocolUpgrade         `serix:"6,lengthPrefixType=uint16"`
+	MaxCommitteeSize        uint32                    `serix:"7"`
+	MinimumStake            uint64                    `serix:"8"`
 
 	storable.Struct[settingsModel, *settingsModel]
 }
@@ -0,0 +1,116 @@
+package permanent
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/iota-core/pkg/model"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestSettings_ProtocolUpgrades(t *testing.T) {
+	genesisParameters := iotago.ProtocolParameters{
+		Version:     3,
+		NetworkName: "test",
+		Bech32HRP:   "rms",
+		RentStructure: iotago.RentStructure{
+			VByteCost:    100,
+			VBFactorKey:  10,
+			VBFactorData: 1,
+		},
+		TokenSupply:           5000,
+		GenesisUnixTimestamp:  uint32(time.Now().Unix()),
+		SlotDurationInSeconds: 10,
+	}
+
+	upgradedParameters := genesisParameters
+	upgradedParameters.Version = 4
+	upgradedParameters.MinPoWScore = 10
+
+	settings := NewSettings(filepath.Join(t.TempDir(), "settings.bin"))
+	require.NoError(t, settings.SetProtocolParameters(genesisParameters))
+
+	// upgrades can only be scheduled once the epoch length is known.
+	require.Error(t, settings.ScheduleProtocolUpgrade(ProtocolUpgrade{StartEpoch: 2, Parameters: upgradedParameters}))
+	require.NoError(t, settings.SetEpochLength(10))
+
+	// upgrades must not change the slot timing or downgrade the version.
+	invalidParameters := upgradedParameters
+	invalidParameters.SlotDurationInSeconds = 5
+	require.Error(t, settings.ScheduleProtocolUpgrade(ProtocolUpgrade{StartEpoch: 2, Parameters: invalidParameters}))
+	invalidParameters = upgradedParameters
+	invalidParameters.Version = 2
+	require.Error(t, settings.ScheduleProtocolUpgrade(ProtocolUpgrade{StartEpoch: 2, Parameters: invalidParameters}))
+
+	require.NoError(t, settings.ScheduleProtocolUpgrade(ProtocolUpgrade{StartEpoch: 2, Parameters: upgradedParameters}))
+	require.NoError(t, settings.ScheduleProtocolUpgrade(ProtocolUpgrade{StartEpoch: 2, Parameters: upgradedParameters}))
+	require.Error(t, settings.ScheduleProtocolUpgrade(ProtocolUpgrade{StartEpoch: 2, Parameters: genesisParameters}))
+	require.Error(t, settings.SetEpochLength(20))
+	require.NoError(t, settings.SetMaxCommitteeSize(5))
+	require.NoError(t, settings.SetMinimumStake(100))
+
+	require.Equal(t, byte(3), settings.ProtocolParameters().Version)
+	require.Equal(t, byte(3), settings.ProtocolParametersForSlot(19).Version)
+	require.Equal(t, byte(4), settings.ProtocolParametersForSlot(20).Version)
+	require.Equal(t, settings.API(0), settings.API(19))
+	require.NotEqual(t, settings.API(19), settings.API(20))
+
+	upgrade, exists := settings.NextProtocolUpgrade()
+	require.True(t, exists)
+	require.Equal(t, uint64(2), upgrade.StartEpoch)
+
+	// the schedule is carried by the snapshot.
+	snapshotFile, err := os.Create(filepath.Join(t.TempDir(), "snapshot.bin"))
+	require.NoError(t, err)
+	defer snapshotFile.Close()
+
+	require.NoError(t, settings.Export(snapshotFile, iotago.NewEmptyCommitment()))
+	_, err = snapshotFile.Seek(0, 0)
+	require.NoError(t, err)
+
+	importedSettings := NewSettings(filepath.Join(t.TempDir(), "settings.bin"))
+	require.NoError(t, importedSettings.Import(snapshotFile))
+	require.Equal(t, iotago.SlotIndex(10), importedSettings.EpochLength())
+	require.Equal(t, uint32(5), importedSettings.MaxCommitteeSize())
+	require.Equal(t, uint64(100), importedSettings.MinimumStake())
+	require.Equal(t, settings.ProtocolUpgrades(), importedSettings.ProtocolUpgrades())
+
+	// once the upgrade is active, it becomes the current protocol version.
+	latestCommitment, err := model.CommitmentFromCommitment(iotago.NewCommitment(19, iotago.CommitmentID{}, iotago.Identifier{}, 0), importedSettings.API(19))
+	require.NoError(t, err)
+	require.NoError(t, importedSettings.SetLatestCommitment(latestCommitment))
+	require.Equal(t, byte(4), importedSettings.ProtocolParameters().Version)
+
+	_, exists = importedSettings.NextProtocolUpgrade()
+	require.False(t, exists)
+
+	// upgrades can not be scheduled in the past.
+	require.Error(t, importedSettings.ScheduleProtocolUpgrade(ProtocolUpgrade{StartEpoch: 1, Parameters: upgradedParameters}))
+}
+
+func TestSettings_ExportRewindsToTargetCommitment(t *testing.T) {
+	settings := NewSettings(filepath.Join(t.TempDir(), "settings.bin"))
+	require.NoError(t, settings.SetLatestStateMutationSlot(12))
+	require.NoError(t, settings.SetLatestFinalizedSlot(3))
+
+	snapshotFile, err := os.Create(filepath.Join(t.TempDir(), "snapshot.bin"))
+	require.NoError(t, err)
+	defer snapshotFile.Close()
+
+	targetCommitment := iotago.NewCommitment(9, iotago.CommitmentID{}, iotago.Identifier{}, 0)
+	require.NoError(t, settings.Export(snapshotFile, targetCommitment))
+
+	_, err = snapshotFile.Seek(0, 0)
+	require.NoError(t, err)
+
+	importedSettings := NewSettings(filepath.Join(t.TempDir(), "settings.bin"))
+	require.NoError(t, importedSettings.Import(snapshotFile))
+
+	// slots before the target commitment are kept, later slots are rewound to the target commitment.
+	require.Equal(t, iotago.SlotIndex(3), importedSettings.LatestFinalizedSlot())
+	require.Equal(t, iotago.SlotIndex(9), importedSettings.LatestStateMutationSlot())
+}
@@ -55,13 +55,15 @@ func (b *Blocks) ForEachBlockIDInSlot(consumer func(blockID iotago.BlockID) erro
 			return false
 		}
 
-		return consumer(blockID) != nil
+		innerErr = consumer(blockID)
+
+		return innerErr == nil
 	}); err != nil {
 		return errors.Wrapf(err, "failed to stream blockIDs for slot %s", b.slot)
 	}
 
 	if innerErr != nil {
-		return errors.Wrapf(innerErr, "failed to deserialize blockIDs for slot %s", b.slot)
+		return errors.Wrapf(innerErr, "failed to consume blockIDs for slot %s", b.slot)
 	}
 
 	return nil
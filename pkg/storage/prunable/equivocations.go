@@ -0,0 +1,58 @@
+package prunable
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/iota-core/pkg/model"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// Equivocations stores the evidence of the equivocations that were detected in a slot.
+type Equivocations struct {
+	slot  iotago.SlotIndex
+	store kvstore.KVStore
+
+	api iotago.API
+}
+
+// NewEquivocations creates a new Equivocations instance.
+func NewEquivocations(slot iotago.SlotIndex, store kvstore.KVStore, api iotago.API) *Equivocations {
+	return &Equivocations{
+		slot:  slot,
+		store: store,
+		api:   api,
+	}
+}
+
+// Store stores the given evidence.
+func (e *Equivocations) Store(equivocation *model.Equivocation) error {
+	if err := e.store.Set(equivocation.Key(), equivocation.Bytes()); err != nil {
+		return errors.Wrapf(err, "failed to store equivocation of issuer %s in slot %s", equivocation.IssuerID(), e.slot)
+	}
+
+	return nil
+}
+
+// ForEach iterates over all evidence that was stored for the slot.
+func (e *Equivocations) ForEach(consumer func(equivocation *model.Equivocation) error) error {
+	var innerErr error
+	if err := e.store.Iterate(kvstore.EmptyPrefix, func(_ kvstore.Key, value kvstore.Value) bool {
+		var equivocation *model.Equivocation
+		if equivocation, innerErr = model.EquivocationFromBytes(value, e.api); innerErr != nil {
+			return false
+		}
+
+		innerErr = consumer(equivocation)
+
+		return innerErr == nil
+	}); err != nil {
+		return errors.Wrapf(err, "failed to stream equivocations for slot %s", e.slot)
+	}
+
+	if innerErr != nil {
+		return errors.Wrapf(innerErr, "failed to consume equivocations for slot %s", e.slot)
+	}
+
+	return nil
+}
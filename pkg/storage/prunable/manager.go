@@ -77,6 +77,16 @@ func (m *Manager) Get(index iotago.SlotIndex, realm kvstore.Realm) kvstore.KVSto
 	return withRealm
 }
 
+// GetIfExists returns the store of the given realm of the slot like Get, but returns nil instead of creating the DB
+// instance of the slot if it does not exist yet.
+func (m *Manager) GetIfExists(index iotago.SlotIndex, realm kvstore.Realm) kvstore.KVStore {
+	if !m.dbInstanceExists(index) {
+		return nil
+	}
+
+	return m.Get(index, realm)
+}
+
 func (m *Manager) PruneUntilSlot(index iotago.SlotIndex) {
 	m.pruningMutex.Lock()
 	defer m.pruningMutex.Unlock()
@@ -98,6 +108,49 @@ func (m *Manager) PruneUntilSlot(index iotago.SlotIndex) {
 	}
 }
 
+// RollbackToSlot removes the buckets of all slots greater than the given index. The buckets of the DB instance that
+// contains the given index are cleared, while the DB instances of later slots are removed from disk.
+func (m *Manager) RollbackToSlot(index iotago.SlotIndex) error {
+	m.pruningMutex.Lock()
+	defer m.pruningMutex.Unlock()
+
+	if index < m.lastPrunedSlot.NextIndex() {
+		return errors.Errorf("cannot roll back to slot %d, it was already pruned", index)
+	}
+
+	db := m.getDBInstance(index)
+	for slot := index + 1; slot < db.index+iotago.SlotIndex(m.optsGranularity); slot++ {
+		if err := m.createBucket(db, slot).Clear(); err != nil {
+			return errors.Wrapf(err, "failed to clear bucket of slot %d", slot)
+		}
+	}
+
+	if err := db.store.Flush(); err != nil {
+		return errors.Wrapf(err, "failed to flush DB instance with base index %d", db.index)
+	}
+
+	baseIndexesToRemove := make(map[iotago.SlotIndex]struct{})
+	for _, dbInfo := range getSortedDBInstancesFromDisk(m.dbConfig.Directory) {
+		if dbInfo.baseIndex > index {
+			baseIndexesToRemove[dbInfo.baseIndex] = struct{}{}
+		}
+	}
+
+	m.openDBsMutex.Lock()
+	m.openDBs.Each(func(baseIndex iotago.SlotIndex, _ *dbInstance) {
+		if baseIndex > index {
+			baseIndexesToRemove[baseIndex] = struct{}{}
+		}
+	})
+	m.openDBsMutex.Unlock()
+
+	for baseIndex := range baseIndexesToRemove {
+		m.removeDBInstance(baseIndex)
+	}
+
+	return nil
+}
+
 func (m *Manager) Shutdown() {
 	m.openDBsMutex.Lock()
 	defer m.openDBsMutex.Unlock()
@@ -184,6 +237,23 @@ func (m *Manager) getDBInstance(index iotago.SlotIndex) (db *dbInstance) {
 	return db
 }
 
+// dbInstanceExists returns whether the DB instance of the given index is open or was already created on disk.
+func (m *Manager) dbInstanceExists(index iotago.SlotIndex) bool {
+	baseIndex := m.computeDBBaseIndex(index)
+
+	m.openDBsMutex.Lock()
+	_, isOpen := m.openDBs.Get(baseIndex)
+	m.openDBsMutex.Unlock()
+
+	if isOpen {
+		return true
+	}
+
+	_, err := os.Stat(dbPathFromIndex(m.dbConfig.Directory, baseIndex))
+
+	return err == nil
+}
+
 // getBucket returns the bucket for the given baseIndex or creates a new one if it does not yet exist.
 // A bucket is marked as dirty by default.
 // Buckets are created as follows (assuming a bucket granularity=2):
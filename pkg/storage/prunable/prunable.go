@@ -11,6 +11,10 @@ const (
 	blocksPrefix byte = iota
 	rootBlocksPrefix
 	attestationsPrefix
+	rootsPrefix
+	equivocationsPrefix
+	ratifiedAcceptedBlocksPrefix
+	taggedDataPrefix
 )
 
 type Prunable struct {
@@ -56,11 +60,48 @@ func (p *Prunable) Attestations(slot iotago.SlotIndex) kvstore.KVStore {
 	return p.manager.Get(slot, kvstore.Realm{attestationsPrefix})
 }
 
+func (p *Prunable) RatifiedAcceptedBlocks(slot iotago.SlotIndex) kvstore.KVStore {
+	return p.manager.Get(slot, kvstore.Realm{ratifiedAcceptedBlocksPrefix})
+}
+
+func (p *Prunable) TaggedData(slot iotago.SlotIndex) kvstore.KVStore {
+	return p.manager.Get(slot, kvstore.Realm{taggedDataPrefix})
+}
+
+// TaggedDataIfExists returns the tagged data index of the given slot without creating the storage of the slot if it
+// does not exist yet.
+func (p *Prunable) TaggedDataIfExists(slot iotago.SlotIndex) kvstore.KVStore {
+	return p.manager.GetIfExists(slot, kvstore.Realm{taggedDataPrefix})
+}
+
+func (p *Prunable) Roots(slot iotago.SlotIndex) *Roots {
+	store := p.manager.Get(slot, kvstore.Realm{rootsPrefix})
+	if store == nil {
+		return nil
+	}
+
+	return NewRoots(slot, store, p.api)
+}
+
+func (p *Prunable) Equivocations(slot iotago.SlotIndex) *Equivocations {
+	store := p.manager.Get(slot, kvstore.Realm{equivocationsPrefix})
+	if store == nil {
+		return nil
+	}
+
+	return NewEquivocations(slot, store, p.api)
+}
+
 // PruneUntilSlot prunes storage slots less than and equal to the given index.
 func (p *Prunable) PruneUntilSlot(index iotago.SlotIndex) {
 	p.manager.PruneUntilSlot(index)
 }
 
+// RollbackToSlot removes the storage slots greater than the given index.
+func (p *Prunable) RollbackToSlot(index iotago.SlotIndex) error {
+	return p.manager.RollbackToSlot(index)
+}
+
 func (p *Prunable) Size() int64 {
 	return p.manager.PrunableStorageSize()
 }
@@ -0,0 +1,52 @@
+package prunable
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/kvstore"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+var rootsKey = []byte{0}
+
+// Roots stores the roots of the commitment of a slot.
+type Roots struct {
+	slot  iotago.SlotIndex
+	store kvstore.KVStore
+
+	api iotago.API
+}
+
+// NewRoots creates a new Roots instance.
+func NewRoots(slot iotago.SlotIndex, store kvstore.KVStore, api iotago.API) *Roots {
+	return &Roots{
+		slot:  slot,
+		store: store,
+		api:   api,
+	}
+}
+
+// Store stores the given roots.
+func (r *Roots) Store(roots *iotago.Roots) error {
+	rootsBytes, err := r.api.Encode(roots)
+	if err != nil {
+		return errors.Wrapf(err, "failed to encode roots for slot %s", r.slot)
+	}
+
+	return r.store.Set(rootsKey, rootsBytes)
+}
+
+// Load loads the roots of the slot.
+func (r *Roots) Load() (*iotago.Roots, error) {
+	rootsBytes, err := r.store.Get(rootsKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get roots for slot %s", r.slot)
+	}
+
+	roots := new(iotago.Roots)
+	if _, err = r.api.Decode(rootsBytes, roots); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode roots for slot %s", r.slot)
+	}
+
+	return roots, nil
+}
@@ -55,7 +55,7 @@ func New(directory string, dbVersion byte, errorHandler func(error), opts ...opt
 			s.Prunable = prunable.New(dbConfig.WithDirectory(s.dir.PathWithCreate(prunableDirName)), errorHandler, s.optsPrunableManagerOptions...)
 
 			s.Permanent.Settings().HookInitialized(func() {
-				s.Prunable.Initialize(s.Settings().API())
+				s.Prunable.Initialize(s.Settings().CurrentAPI())
 			})
 		})
 }
@@ -65,4 +65,8 @@ func TestBooker(t *testing.T) {
 
 	require.EqualValues(t, 0, genesisOutput.SlotIndexBooked())
 	require.EqualValues(t, 101, newOutput.SlotIndexBooked())
+
+	// Only the transactions of committed slots are part of the committed ledger state.
+	require.True(t, lo.PanicOnErr(node1.Protocol.MainEngineInstance().Ledger.TransactionCommitted(iotago.TransactionID{})))
+	require.False(t, lo.PanicOnErr(node1.Protocol.MainEngineInstance().Ledger.TransactionCommitted(lo.PanicOnErr(transaction.ID()))))
 }
@@ -10,9 +10,11 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotaledger/hive.go/core/eventticker"
+	"github.com/iotaledger/hive.go/runtime/event"
 	"github.com/iotaledger/hive.go/runtime/options"
 	"github.com/iotaledger/iota-core/pkg/protocol"
 	"github.com/iotaledger/iota-core/pkg/protocol/chainmanager"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/notarization/slotnotarization"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/sybilprotection/poa"
 	"github.com/iotaledger/iota-core/pkg/testsuite"
@@ -200,17 +202,40 @@ func TestProtocol_EngineSwitching(t *testing.T) {
 
 	wg := &sync.WaitGroup{}
 
-	detectFork := func(node *mock.Node, done func()) {
+	// The nodes keep issuing blocks until the nodes of the lighter partition switched to the heavier chain, so that
+	// their candidate engines receive the blocks that are needed to commit the slots of the heavier chain.
+	activityCtx, activityCancel := context.WithCancel(context.Background())
+	defer activityCancel()
+
+	enginesSwitched := &sync.WaitGroup{}
+	enginesSwitched.Add(2)
+	for _, node := range []*mock.Node{node3, node4} {
+		node.Protocol.Events.MainEngineSwitched.Hook(func(_ *engine.Engine) {
+			enginesSwitched.Done()
+		}, event.WithMaxTriggerCount(1))
+	}
+
+	go func() {
+		enginesSwitched.Wait()
+		activityCancel()
+	}()
+
+	detectFork := func(node *mock.Node) {
 		forkDetected := make(chan struct{}, 1)
 		node.Protocol.Events.ChainManager.ForkDetected.Hook(func(fork *chainmanager.Fork) {
-			forkDetected <- struct{}{}
+			select {
+			case forkDetected <- struct{}{}:
+			default:
+			}
 		})
 
+		wg.Add(1)
 		go func() {
+			defer wg.Done()
+
 			require.Eventually(t, func() bool {
 				select {
 				case <-forkDetected:
-					done()
 					return true
 				default:
 					return false
@@ -218,32 +243,29 @@ func TestProtocol_EngineSwitching(t *testing.T) {
 			}, 25*time.Second, 10*time.Millisecond)
 		}()
 	}
-	node1Ctx, node1Cancel := context.WithCancel(context.Background())
-	defer node1Cancel()
-
-	node2Ctx, node2Cancel := context.WithCancel(context.Background())
-	defer node2Cancel()
 
-	node3Ctx, node3Cancel := context.WithCancel(context.Background())
-	defer node3Cancel()
-
-	node4Ctx, node4Cancel := context.WithCancel(context.Background())
-	defer node4Cancel()
-
-	detectFork(node1, node1Cancel)
-	detectFork(node2, node2Cancel)
-	detectFork(node3, node3Cancel)
-	detectFork(node4, node4Cancel)
+	detectFork(node1)
+	detectFork(node2)
+	detectFork(node3)
+	detectFork(node4)
 
 	// Issue blocks after merging the networks
 	{
 		wg.Add(4)
 
-		node1.IssueActivity(node1Ctx, 25*time.Second, wg)
-		node2.IssueActivity(node2Ctx, 25*time.Second, wg)
-		node3.IssueActivity(node3Ctx, 25*time.Second, wg)
-		node4.IssueActivity(node4Ctx, 25*time.Second, wg)
+		node1.IssueActivity(activityCtx, 25*time.Second, wg)
+		node2.IssueActivity(activityCtx, 25*time.Second, wg)
+		node3.IssueActivity(activityCtx, 25*time.Second, wg)
+		node4.IssueActivity(activityCtx, 25*time.Second, wg)
 	}
 
 	wg.Wait()
+
+	// The nodes of the lighter partition should have switched to the heavier chain.
+	ts.AssertEqualStoredCommitmentAtIndex(8, ts.Nodes()...)
+
+	// The chain of the main engine is the main chain of the chain manager on all nodes.
+	for _, node := range ts.Nodes() {
+		require.Equal(t, node.Protocol.ChainManager.RootCommitment().Chain().ForkingPoint.ID(), node.Protocol.MainEngineInstance().ChainID(), "node %s", node.Name)
+	}
 }
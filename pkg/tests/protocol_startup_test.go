@@ -147,6 +147,10 @@ func TestProtocol_StartNodeFromSnapshotAndDisk(t *testing.T) {
 		)
 		require.Equal(t, node1.Protocol.MainEngineInstance().Storage.Settings().LatestCommitment().Commitment(), node2.Protocol.MainEngineInstance().Storage.Settings().LatestCommitment().Commitment())
 
+		// Only the blocks of committed slots are included in a commitment.
+		ts.AssertStorageBlocksIncluded(ts.Blocks("1.1", "1.2", "1.1*"), true, ts.Nodes()...)
+		ts.AssertStorageBlocksIncluded(ts.Blocks("2.2", "2.2*"), false, ts.Nodes()...)
+
 		// Slot 7-8
 		{
 			slot1Commitment := lo.PanicOnErr(node1.Protocol.MainEngineInstance().Storage.Commitments().Load(1)).Commitment()
@@ -427,5 +431,24 @@ func TestProtocol_StartNodeFromSnapshotAndDisk(t *testing.T) {
 			ts.AssertBlocksInCacheRatifiedAccepted(ts.Blocks("13.1"), true, ts.Nodes()...)
 			ts.AssertBlocksInCacheConfirmed(ts.Blocks("13.1"), true, ts.Nodes()...)
 		}
+
+		// Slot 17-20
+		{
+			node21 := ts.Node("node2.1")
+			slot11Commitment := lo.PanicOnErr(node1.Protocol.MainEngineInstance().Storage.Commitments().Load(11)).Commitment()
+			ts.IssueBlockAtSlot("17.1", 17, slot11Commitment, node1, ts.BlockID("16.2"))
+			ts.IssueBlockAtSlot("18.2", 18, slot11Commitment, node21, ts.BlockID("17.1"))
+			ts.IssueBlockAtSlot("19.1", 19, slot11Commitment, node1, ts.BlockID("18.2"))
+			ts.IssueBlockAtSlot("20.2", 20, slot11Commitment, node21, ts.BlockID("19.1"))
+
+			ts.AssertBlocksExist(ts.Blocks("17.1", "18.2", "19.1", "20.2"), true, ts.Nodes()...)
+			ts.AssertBlocksInCacheRatifiedAccepted(ts.Blocks("16.2", "17.1"), true, ts.Nodes()...)
+		}
+
+		// Verify that node3 commits the slots after the snapshot in the same way as the nodes that started from genesis.
+		ts.AssertLatestCommitmentSlotIndex(15, ts.Nodes()...)
+		for slot := iotago.SlotIndex(10); slot <= 15; slot++ {
+			ts.AssertEqualStoredCommitmentAtIndex(slot, ts.Nodes()...)
+		}
 	}
 }
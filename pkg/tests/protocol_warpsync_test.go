@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/core/eventticker"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/network"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/notarization/slotnotarization"
+	"github.com/iotaledger/iota-core/pkg/protocol/warpsync"
+	"github.com/iotaledger/iota-core/pkg/testsuite"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestProtocol_WarpSyncRequest(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	defer ts.Shutdown()
+
+	node1 := ts.AddValidatorNode("node1", 50)
+	node2 := ts.AddValidatorNode("node2", 50)
+
+	ts.Run(map[string][]options.Option[protocol.Protocol]{
+		"node1": {
+			protocol.WithNotarizationProvider(
+				slotnotarization.NewProvider(slotnotarization.WithMinCommittableSlotAge(1)),
+			),
+		},
+		"node2": {
+			protocol.WithNotarizationProvider(
+				slotnotarization.NewProvider(slotnotarization.WithMinCommittableSlotAge(1)),
+			),
+		},
+	})
+	ts.HookLogging()
+
+	ts.Wait()
+
+	ts.AssertNodeState(ts.Nodes(),
+		testsuite.WithSnapshotImported(true),
+		testsuite.WithLatestCommitment(iotago.NewEmptyCommitment()),
+	)
+
+	// Issue blocks until slot 1 is committed.
+	var lateBlock *model.Block
+	{
+		ts.IssueBlockAtSlot("1.1", 1, iotago.NewEmptyCommitment(), node1, iotago.EmptyBlockID())
+		ts.IssueBlockAtSlot("1.2", 1, iotago.NewEmptyCommitment(), node2, iotago.EmptyBlockID())
+		lateBlock = node1.CreateBlockAtSlot("1.3", 1, iotago.NewEmptyCommitment(), ts.BlockID("1.1"))
+		ts.IssueBlockAtSlot("2.2", 2, iotago.NewEmptyCommitment(), node2, ts.BlockIDs("1.1", "1.2")...)
+		ts.IssueBlockAtSlot("3.1", 3, iotago.NewEmptyCommitment(), node1, ts.BlockID("2.2"))
+		ts.IssueBlockAtSlot("4.2", 4, iotago.NewEmptyCommitment(), node2, ts.BlockID("3.1"))
+		ts.IssueBlockAtSlot("5.1", 5, iotago.NewEmptyCommitment(), node1, ts.BlockID("4.2"))
+		ts.IssueBlockAtSlot("6.2", 6, iotago.NewEmptyCommitment(), node2, ts.BlockID("5.1"))
+
+		ts.AssertNodeState(ts.Nodes(),
+			testsuite.WithLatestCommitmentSlotIndex(1),
+		)
+	}
+
+	commitment, err := node1.Protocol.MainEngineInstance().Storage.Commitments().Load(1)
+	require.NoError(t, err)
+
+	// A block of slot 1 that is accepted after the slot was committed ends up in the block storage of the slot, but it is
+	// not part of the TangleRoot of the commitment.
+	require.NoError(t, node1.Protocol.MainEngineInstance().Storage.Blocks(1).Store(lateBlock))
+
+	manager := warpsync.NewManager(warpsync.WithSlotRequesterOptions(eventticker.RetryInterval[iotago.SlotIndex, iotago.CommitmentID](time.Hour)))
+	defer manager.Shutdown()
+
+	var verifiedBlockIDs iotago.BlockIDs
+	manager.Events.SlotVerified.Hook(func(_ *model.Commitment, blockIDs iotago.BlockIDs) {
+		verifiedBlockIDs = blockIDs
+	})
+	manager.RequestSlot(commitment)
+
+	responseErrors := make(chan error, 1)
+	node2.Protocol.Events.Network.WarpSyncResponseReceived.Hook(func(commitmentID iotago.CommitmentID, blockIDs iotago.BlockIDs, roots *iotago.Roots, source network.PeerID) {
+		if commitmentID == commitment.ID() && source == node1.PeerID {
+			responseErrors <- manager.ProcessResponse(commitmentID, blockIDs, roots)
+		}
+	})
+
+	node1.Protocol.ProcessWarpSyncRequest(commitment.ID(), node2.PeerID)
+
+	select {
+	case err = <-responseErrors:
+		require.NoError(t, err)
+		require.ElementsMatch(t, ts.BlockIDs("1.1", "1.2"), verifiedBlockIDs)
+	case <-time.After(10 * time.Second):
+		require.FailNow(t, "node2 did not receive the warp sync response of node1")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/ed25519"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/iotaledger/iota-core/pkg/blockissuer"
 	"github.com/iotaledger/iota-core/pkg/model"
 	"github.com/iotaledger/iota-core/pkg/network"
+	"github.com/iotaledger/iota-core/pkg/network/protocols/core"
 	"github.com/iotaledger/iota-core/pkg/protocol"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
@@ -26,6 +28,10 @@ import (
 	"github.com/iotaledger/iota.go/v4/builder"
 )
 
+// issuingTimeOffset is increased for every created block and added to the start time of its slot, so that the blocks of
+// a node never share their issuing time, which would be considered an equivocation.
+var issuingTimeOffset atomic.Int64
+
 type Node struct {
 	Testing *testing.T
 
@@ -87,6 +93,16 @@ func (n *Node) HookLogging() {
 
 	n.attachEngineLogs(n.Protocol.MainEngineInstance())
 
+	events.CandidateEngineActivated.Hook(func(e *engine.Engine) {
+		fmt.Printf("%s > CandidateEngineActivated: %s, ChainID:%s Index:%s\n", n.Name, e.Name()[:8], e.ChainID(), e.ChainID().Index())
+
+		n.attachEngineLogs(e)
+	})
+
+	events.MainEngineSwitched.Hook(func(e *engine.Engine) {
+		fmt.Printf("%s > MainEngineSwitched: %s, ChainID:%s Index:%s\n", n.Name, e.Name()[:8], e.ChainID(), e.ChainID().Index())
+	})
+
 	events.Network.BlockReceived.Hook(func(block *model.Block, source identity.ID) {
 		fmt.Printf("%s > Network.BlockReceived: from %s %s - %d\n", n.Name, source, block.ID(), block.ID().Index())
 	})
@@ -95,14 +111,26 @@ func (n *Node) HookLogging() {
 		fmt.Printf("%s > Network.BlockRequestReceived: from %s %s\n", n.Name, source, blockID)
 	})
 
-	// events.Network.AttestationsReceived.Hook(func(event *network.AttestationsReceivedEvent) {
-	// 	fmt.Printf("%s > Network.AttestationsReceived: from %s for %s\n", n.Name, event.Source, event.Commitment.ID())
-	// })
-	//
-	// events.Network.AttestationsRequestReceived.Hook(func(event *network.AttestationsRequestReceivedEvent) {
-	// 	fmt.Printf("%s > Network.AttestationsRequestReceived: from %s %s -> %d\n", n.Name, event.Source, event.Commitment.ID(), event.EndIndex)
-	// })
-	//
+	events.Network.AttestationsReceived.Hook(func(event *core.AttestationsReceivedEvent) {
+		fmt.Printf("%s > Network.AttestationsReceived: from %s for %s\n", n.Name, event.Source, event.ForkingPoint.ID())
+	})
+
+	events.Network.AttestationsRequestReceived.Hook(func(event *core.AttestationsRequestReceivedEvent) {
+		fmt.Printf("%s > Network.AttestationsRequestReceived: from %s %s -> %d\n", n.Name, event.Source, event.Commitment.MustID(), event.EndIndex)
+	})
+
+	events.Network.WarpSyncRequestReceived.Hook(func(commitmentID iotago.CommitmentID, source identity.ID) {
+		fmt.Printf("%s > Network.WarpSyncRequestReceived: from %s %s\n", n.Name, source, commitmentID)
+	})
+
+	events.Network.WarpSyncResponseReceived.Hook(func(commitmentID iotago.CommitmentID, blockIDs iotago.BlockIDs, _ *iotago.Roots, source identity.ID) {
+		fmt.Printf("%s > Network.WarpSyncResponseReceived: from %s %s with %d blocks\n", n.Name, source, commitmentID, len(blockIDs))
+	})
+
+	events.WarpSync.SlotVerified.Hook(func(commitment *model.Commitment, blockIDs iotago.BlockIDs) {
+		fmt.Printf("%s > WarpSync.SlotVerified: %s with %d blocks\n", n.Name, commitment.ID(), len(blockIDs))
+	})
+
 	// events.Network.SlotCommitmentReceived.Hook(func(event *network.SlotCommitmentReceivedEvent) {
 	// 	fmt.Printf("%s > Network.SlotCommitmentReceived: from %s %s\n", n.Name, event.Source, event.Commitment.ID())
 	// })
@@ -148,6 +176,22 @@ func (n *Node) attachEngineLogs(instance *engine.Engine) {
 		fmt.Printf("%s > [%s] Booker.BlockBooked: %s\n", n.Name, engineName, block.ID())
 	})
 
+	events.Booker.BlockInvalid.Hook(func(block *blocks.Block, err error) {
+		fmt.Printf("%s > [%s] Booker.BlockInvalid: %s - %s\n", n.Name, engineName, block.ID(), err)
+	})
+
+	events.Scheduler.BlockScheduled.Hook(func(block *blocks.Block) {
+		fmt.Printf("%s > [%s] Scheduler.BlockScheduled: %s\n", n.Name, engineName, block.ID())
+	})
+
+	events.Scheduler.BlockDropped.Hook(func(block *blocks.Block, err error) {
+		fmt.Printf("%s > [%s] Scheduler.BlockDropped: %s - %s\n", n.Name, engineName, block.ID(), err.Error())
+	})
+
+	events.Scheduler.BlockSkipped.Hook(func(block *blocks.Block) {
+		fmt.Printf("%s > [%s] Scheduler.BlockSkipped: %s\n", n.Name, engineName, block.ID())
+	})
+
 	events.Clock.AcceptedTimeUpdated.Hook(func(newTime time.Time) {
 		fmt.Printf("%s > [%s] Clock.AcceptedTimeUpdated: %s\n", n.Name, engineName, newTime)
 	})
@@ -224,14 +268,28 @@ func (n *Node) IssueBlock(alias string, opts ...options.Option[blockissuer.Block
 }
 
 func (n *Node) IssueBlockAtSlot(alias string, slot iotago.SlotIndex, slotCommitment *iotago.Commitment, parents ...iotago.BlockID) *blocks.Block {
-	slotTimeProvider := n.Protocol.MainEngineInstance().Storage.Settings().API().SlotTimeProvider()
-	issuingTime := slotTimeProvider.StartTime(slot)
+	return n.IssueBlock(alias, n.blockAtSlotOptions(slot, slotCommitment, parents...)...)
+}
+
+// CreateBlockAtSlot creates a block in the given slot without issuing it.
+func (n *Node) CreateBlockAtSlot(alias string, slot iotago.SlotIndex, slotCommitment *iotago.Commitment, parents ...iotago.BlockID) *model.Block {
+	modelBlock, err := n.blockIssuer.CreateBlock(context.Background(), n.blockAtSlotOptions(slot, slotCommitment, parents...)...)
+	require.NoError(n.Testing, err)
+
+	modelBlock.ID().RegisterAlias(alias)
+
+	return modelBlock
+}
+
+func (n *Node) blockAtSlotOptions(slot iotago.SlotIndex, slotCommitment *iotago.Commitment, parents ...iotago.BlockID) []options.Option[blockissuer.BlockParams] {
+	slotTimeProvider := n.Protocol.MainEngineInstance().Storage.Settings().CurrentAPI().SlotTimeProvider()
+	issuingTime := slotTimeProvider.StartTime(slot).Add(time.Duration(issuingTimeOffset.Add(1)))
 	require.Truef(n.Testing, issuingTime.Before(time.Now()), "node: %s: issued block (%s, slot: %d) is in the current (%s, slot: %d) or future slot", n.Name, issuingTime, slot, time.Now(), slotTimeProvider.IndexFromTime(time.Now()))
 
 	parentReferences := make(model.ParentReferences)
 	parentReferences[model.StrongParentType] = parents
 
-	return n.IssueBlock(alias, blockissuer.WithIssuingTime(issuingTime), blockissuer.WithSlotCommitment(slotCommitment), blockissuer.WithReferences(parentReferences))
+	return []options.Option[blockissuer.BlockParams]{blockissuer.WithIssuingTime(issuingTime), blockissuer.WithSlotCommitment(slotCommitment), blockissuer.WithReferences(parentReferences)}
 }
 
 func (n *Node) IssueActivity(ctx context.Context, duration time.Duration, wg *sync.WaitGroup) {
@@ -5,6 +5,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
 	"github.com/iotaledger/iota-core/pkg/testsuite/mock"
 )
 
@@ -51,3 +52,24 @@ func (t *TestSuite) AssertStorageBlockExist(block *model.Block, expectedExist bo
 		})
 	}
 }
+
+func (t *TestSuite) AssertStorageBlocksIncluded(blocks []*blocks.Block, expectedIncluded bool, nodes ...*mock.Node) {
+	mustNodes(nodes)
+
+	for _, node := range nodes {
+		for _, block := range blocks {
+			t.Eventually(func() error {
+				included, err := node.Protocol.MainEngineInstance().Notarization.IsBlockIncluded(block.ID())
+				if err != nil {
+					return errors.Wrapf(err, "AssertStorageBlocksIncluded: %s: error checking inclusion of block %s", node.Name, block.ID())
+				}
+
+				if expectedIncluded != included {
+					return errors.Errorf("AssertStorageBlocksIncluded: %s: block %s: expected %v, got %v", node.Name, block.ID(), expectedIncluded, included)
+				}
+
+				return nil
+			})
+		}
+	}
+}
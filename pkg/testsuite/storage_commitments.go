@@ -28,3 +28,31 @@ func (t *TestSuite) AssertStorageCommitments(commitments []*iotago.Commitment, n
 		}
 	}
 }
+
+func (t *TestSuite) AssertEqualStoredCommitmentAtIndex(index iotago.SlotIndex, nodes ...*mock.Node) {
+	mustNodes(nodes)
+
+	t.Eventually(func() error {
+		var commitment *iotago.Commitment
+		var commitmentNode *mock.Node
+		for _, node := range nodes {
+			storedCommitment, err := node.Protocol.MainEngineInstance().Storage.Commitments().Load(index)
+			if err != nil {
+				return errors.Wrapf(err, "AssertEqualStoredCommitmentAtIndex: %s: error loading commitment for slot: %d", node.Name, index)
+			}
+
+			if commitment == nil {
+				commitment = storedCommitment.Commitment()
+				commitmentNode = node
+
+				continue
+			}
+
+			if !cmp.Equal(*commitment, *storedCommitment.Commitment()) {
+				return errors.Errorf("AssertEqualStoredCommitmentAtIndex: %s: expected %s (from %s), got %s", node.Name, commitment, commitmentNode.Name, storedCommitment)
+			}
+		}
+
+		return nil
+	})
+}
@@ -11,4 +11,12 @@ pushd tools/genesis-snapshot
 go mod tidy
 popd
 
+pushd tools/snapshot-inspect
+go mod tidy
+popd
+
+pushd tools/ledger-rollback
+go mod tidy
+popd
+
 popd
\ No newline at end of file
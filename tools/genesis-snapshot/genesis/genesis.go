@@ -0,0 +1,299 @@
+// Package genesis loads declarative genesis definitions that describe the initial state of a network, so that new
+// networks can be bootstrapped without having to add a preset to the code.
+package genesis
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/iota-core/pkg/protocol/snapshotcreator"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// Definition is the declarative definition of the genesis of a network.
+type Definition struct {
+	// ProtocolParameters are the protocol parameters of the network in the JSON format of the protocol.
+	ProtocolParameters json.RawMessage `json:"protocolParameters"`
+	// EpochLength defines the amount of slots per epoch (the default of the snapshot creator is used if it is 0).
+	EpochLength uint64 `json:"epochLength"`
+	// MaxCommitteeSize defines the maximum amount of validators in the committee (the default of the snapshot creator
+	// is used if it is 0).
+	MaxCommitteeSize uint32 `json:"maxCommitteeSize"`
+	// MinimumStake defines the minimum amount of funds that a validator needs to hold to be selected for the committee
+	// (the default of the snapshot creator is used if it is 0).
+	MinimumStake uint64 `json:"minimumStake"`
+	// Outputs are the outputs that are created in the genesis.
+	Outputs []*Output `json:"outputs"`
+	// Validators are the accounts that are part of the committee.
+	Validators []*Validator `json:"validators"`
+	// RootBlocks are the initial blocks to which new blocks can attach to.
+	RootBlocks []*RootBlock `json:"rootBlocks"`
+
+	protocolParameters iotago.ProtocolParameters
+	outputs            []iotago.Output
+	validators         map[iotago.AccountID]int64
+	rootBlocks         map[iotago.BlockID]iotago.CommitmentID
+}
+
+// Output defines an output of the genesis. It is either a basic output that is defined by an address and an amount or
+// an arbitrary output (basic, alias, foundry or NFT) in the JSON format of the protocol.
+type Output struct {
+	Address string          `json:"address,omitempty"`
+	Amount  uint64          `json:"amount,omitempty"`
+	Output  json.RawMessage `json:"output,omitempty"`
+}
+
+// Validator defines an account that is part of the committee.
+type Validator struct {
+	AccountID string `json:"accountId"`
+	Weight    int64  `json:"weight"`
+}
+
+// RootBlock defines a block to which new blocks can attach to and the commitment that it commits to.
+type RootBlock struct {
+	BlockID      string `json:"blockId"`
+	CommitmentID string `json:"commitmentId"`
+}
+
+// Load loads the genesis definition from the JSON or YAML file at the given path. The format is determined by the
+// extension of the file.
+func Load(filePath string) (definition *Definition, err error) {
+	fileBytes, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read genesis file")
+	}
+
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".yaml", ".yml":
+		if fileBytes, err = yamlToJSON(fileBytes); err != nil {
+			return nil, errors.Wrap(err, "failed to parse genesis file")
+		}
+	case ".json":
+	default:
+		return nil, errors.Errorf("unsupported format of genesis file %s: expected .json, .yaml or .yml", filePath)
+	}
+
+	definition = new(Definition)
+	if err = json.Unmarshal(fileBytes, definition); err != nil {
+		return nil, errors.Wrap(err, "failed to parse genesis file")
+	}
+
+	if err = definition.parse(); err != nil {
+		return nil, errors.Wrap(err, "invalid genesis definition")
+	}
+
+	return definition, nil
+}
+
+// Validate checks that the genesis definition describes a valid initial state of the network.
+func (d *Definition) Validate() error {
+	if d.protocolParameters.NetworkName == "" {
+		return errors.New("the network name must not be empty")
+	}
+
+	if d.protocolParameters.SlotDurationInSeconds == 0 {
+		return errors.New("the slot duration must not be zero")
+	}
+
+	if len(d.outputs) == 0 {
+		return errors.New("the genesis must contain at least one output")
+	}
+
+	if len(d.outputs) > snapshotcreator.MaxGenesisOutputs {
+		return errors.Errorf("the genesis must not contain more than %d outputs", snapshotcreator.MaxGenesisOutputs)
+	}
+
+	outputs := make(iotago.TxEssenceOutputs, len(d.outputs))
+	for i, output := range d.outputs {
+		// the native tokens are limited per transaction, so they are only checked per output as the genesis outputs are
+		// not created by a transaction.
+		if err := iotago.SyntacticallyValidateOutputs(iotago.TxEssenceOutputs{output}, iotago.OutputsSyntacticalNativeTokens()); err != nil {
+			return errors.Wrapf(err, "invalid native tokens in output %d", i)
+		}
+
+		outputs[i] = output
+	}
+
+	// the deposit check also makes sure that every output covers its storage deposit and that the sum of the outputs does
+	// not exceed the token supply.
+	if err := iotago.SyntacticallyValidateOutputs(outputs,
+		iotago.OutputsSyntacticalDepositAmount(&d.protocolParameters),
+		iotago.OutputsSyntacticalExpirationAndTimelock(),
+		iotago.OutputsSyntacticalChainConstrainedOutputUniqueness(),
+		iotago.OutputsSyntacticalFoundry(),
+		iotago.OutputsSyntacticalAlias(),
+		iotago.OutputsSyntacticalNFT(),
+	); err != nil {
+		return errors.Wrap(err, "invalid genesis outputs")
+	}
+
+	var totalAmount uint64
+	for _, output := range d.outputs {
+		totalAmount += output.Deposit()
+	}
+
+	if totalAmount != d.protocolParameters.TokenSupply {
+		return errors.Errorf("the total amount of the outputs (%d) does not match the token supply (%d)", totalAmount, d.protocolParameters.TokenSupply)
+	}
+
+	for accountID, weight := range d.validators {
+		if weight <= 0 {
+			return errors.Errorf("the weight of validator %s must be positive", accountID)
+		}
+	}
+
+	return nil
+}
+
+// Options returns the options of the snapshot creator that create the snapshot described by the genesis definition.
+func (d *Definition) Options() []options.Option[snapshotcreator.Options] {
+	opts := []options.Option[snapshotcreator.Options]{
+		snapshotcreator.WithProtocolParameters(d.protocolParameters),
+		snapshotcreator.WithGenesisOutputs(d.outputs...),
+		snapshotcreator.WithValidators(d.validators),
+	}
+
+	if d.EpochLength != 0 {
+		opts = append(opts, snapshotcreator.WithEpochLength(iotago.SlotIndex(d.EpochLength)))
+	}
+
+	if d.MaxCommitteeSize != 0 {
+		opts = append(opts, snapshotcreator.WithMaxCommitteeSize(d.MaxCommitteeSize))
+	}
+
+	if d.MinimumStake != 0 {
+		opts = append(opts, snapshotcreator.WithMinimumStake(d.MinimumStake))
+	}
+
+	if len(d.rootBlocks) != 0 {
+		opts = append(opts, snapshotcreator.WithRootBlocks(d.rootBlocks))
+	}
+
+	return opts
+}
+
+func (d *Definition) parse() (err error) {
+	if len(d.ProtocolParameters) == 0 {
+		return errors.New("missing protocol parameters")
+	}
+
+	// the protocol parameters do not influence the JSON format, so any parameters can be used to decode them.
+	if err = iotago.LatestAPI(&iotago.ProtocolParameters{}).JSONDecode(d.ProtocolParameters, &d.protocolParameters); err != nil {
+		return errors.Wrap(err, "failed to parse protocol parameters")
+	}
+
+	if d.protocolParameters.GenesisUnixTimestamp == 0 {
+		d.protocolParameters.GenesisUnixTimestamp = uint32(time.Now().Unix())
+	}
+
+	api := iotago.LatestAPI(&d.protocolParameters)
+
+	d.outputs = make([]iotago.Output, len(d.Outputs))
+	for i, output := range d.Outputs {
+		if d.outputs[i], err = output.parse(api, d.protocolParameters.Bech32HRP); err != nil {
+			return errors.Wrapf(err, "failed to parse output %d", i)
+		}
+	}
+
+	d.validators = make(map[iotago.AccountID]int64)
+	for i, validator := range d.Validators {
+		accountID, err := iotago.IdentifierFromHexString(validator.AccountID)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse account ID of validator %d", i)
+		}
+
+		if _, exists := d.validators[accountID]; exists {
+			return errors.Errorf("validator %s is defined more than once", accountID)
+		}
+
+		d.validators[accountID] = validator.Weight
+	}
+
+	d.rootBlocks = make(map[iotago.BlockID]iotago.CommitmentID)
+	for i, rootBlock := range d.RootBlocks {
+		blockID, err := iotago.SlotIdentifierFromHexString(rootBlock.BlockID)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse block ID of root block %d", i)
+		}
+
+		commitmentID, err := iotago.SlotIdentifierFromHexString(rootBlock.CommitmentID)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse commitment ID of root block %d", i)
+		}
+
+		d.rootBlocks[blockID] = commitmentID
+	}
+
+	return nil
+}
+
+func (o *Output) parse(api iotago.API, bech32HRP iotago.NetworkPrefix) (output iotago.Output, err error) {
+	if len(o.Output) == 0 {
+		return o.parseBasicOutput(bech32HRP)
+	}
+
+	if o.Address != "" || o.Amount != 0 {
+		return nil, errors.New("an output must either define an address and an amount or an output")
+	}
+
+	var outputType struct {
+		Type iotago.OutputType `json:"type"`
+	}
+	if err = json.Unmarshal(o.Output, &outputType); err != nil {
+		return nil, errors.Wrap(err, "failed to parse output type")
+	}
+
+	switch outputType.Type {
+	case iotago.OutputBasic:
+		output = new(iotago.BasicOutput)
+	case iotago.OutputAlias:
+		output = new(iotago.AliasOutput)
+	case iotago.OutputFoundry:
+		output = new(iotago.FoundryOutput)
+	case iotago.OutputNFT:
+		output = new(iotago.NFTOutput)
+	default:
+		return nil, errors.Errorf("unsupported output type %d", outputType.Type)
+	}
+
+	if err = api.JSONDecode(o.Output, output); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode output of type %s", outputType.Type)
+	}
+
+	return output, nil
+}
+
+func (o *Output) parseBasicOutput(bech32HRP iotago.NetworkPrefix) (output iotago.Output, err error) {
+	hrp, address, err := iotago.ParseBech32(o.Address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse address %s", o.Address)
+	}
+
+	if hrp != bech32HRP {
+		return nil, errors.Errorf("address %s does not use the bech32 HRP %s of the network", o.Address, bech32HRP)
+	}
+
+	return &iotago.BasicOutput{
+		Amount: o.Amount,
+		Conditions: iotago.BasicOutputUnlockConditions{
+			&iotago.AddressUnlockCondition{Address: address},
+		},
+	}, nil
+}
+
+// yamlToJSON converts the given YAML document to JSON, so that it can be decoded like a JSON genesis file.
+func yamlToJSON(yamlBytes []byte) ([]byte, error) {
+	var document any
+	if err := yaml.Unmarshal(yamlBytes, &document); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(document)
+}
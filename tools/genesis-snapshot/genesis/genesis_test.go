@@ -0,0 +1,151 @@
+package genesis
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/iota-core/pkg/protocol/snapshotcreator"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+const (
+	testProtocolParameters = `{"version":3,"networkName":"test","bech32Hrp":"rms","minPowScore":10,"rentStructure":{"vByteCost":100,"vByteFactorData":1,"vByteFactorKey":10},"tokenSupply":"10000000","genesisUnixTimestamp":0,"slotDurationInSeconds":10}`
+	testAddress            = "rms1qpz83a84y5vqevyglu3j77ljrexp4ed0rmya3zyxg445uyamzw0h2qmjj5d"
+	testPubKeyHash         = "0x035c555f8933e2caecb0ba34ff36df229706b237a006125fd91e59ae66943eea"
+)
+
+func TestDefinition_Validate(t *testing.T) {
+	definition := loadDefinition(t, `{
+		"protocolParameters": `+testProtocolParameters+`,
+		"outputs": [
+			{"address": "`+testAddress+`", "amount": 9000000},
+			{"output": {"type": 6, "amount": "1000000", "nftId": "0x0000000000000000000000000000000000000000000000000000000000000000", "unlockConditions": [{"type": 0, "address": {"type": 0, "pubKeyHash": "`+testPubKeyHash+`"}}]}}
+		],
+		"validators": [{"accountId": "`+testPubKeyHash+`", "weight": 100}]
+	}`)
+	require.NoError(t, definition.Validate())
+	require.Len(t, definition.outputs, 2)
+	require.Equal(t, map[iotago.AccountID]int64{iotago.MustIdentifierFromHexString(testPubKeyHash): 100}, definition.validators)
+
+	// the total amount of the outputs must match the token supply.
+	definition = loadDefinition(t, `{
+		"protocolParameters": `+testProtocolParameters+`,
+		"outputs": [{"address": "`+testAddress+`", "amount": 9000000}]
+	}`)
+	require.ErrorContains(t, definition.Validate(), "does not match the token supply")
+
+	// the outputs must not exceed the token supply.
+	definition = loadDefinition(t, `{
+		"protocolParameters": `+testProtocolParameters+`,
+		"outputs": [
+			{"address": "`+testAddress+`", "amount": 10000000},
+			{"address": "`+testAddress+`", "amount": 10000000}
+		]
+	}`)
+	require.ErrorIs(t, definition.Validate(), iotago.ErrOutputsSumExceedsTotalSupply)
+
+	// every output must cover its storage deposit.
+	definition = loadDefinition(t, `{
+		"protocolParameters": `+testProtocolParameters+`,
+		"outputs": [
+			{"address": "`+testAddress+`", "amount": 9999999},
+			{"address": "`+testAddress+`", "amount": 1}
+		]
+	}`)
+	require.ErrorIs(t, definition.Validate(), iotago.ErrVByteRentNotCovered)
+
+	// outputs without tokens are invalid.
+	definition = loadDefinition(t, `{
+		"protocolParameters": `+testProtocolParameters+`,
+		"outputs": [
+			{"address": "`+testAddress+`", "amount": 10000000},
+			{"output": {"type": 3, "amount": "0", "unlockConditions": [{"type": 0, "address": {"type": 0, "pubKeyHash": "`+testPubKeyHash+`"}}]}}
+		]
+	}`)
+	require.ErrorIs(t, definition.Validate(), iotago.ErrDepositAmountMustBeGreaterThanZero)
+
+	// chain outputs must be syntactically valid (an NFT must not be its own issuer).
+	definition = loadDefinition(t, `{
+		"protocolParameters": `+testProtocolParameters+`,
+		"outputs": [
+			{"address": "`+testAddress+`", "amount": 9000000},
+			{"output": {"type": 6, "amount": "1000000", "nftId": "0x0100000000000000000000000000000000000000000000000000000000000000", "unlockConditions": [{"type": 0, "address": {"type": 16, "nftId": "0x0100000000000000000000000000000000000000000000000000000000000000"}}]}}
+		]
+	}`)
+	require.ErrorIs(t, definition.Validate(), iotago.ErrNFTOutputCyclicAddress)
+
+	// validators must have a positive weight.
+	definition = loadDefinition(t, `{
+		"protocolParameters": `+testProtocolParameters+`,
+		"outputs": [{"address": "`+testAddress+`", "amount": 10000000}],
+		"validators": [{"accountId": "`+testPubKeyHash+`", "weight": 0}]
+	}`)
+	require.ErrorContains(t, definition.Validate(), "must be positive")
+}
+
+func TestDefinition_ValidateMaxOutputs(t *testing.T) {
+	definition := loadDefinition(t, `{
+		"protocolParameters": `+testProtocolParameters+`,
+		"outputs": [{"address": "`+testAddress+`", "amount": 10000000}]
+	}`)
+
+	// the outputs of the genesis are identified by their uint16 index, so more outputs can not be created.
+	for len(definition.outputs) <= snapshotcreator.MaxGenesisOutputs {
+		definition.outputs = append(definition.outputs, definition.outputs[0])
+	}
+	require.ErrorContains(t, definition.Validate(), "must not contain more than")
+}
+
+func TestLoad(t *testing.T) {
+	definition, err := Load(writeFile(t, "genesis.yaml", `
+protocolParameters:
+  version: 3
+  networkName: test
+  bech32Hrp: rms
+  tokenSupply: "10000000"
+  slotDurationInSeconds: 10
+outputs:
+  - address: `+testAddress+`
+    amount: 10000000
+`))
+	require.NoError(t, err)
+	require.Equal(t, "test", definition.protocolParameters.NetworkName)
+	require.NotZero(t, definition.protocolParameters.GenesisUnixTimestamp)
+	require.Len(t, definition.outputs, 1)
+	require.EqualValues(t, 10000000, definition.outputs[0].Deposit())
+
+	_, err = Load(writeFile(t, "genesis.toml", `{}`))
+	require.Error(t, err)
+
+	// the address must use the bech32 HRP of the network.
+	_, err = Load(writeFile(t, "genesis.json", `{
+		"protocolParameters": {"version":3,"networkName":"test","bech32Hrp":"atoi","tokenSupply":"10000000","slotDurationInSeconds":10},
+		"outputs": [{"address": "`+testAddress+`", "amount": 10000000}]
+	}`))
+	require.Error(t, err)
+
+	// an output must either be defined by an address and an amount or in the JSON format of the protocol.
+	_, err = Load(writeFile(t, "genesis.json", `{
+		"protocolParameters": `+testProtocolParameters+`,
+		"outputs": [{"address": "`+testAddress+`", "amount": 10000000, "output": {"type": 3}}]
+	}`))
+	require.Error(t, err)
+}
+
+// loadDefinition loads the given JSON genesis definition and fails the test if it can not be parsed.
+func loadDefinition(t *testing.T, definitionJSON string) *Definition {
+	definition, err := Load(writeFile(t, "genesis.json", definitionJSON))
+	require.NoError(t, err)
+
+	return definition
+}
+
+func writeFile(t *testing.T, fileName string, content string) string {
+	filePath := filepath.Join(t.TempDir(), fileName)
+	require.NoError(t, os.WriteFile(filePath, []byte(content), 0o600))
+
+	return filePath
+}
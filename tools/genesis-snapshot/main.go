@@ -7,11 +7,12 @@ import (
 
 	"github.com/iotaledger/hive.go/runtime/options"
 	"github.com/iotaledger/iota-core/pkg/protocol/snapshotcreator"
+	"github.com/iotaledger/iota-core/tools/genesis-snapshot/genesis"
 	"github.com/iotaledger/iota-core/tools/genesis-snapshot/presets"
 )
 
 func main() {
-	parsedOpts, configSelected := parseFlags()
+	parsedOpts, configSelected, genesisFile := parseFlags()
 	opts := presets.Base
 	switch configSelected {
 	case "docker":
@@ -21,6 +22,21 @@ func main() {
 	default:
 		configSelected = "default"
 	}
+
+	if genesisFile != "" {
+		definition, err := genesis.Load(genesisFile)
+		if err != nil {
+			log.Fatalf("failed to load genesis file %s: %s", genesisFile, err)
+		}
+
+		if err = definition.Validate(); err != nil {
+			log.Fatalf("invalid genesis file %s: %s", genesisFile, err)
+		}
+
+		configSelected = genesisFile
+		opts = append(opts, definition.Options()...)
+	}
+
 	opts = append(opts, parsedOpts...)
 	info := snapshotcreator.NewOptions(opts...)
 
@@ -31,14 +47,15 @@ func main() {
 	}
 }
 
-func parseFlags() (opt []options.Option[snapshotcreator.Options], conf string) {
+func parseFlags() (opt []options.Option[snapshotcreator.Options], conf string, genesisFile string) {
 	filename := flag.String("filename", "", "the name of the generated snapshot file")
 	config := flag.String("config", "", "use ready config: devnet, feature, docker")
+	genesisFilePath := flag.String("genesis", "", "the path of a JSON or YAML file that defines the genesis (overrides the ready config)")
 
 	flag.Parse()
 	opt = []options.Option[snapshotcreator.Options]{}
 	if *filename != "" {
 		opt = append(opt, snapshotcreator.WithFilePath(*filename))
 	}
-	return opt, *config
+	return opt, *config, *genesisFilePath
 }
@@ -0,0 +1,60 @@
+module github.com/iotaledger/iota-core/tools/ledger-rollback
+
+go 1.20
+
+replace github.com/iotaledger/iota-core => ../../
+
+require (
+	github.com/iotaledger/hive.go/core v1.0.0-rc.3.0.20230509142214-c542bb85ed3c
+	github.com/iotaledger/hive.go/kvstore v0.0.0-20230509142214-c542bb85ed3c
+	github.com/iotaledger/hive.go/runtime v0.0.0-20230509142214-c542bb85ed3c
+	github.com/iotaledger/iota-core v0.0.0-20230414140919-e676d4aef341
+	github.com/iotaledger/iota.go/v4 v4.0.0-20230517140417-5a7e3d76d50a
+	github.com/pkg/errors v0.9.1
+	github.com/spf13/pflag v1.0.5
+	github.com/stretchr/testify v1.8.2
+)
+
+require (
+	filippo.io/edwards25519 v1.0.0 // indirect
+	github.com/btcsuite/btcd/btcec/v2 v2.3.2 // indirect
+	github.com/celestiaorg/smt v0.3.0 // indirect
+	github.com/cockroachdb/errors v1.9.1 // indirect
+	github.com/cockroachdb/logtags v0.0.0-20230118201751-21c54148d20b // indirect
+	github.com/cockroachdb/redact v1.1.3 // indirect
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/decred/dcrd/dcrec/secp256k1/v4 v4.2.0 // indirect
+	github.com/ethereum/go-ethereum v1.11.6 // indirect
+	github.com/getsentry/sentry-go v0.21.0 // indirect
+	github.com/gogo/protobuf v1.3.2 // indirect
+	github.com/google/uuid v1.3.0 // indirect
+	github.com/holiman/uint256 v1.2.2 // indirect
+	github.com/iancoleman/orderedmap v0.2.0 // indirect
+	github.com/iotaledger/grocksdb v1.7.5-0.20230220105546-5162e18885c7 // indirect
+	github.com/iotaledger/hive.go/ads v0.0.0-20230509142214-c542bb85ed3c // indirect
+	github.com/iotaledger/hive.go/constraints v0.0.0-20230509142214-c542bb85ed3c // indirect
+	github.com/iotaledger/hive.go/crypto v0.0.0-20230509142214-c542bb85ed3c // indirect
+	github.com/iotaledger/hive.go/ds v0.0.0-20230509142214-c542bb85ed3c // indirect
+	github.com/iotaledger/hive.go/lo v0.0.0-20230509142214-c542bb85ed3c // indirect
+	github.com/iotaledger/hive.go/serializer/v2 v2.0.0-rc.1.0.20230509142214-c542bb85ed3c // indirect
+	github.com/iotaledger/hive.go/stringify v0.0.0-20230509142214-c542bb85ed3c // indirect
+	github.com/iotaledger/iota.go v1.0.0 // indirect
+	github.com/kr/pretty v0.3.1 // indirect
+	github.com/kr/text v0.2.0 // indirect
+	github.com/mr-tron/base58 v1.2.0 // indirect
+	github.com/natefinch/atomic v1.0.1 // indirect
+	github.com/oasisprotocol/ed25519 v0.0.0-20210505154701-76d8c688d86e // indirect
+	github.com/pelletier/go-toml/v2 v2.0.7 // indirect
+	github.com/petermattis/goid v0.0.0-20230317030725-371a4b8eda08 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/rogpeppe/go-internal v1.10.0 // indirect
+	github.com/sasha-s/go-deadlock v0.3.1 // indirect
+	github.com/zyedidia/generic v1.2.1 // indirect
+	go.uber.org/atomic v1.11.0 // indirect
+	golang.org/x/crypto v0.9.0 // indirect
+	golang.org/x/sys v0.8.0 // indirect
+	golang.org/x/text v0.9.0 // indirect
+	golang.org/x/xerrors v0.0.0-20220907171357-04be3eba64a2 // indirect
+	google.golang.org/protobuf v1.30.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
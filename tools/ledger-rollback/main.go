@@ -0,0 +1,211 @@
+// Package main implements a tool that rewinds the database of a stopped node to the commitment of an earlier slot, so
+// that a node that committed a bad slot does not have to be resynced from a snapshot. Like the node itself, it needs to
+// be built with the rocksdb build tag (e.g. `go run -tags rocksdb . --database testnet/database --slot 42`).
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	flag "github.com/spf13/pflag"
+
+	"github.com/iotaledger/hive.go/runtime/ioutils"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	"github.com/iotaledger/iota-core/pkg/storage"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// engineInfo is the content of the info file that the engine manager uses to store the name of the active engine.
+type engineInfo struct {
+	Name string `json:"name"`
+}
+
+// nodeConfig is the part of the node configuration that determines how the weights of the committee are derived.
+type nodeConfig struct {
+	Protocol struct {
+		SybilProtection struct {
+			Committee []struct {
+				Identity string `json:"identity"`
+				Weight   int64  `json:"weight"`
+			} `json:"committee"`
+			ProofOfStake struct {
+				Enabled bool `json:"enabled"`
+			} `json:"proofOfStake"`
+		} `json:"sybilProtection"`
+	} `json:"protocol"`
+}
+
+func main() {
+	databasePath := flag.String("database", "testnet/database", "the path to the database folder of the node")
+	configPath := flag.String("config", "config.json", "the path to the configuration file of the node")
+	targetSlot := flag.Uint64("slot", 0, "the slot of the commitment that the node is rolled back to")
+	flag.Parse()
+
+	if !flag.CommandLine.Changed("slot") {
+		log.Fatal("the target slot must be specified with --slot")
+	}
+
+	engineDirectory, err := activeEngineDirectory(*databasePath)
+	if err != nil {
+		log.Fatalf("failed to determine active engine in %s: %s", *databasePath, err)
+	}
+
+	committee, err := readCommittee(*configPath)
+	if err != nil {
+		log.Fatalf("failed to read committee from %s: %s", *configPath, err)
+	}
+
+	result, err := rollbackEngineDirectory(engineDirectory, iotago.SlotIndex(*targetSlot), committee)
+	if err != nil {
+		log.Fatalf("failed to roll back %s to slot %d: %s", engineDirectory, *targetSlot, err)
+	}
+
+	fmt.Printf("Rolled back %s\n", engineDirectory)
+	fmt.Printf("  From slot:             %d\n", result.PreviousCommitment.Index())
+	fmt.Printf("  To slot:               %d\n", result.Commitment.Index())
+	fmt.Printf("  Commitment:            %s\n", result.Commitment.ID())
+	fmt.Printf("  State root:            %s (verified)\n", result.StateRoot)
+	fmt.Printf("  Accounts root:         %s\n", result.AccountsRoot)
+	fmt.Printf("  Mana root:             %s (verified)\n", result.ManaRoot)
+	fmt.Printf("  Latest finalized slot: %d\n", result.LatestFinalizedSlot)
+}
+
+// activeEngineDirectory returns the directory of the engine that is marked as active in the given database folder.
+func activeEngineDirectory(databasePath string) (string, error) {
+	info := new(engineInfo)
+	if err := ioutils.ReadJSONFromFile(filepath.Join(databasePath, "info"), info); err != nil {
+		return "", err
+	} else if info.Name == "" {
+		return "", errors.New("no active engine")
+	}
+
+	engineDirectory := filepath.Join(databasePath, info.Name)
+	if _, err := os.Stat(engineDirectory); err != nil {
+		return "", err
+	}
+
+	return engineDirectory, nil
+}
+
+// rollbackEngineDirectory rolls back a copy of the given engine directory and only replaces the engine directory with
+// the copy once the rollback was verified, so that a failed rollback leaves the database of the node untouched.
+func rollbackEngineDirectory(engineDirectory string, targetSlot iotago.SlotIndex, committee map[iotago.AccountID]int64) (*Result, error) {
+	rollbackDirectory := engineDirectory + ".rollback"
+	if err := os.RemoveAll(rollbackDirectory); err != nil {
+		return nil, errors.Wrap(err, "failed to remove leftovers of a previous rollback")
+	}
+
+	if err := copyDirectory(engineDirectory, rollbackDirectory); err != nil {
+		_ = os.RemoveAll(rollbackDirectory)
+		return nil, errors.Wrap(err, "failed to copy engine directory")
+	}
+
+	store := storage.New(rollbackDirectory, protocol.DatabaseVersion, func(err error) {
+		log.Printf("storage error: %s", err)
+	})
+
+	result, err := Rollback(store, targetSlot, committee)
+	store.Shutdown()
+
+	if err != nil {
+		_ = os.RemoveAll(rollbackDirectory)
+		return nil, err
+	}
+
+	previousDirectory := engineDirectory + ".previous"
+	if err = os.RemoveAll(previousDirectory); err != nil {
+		return nil, errors.Wrap(err, "failed to remove leftovers of a previous rollback")
+	}
+
+	if err = os.Rename(engineDirectory, previousDirectory); err != nil {
+		return nil, errors.Wrap(err, "failed to move engine directory")
+	}
+
+	if err = os.Rename(rollbackDirectory, engineDirectory); err != nil {
+		return nil, errors.Wrapf(err, "failed to move rolled back engine directory (the original is kept in %s)", previousDirectory)
+	}
+
+	if err = os.RemoveAll(previousDirectory); err != nil {
+		return nil, errors.Wrapf(err, "failed to remove the original engine directory %s", previousDirectory)
+	}
+
+	return result, nil
+}
+
+// copyDirectory recursively copies the files of the source directory to the (new) target directory.
+func copyDirectory(sourceDirectory string, targetDirectory string) error {
+	return filepath.WalkDir(sourceDirectory, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relativePath, err := filepath.Rel(sourceDirectory, path)
+		if err != nil {
+			return err
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		targetPath := filepath.Join(targetDirectory, relativePath)
+		if entry.IsDir() {
+			return os.MkdirAll(targetPath, info.Mode().Perm())
+		}
+
+		return copyFile(path, targetPath, info.Mode().Perm())
+	})
+}
+
+func copyFile(sourcePath string, targetPath string, perm fs.FileMode) error {
+	source, err := os.Open(sourcePath)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	target, err := os.OpenFile(targetPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm)
+	if err != nil {
+		return err
+	}
+
+	if _, err = io.Copy(target, source); err != nil {
+		_ = target.Close()
+		return err
+	}
+
+	return target.Close()
+}
+
+// readCommittee reads the statically configured committee from the configuration of the node. It returns nil if the
+// committee is derived from the stake (proof of stake).
+func readCommittee(configPath string) (map[iotago.AccountID]int64, error) {
+	config := new(nodeConfig)
+	if err := ioutils.ReadJSONFromFile(configPath, config); err != nil {
+		return nil, err
+	}
+
+	if config.Protocol.SybilProtection.ProofOfStake.Enabled {
+		return nil, nil
+	}
+
+	committee := make(map[iotago.AccountID]int64)
+	for _, validator := range config.Protocol.SybilProtection.Committee {
+		identity, err := iotago.DecodeHex(validator.Identity)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode identity %s", validator.Identity)
+		} else if len(identity) != iotago.IdentifierLength {
+			return nil, errors.Errorf("identity %s has an invalid length", validator.Identity)
+		}
+
+		committee[iotago.AccountID(identity)] = validator.Weight
+	}
+
+	return committee, nil
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestReadCommittee(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+
+	require.NoError(t, os.WriteFile(configPath, []byte(`{"protocol":{"sybilProtection":{"committee":[{"identity":"0x0100000000000000000000000000000000000000000000000000000000000000","weight":10}]}}}`), 0o600))
+	committee, err := readCommittee(configPath)
+	require.NoError(t, err)
+	require.Equal(t, map[iotago.AccountID]int64{{1}: 10}, committee)
+
+	// a node without a configured committee still uses the (empty) static committee.
+	require.NoError(t, os.WriteFile(configPath, []byte(`{}`), 0o600))
+	committee, err = readCommittee(configPath)
+	require.NoError(t, err)
+	require.NotNil(t, committee)
+	require.Empty(t, committee)
+
+	// the weights of a proof of stake node are rolled back from its storage.
+	require.NoError(t, os.WriteFile(configPath, []byte(`{"protocol":{"sybilProtection":{"proofOfStake":{"enabled":true}}}}`), 0o600))
+	committee, err = readCommittee(configPath)
+	require.NoError(t, err)
+	require.Nil(t, committee)
+
+	require.NoError(t, os.WriteFile(configPath, []byte(`{"protocol":{"sybilProtection":{"committee":[{"identity":"0x01","weight":10}]}}}`), 0o600))
+	_, err = readCommittee(configPath)
+	require.Error(t, err)
+
+	_, err = readCommittee(filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+}
+
+func TestCopyDirectory(t *testing.T) {
+	sourceDirectory := filepath.Join(t.TempDir(), "engine")
+	require.NoError(t, os.MkdirAll(filepath.Join(sourceDirectory, "prunable", "0"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDirectory, "commitments.bin"), []byte("commitments"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDirectory, "prunable", "0", "data"), []byte("data"), 0o600))
+
+	targetDirectory := filepath.Join(t.TempDir(), "engine.rollback")
+	require.NoError(t, copyDirectory(sourceDirectory, targetDirectory))
+
+	commitments, err := os.ReadFile(filepath.Join(targetDirectory, "commitments.bin"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("commitments"), commitments)
+
+	data, err := os.ReadFile(filepath.Join(targetDirectory, "prunable", "0", "data"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("data"), data)
+
+	// the copy is independent of the source.
+	require.NoError(t, os.WriteFile(filepath.Join(targetDirectory, "commitments.bin"), []byte("modified"), 0o600))
+	commitments, err = os.ReadFile(filepath.Join(sourceDirectory, "commitments.bin"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("commitments"), commitments)
+
+	// files that exist in the target are not overwritten.
+	require.Error(t, copyDirectory(sourceDirectory, targetDirectory))
+}
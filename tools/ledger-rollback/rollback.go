@@ -0,0 +1,170 @@
+package main
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/core/account"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledger/accountsledger"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/notarization/slotnotarization"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/sybilprotection/pos"
+	"github.com/iotaledger/iota-core/pkg/storage"
+	"github.com/iotaledger/iota-core/pkg/traits"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// Result summarizes the state of a storage after it was rolled back.
+type Result struct {
+	// PreviousCommitment is the latest commitment before the rollback.
+	PreviousCommitment *model.Commitment
+	// Commitment is the latest commitment after the rollback.
+	Commitment *model.Commitment
+	// StateRoot is the root of the ledger state after the rollback (it matches the state root of the commitment).
+	StateRoot iotago.Identifier
+	// AccountsRoot is the root of the accounts ledger after the rollback.
+	AccountsRoot iotago.Identifier
+	// ManaRoot is the root that combines the accounts root and the weights root (it matches the mana root of the
+	// commitment).
+	ManaRoot iotago.Identifier
+	// LatestFinalizedSlot is the latest finalized slot after the rollback.
+	LatestFinalizedSlot iotago.SlotIndex
+}
+
+// Rollback rewinds the given (offline) storage to the commitment of the given slot. It rolls back the ledger state, the
+// accounts ledger and the weights of the sybil protection, verifies them against the roots of the target commitment
+// and removes everything that was committed after the target slot. The storage is modified in place, so it should be a
+// copy that is discarded if the rollback fails.
+//
+// The committee is the statically configured committee of a node that uses proof of authority, or nil if the weights
+// are derived from the stake (proof of stake) and therefore need to be rolled back as well.
+func Rollback(store *storage.Storage, targetSlot iotago.SlotIndex, committee map[iotago.AccountID]int64) (result *Result, err error) {
+	if !store.Settings().SnapshotImported() {
+		return nil, errors.New("the storage was not initialized from a snapshot")
+	}
+
+	// initialize the storage the same way as the engine does when it is restored from disk.
+	store.Settings().UpdateAPI()
+	store.Settings().TriggerInitialized()
+	store.Commitments().TriggerInitialized()
+	store.Prunable.RestoreFromDisk()
+
+	result = &Result{
+		PreviousCommitment: store.Settings().LatestCommitment(),
+	}
+
+	if targetSlot >= result.PreviousCommitment.Index() {
+		return nil, errors.Errorf("target slot %d is not before the latest committed slot %d", targetSlot, result.PreviousCommitment.Index())
+	}
+
+	if result.Commitment, err = store.Commitments().Load(targetSlot); err != nil {
+		return nil, errors.Wrapf(err, "failed to load commitment of slot %d", targetSlot)
+	}
+
+	targetRoots, err := loadRoots(store, result.Commitment)
+	if err != nil {
+		return nil, err
+	}
+
+	ledgerState := ledgerstate.New(store.Ledger(), store.Settings().CurrentAPI)
+	if err = ledgerState.RollbackToSlot(targetSlot); err != nil {
+		return nil, errors.Wrap(err, "failed to roll back ledger state")
+	}
+
+	if !ledgerState.CheckStateTree() {
+		return nil, errors.New("the ledger state tree does not match the unspent outputs after the rollback")
+	}
+
+	if result.StateRoot = ledgerState.StateTreeRoot(); result.StateRoot != targetRoots.StateRoot {
+		return nil, errors.Errorf("state root %s after the rollback does not match the state root %s of commitment %s", result.StateRoot, targetRoots.StateRoot, result.Commitment.ID())
+	}
+
+	accountsLedger := accountsledger.New(store.Accounts(), store.Settings().EpochLength)
+	if err = accountsLedger.RollbackToSlot(targetSlot); err != nil {
+		return nil, errors.Wrap(err, "failed to roll back accounts ledger")
+	}
+	result.AccountsRoot = accountsLedger.AccountsRoot()
+
+	weightsRoot, err := rollbackWeights(store, targetSlot, committee)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to roll back weights")
+	}
+
+	if result.ManaRoot = slotnotarization.ManaRoot(result.AccountsRoot, weightsRoot); result.ManaRoot != targetRoots.ManaRoot {
+		return nil, errors.Errorf("mana root %s (accounts root %s) after the rollback does not match the mana root %s of commitment %s", result.ManaRoot, result.AccountsRoot, targetRoots.ManaRoot, result.Commitment.ID())
+	}
+
+	traits.NewCommittable(store.Permanent.Attestations(), slotnotarization.PrefixAttestationsLastCommittedSlot).SetLastCommittedSlot(targetSlot)
+
+	if err = store.Commitments().RollbackToSlot(targetSlot); err != nil {
+		return nil, errors.Wrap(err, "failed to roll back commitments")
+	}
+
+	if err = rollbackSettings(store, result.Commitment); err != nil {
+		return nil, errors.Wrap(err, "failed to roll back settings")
+	}
+	result.LatestFinalizedSlot = store.Settings().LatestFinalizedSlot()
+
+	if err = store.Prunable.RollbackToSlot(targetSlot); err != nil {
+		return nil, errors.Wrap(err, "failed to roll back prunable storage")
+	}
+
+	return result, nil
+}
+
+// loadRoots loads the roots of the given commitment from the prunable storage and checks that they match the
+// commitment.
+func loadRoots(store *storage.Storage, commitment *model.Commitment) (*iotago.Roots, error) {
+	rootsStorage := store.Roots(commitment.Index())
+	if rootsStorage == nil {
+		return nil, errors.Errorf("the roots of slot %d were already pruned", commitment.Index())
+	}
+
+	roots, err := rootsStorage.Load()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load roots of slot %d", commitment.Index())
+	}
+
+	if rootsID := model.RootsID(roots); rootsID != commitment.RootsID() {
+		return nil, errors.Errorf("roots %s of slot %d do not match the roots %s of commitment %s", rootsID, commitment.Index(), commitment.RootsID(), commitment.ID())
+	}
+
+	return roots, nil
+}
+
+// rollbackWeights rolls back the weights of the sybil protection and returns the root of the weights that the target
+// slot was committed with. The weights of a statically configured committee never change, so they are only hashed.
+func rollbackWeights(store *storage.Storage, targetSlot iotago.SlotIndex, committee map[iotago.AccountID]int64) (iotago.Identifier, error) {
+	if committee == nil {
+		return pos.RollbackToSlot(store.Permanent.SybilProtection(), targetSlot)
+	}
+
+	weights := account.NewAccounts[iotago.AccountID](mapdb.NewMapDB())
+	for accountID, weight := range committee {
+		weights.Set(accountID, weight)
+	}
+
+	return iotago.Identifier(weights.Root()), nil
+}
+
+// rollbackSettings sets the latest commitment to the given commitment and resets the slots that were set after it.
+func rollbackSettings(store *storage.Storage, commitment *model.Commitment) error {
+	if err := store.Settings().SetLatestCommitment(commitment); err != nil {
+		return errors.Wrap(err, "failed to set latest commitment")
+	}
+
+	if store.Settings().LatestFinalizedSlot() > commitment.Index() {
+		if err := store.Settings().SetLatestFinalizedSlot(commitment.Index()); err != nil {
+			return errors.Wrap(err, "failed to set latest finalized slot")
+		}
+	}
+
+	if store.Settings().LatestStateMutationSlot() > commitment.Index() {
+		if err := store.Settings().SetLatestStateMutationSlot(commitment.Index()); err != nil {
+			return errors.Wrap(err, "failed to set latest state mutation slot")
+		}
+	}
+
+	return nil
+}
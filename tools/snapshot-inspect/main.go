@@ -0,0 +1,124 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"sort"
+
+	flag "github.com/spf13/pflag"
+
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func main() {
+	filePath := flag.String("snapshot", "snapshot.bin", "the path of the snapshot file to inspect")
+	flag.Parse()
+
+	snapshot, err := ReadSnapshot(*filePath)
+	if err != nil {
+		log.Fatalf("failed to read snapshot %s: %s", *filePath, err)
+	}
+	defer snapshot.Close()
+
+	if err = printSummary(snapshot); err != nil {
+		log.Fatalf("failed to print summary of snapshot %s: %s", *filePath, err)
+	}
+
+	if !verify(snapshot) {
+		snapshot.Close()
+		os.Exit(1)
+	}
+}
+
+func printSummary(snapshot *Snapshot) error {
+	protocolParameters := snapshot.Settings.ProtocolParameters()
+	latestCommitment := snapshot.Settings.LatestCommitment()
+
+	fmt.Println("Protocol parameters")
+	fmt.Printf("  Version:               %d\n", protocolParameters.Version)
+	fmt.Printf("  Network name:          %s\n", protocolParameters.NetworkName)
+	fmt.Printf("  Bech32 HRP:            %s\n", protocolParameters.Bech32HRP)
+	fmt.Printf("  Min PoW score:         %d\n", protocolParameters.MinPoWScore)
+	fmt.Printf("  Token supply:          %d\n", protocolParameters.TokenSupply)
+	fmt.Printf("  Genesis timestamp:     %d\n", protocolParameters.GenesisUnixTimestamp)
+	fmt.Printf("  Slot duration:         %ds\n", protocolParameters.SlotDurationInSeconds)
+	fmt.Printf("  Epoch length:          %d\n", snapshot.Settings.EpochLength())
+	fmt.Printf("  Max committee size:    %d\n", snapshot.Settings.MaxCommitteeSize())
+	fmt.Printf("  Minimum stake:         %d\n", snapshot.Settings.MinimumStake())
+
+	fmt.Println("Latest commitment")
+	fmt.Printf("  Slot:                  %d\n", latestCommitment.Index())
+	fmt.Printf("  ID:                    %s\n", latestCommitment.ID())
+	fmt.Printf("  Previous ID:           %s\n", latestCommitment.PrevID())
+	fmt.Printf("  Roots ID:              %s\n", latestCommitment.RootsID())
+	if snapshot.Roots != nil {
+		fmt.Printf("  Committed state root:  %s\n", snapshot.Roots.StateRoot)
+	}
+	fmt.Printf("  Cumulative weight:     %d\n", latestCommitment.CumulativeWeight())
+	fmt.Printf("  Latest finalized slot: %d\n", snapshot.Settings.LatestFinalizedSlot())
+
+	balance, outputCount, err := snapshot.LedgerState.ComputeLedgerBalance()
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Ledger")
+	fmt.Printf("  Unspent outputs:       %d\n", outputCount)
+	fmt.Printf("  Total balance:         %d\n", balance)
+	fmt.Printf("  State root:            %s\n", snapshot.LedgerState.StateTreeRoot())
+	fmt.Printf("  Accounts root:         %s\n", snapshot.AccountsLedger.AccountsRoot())
+
+	fmt.Println("Consensus")
+	fmt.Printf("  Root blocks:           %d\n", len(snapshot.RootBlocks))
+	fmt.Printf("  Committee members:     %d (total weight %d)\n", len(snapshot.Committee), totalWeight(snapshot.Committee))
+
+	slots := make([]iotago.SlotIndex, 0, len(snapshot.AttestationWindow))
+	for slot := range snapshot.AttestationWindow {
+		slots = append(slots, slot)
+	}
+	sort.Slice(slots, func(i, j int) bool { return slots[i] < slots[j] })
+
+	for _, slot := range slots {
+		fmt.Printf("  Attestations slot %-4d %d (weight %d)\n", slot, snapshot.AttestationWindow[slot].Count, snapshot.AttestationWindow[slot].Weight)
+	}
+
+	return nil
+}
+
+func verify(snapshot *Snapshot) (valid bool) {
+	valid = true
+
+	fmt.Println("Verification")
+
+	if err := snapshot.VerifyCommitmentChain(); err != nil {
+		fmt.Printf("  Commitment chain:      FAILED (%s)\n", err)
+		valid = false
+	} else {
+		fmt.Println("  Commitment chain:      OK")
+	}
+
+	if err := snapshot.VerifyLedgerIndex(); err != nil {
+		fmt.Printf("  Ledger index:          FAILED (%s)\n", err)
+		valid = false
+	} else {
+		fmt.Println("  Ledger index:          OK")
+	}
+
+	if err := snapshot.VerifyStateTree(); err != nil {
+		fmt.Printf("  State tree:            FAILED (%s)\n", err)
+		valid = false
+	} else {
+		fmt.Println("  State tree:            OK")
+	}
+
+	return valid
+}
+
+func totalWeight(weights map[iotago.AccountID]int64) (total int64) {
+	for _, weight := range weights {
+		total += weight
+	}
+
+	return total
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/serializer/v2/stream"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledger/accountsledger"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledgerstate"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/sybilprotection"
+	"github.com/iotaledger/iota-core/pkg/storage/permanent"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// Snapshot holds the sections of a snapshot file that was written by Engine.Export.
+type Snapshot struct {
+	Settings       *permanent.Settings
+	Commitments    *permanent.Commitments
+	LedgerState    *ledgerstate.Manager
+	AccountsLedger *accountsledger.Manager
+	RootBlocks     map[iotago.BlockID]iotago.CommitmentID
+	// Committee contains the weights that the mana root of the latest commitment is derived from (accounts that left
+	// the committee have a weight of 0).
+	Committee map[iotago.AccountID]int64
+
+	// AttestationWindow contains the committed attestations of the slots of the attestation window.
+	AttestationWindow map[iotago.SlotIndex]*SlotAttestations
+	// Roots contains the roots that the latest commitment commits to (nil if they are not part of the snapshot, e.g. for
+	// a genesis snapshot).
+	Roots *iotago.Roots
+
+	directory string
+}
+
+// SlotAttestations summarizes the committed attestations of a slot.
+type SlotAttestations struct {
+	Weight int64
+	Count  int
+}
+
+// ReadSnapshot reads all sections of the snapshot file at the given path. The settings and commitments are
+// persisted in a temporary directory that is removed by Close.
+func ReadSnapshot(filePath string) (snapshot *Snapshot, err error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open snapshot file")
+	}
+	defer file.Close()
+
+	directory, err := os.MkdirTemp("", "snapshot-inspect-*")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create temporary directory")
+	}
+
+	snapshot = &Snapshot{
+		Settings:          permanent.NewSettings(filepath.Join(directory, "settings.bin")),
+		RootBlocks:        make(map[iotago.BlockID]iotago.CommitmentID),
+		AttestationWindow: make(map[iotago.SlotIndex]*SlotAttestations),
+		directory:         directory,
+	}
+	snapshot.Commitments = permanent.NewCommitments(filepath.Join(directory, "commitments.bin"), snapshot.Settings.API)
+	snapshot.LedgerState = ledgerstate.New(mapdb.NewMapDB(), snapshot.latestAPI)
+	snapshot.AccountsLedger = accountsledger.New(mapdb.NewMapDB(), snapshot.Settings.EpochLength)
+
+	if err = snapshot.read(file); err != nil {
+		snapshot.Close()
+
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// latestAPI returns the API of the latest commitment of the snapshot.
+func (s *Snapshot) latestAPI() iotago.API {
+	return s.Settings.API(s.Settings.LatestCommitment().Index())
+}
+
+// Close removes the temporary files of the snapshot.
+func (s *Snapshot) Close() {
+	_ = os.RemoveAll(s.directory)
+}
+
+func (s *Snapshot) read(reader io.ReadSeeker) (err error) {
+	if err = s.Settings.Import(reader); err != nil {
+		return errors.Wrap(err, "failed to read settings")
+	} else if err = s.Commitments.Import(reader); err != nil {
+		return errors.Wrap(err, "failed to read commitments")
+	} else if err = s.LedgerState.Import(reader); err != nil {
+		return errors.Wrap(err, "failed to read ledger state")
+	} else if err = s.AccountsLedger.Import(reader); err != nil {
+		return errors.Wrap(err, "failed to read accounts ledger")
+	} else if err = s.readRootBlocks(reader); err != nil {
+		return errors.Wrap(err, "failed to read root blocks")
+	} else if s.Committee, err = sybilprotection.ReadWeights(reader); err != nil {
+		return errors.Wrap(err, "failed to read committee")
+	} else if err = s.readNotarization(reader); err != nil {
+		return errors.Wrap(err, "failed to read notarization state")
+	}
+
+	// the notarization state is the last section that is written by Engine.Export.
+	if _, err = reader.Read(make([]byte, 1)); !errors.Is(err, io.EOF) {
+		return errors.New("unexpected data after the notarization state")
+	}
+
+	return nil
+}
+
+func (s *Snapshot) readRootBlocks(reader io.ReadSeeker) (err error) {
+	return stream.ReadCollection(reader, func(i int) error {
+		var rootBlockID iotago.BlockID
+		if err = stream.ReadSerializable(reader, &rootBlockID, iotago.BlockIDLength); err != nil {
+			return errors.Wrapf(err, "failed to read root block id %d", i)
+		}
+
+		var commitmentID iotago.CommitmentID
+		if err = stream.ReadSerializable(reader, &commitmentID, iotago.CommitmentIDLength); err != nil {
+			return errors.Wrapf(err, "failed to read root block's %s commitment id", rootBlockID)
+		}
+
+		s.RootBlocks[rootBlockID] = commitmentID
+
+		return nil
+	})
+}
+
+func (s *Snapshot) readNotarization(reader io.ReadSeeker) (err error) {
+	if _, err = stream.Read[uint64](reader); err != nil {
+		return errors.Wrap(err, "failed to read target slot")
+	}
+
+	if err = stream.ReadCollection(reader, func(int) error {
+		return s.readAttestations(reader)
+	}); err != nil {
+		return err
+	}
+
+	return s.readRoots(reader)
+}
+
+func (s *Snapshot) readRoots(reader io.ReadSeeker) (err error) {
+	rootsBytes, err := stream.ReadBlob(reader)
+	if err != nil {
+		return errors.Wrap(err, "failed to read roots")
+	} else if len(rootsBytes) == 0 {
+		return nil
+	}
+
+	s.Roots = new(iotago.Roots)
+	if _, err = s.latestAPI().Decode(rootsBytes, s.Roots); err != nil {
+		return errors.Wrap(err, "failed to decode roots")
+	}
+
+	return nil
+}
+
+func (s *Snapshot) readAttestations(reader io.ReadSeeker) (err error) {
+	slot, err := stream.Read[uint64](reader)
+	if err != nil {
+		return errors.Wrap(err, "failed to read slot")
+	}
+
+	slotAttestations := new(SlotAttestations)
+	if slotAttestations.Weight, err = stream.Read[int64](reader); err != nil {
+		return errors.Wrapf(err, "failed to read weight of slot %d", slot)
+	}
+	s.AttestationWindow[iotago.SlotIndex(slot)] = slotAttestations
+
+	return stream.ReadCollection(reader, func(i int) error {
+		if err = stream.ReadSerializable(reader, new(iotago.Attestation)); err != nil {
+			return errors.Wrapf(err, "failed to read attestation %d of slot %d", i, slot)
+		}
+
+		slotAttestations.Count++
+
+		return nil
+	})
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	hivedb "github.com/iotaledger/hive.go/kvstore/database"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/snapshotcreator"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func createSnapshot(t *testing.T, validators map[iotago.AccountID]int64, rootBlocks map[iotago.BlockID]iotago.CommitmentID) string {
+	filePath := filepath.Join(t.TempDir(), "snapshot.bin")
+
+	require.NoError(t, snapshotcreator.CreateSnapshot(
+		snapshotcreator.WithDatabaseEngine(hivedb.EngineMapDB),
+		snapshotcreator.WithFilePath(filePath),
+		snapshotcreator.WithProtocolParameters(iotago.ProtocolParameters{
+			Version:     3,
+			NetworkName: t.Name(),
+			Bech32HRP:   "rms",
+			RentStructure: iotago.RentStructure{
+				VByteCost:    100,
+				VBFactorData: 1,
+				VBFactorKey:  10,
+			},
+			TokenSupply:           1_000_0000,
+			GenesisUnixTimestamp:  uint32(time.Now().Unix()),
+			SlotDurationInSeconds: 10,
+		}),
+		snapshotcreator.WithGenesisSeed(make([]byte, 32)),
+		snapshotcreator.WithValidators(validators),
+		snapshotcreator.WithRootBlocks(rootBlocks),
+	))
+
+	return filePath
+}
+
+func TestReadSnapshot(t *testing.T) {
+	validators := map[iotago.AccountID]int64{
+		{1}: 10,
+		{2}: 20,
+	}
+	rootBlocks := map[iotago.BlockID]iotago.CommitmentID{
+		iotago.EmptyBlockID(): iotago.NewEmptyCommitment().MustID(),
+	}
+
+	snapshot, err := ReadSnapshot(createSnapshot(t, validators, rootBlocks))
+	require.NoError(t, err)
+	defer snapshot.Close()
+
+	require.Equal(t, t.Name(), snapshot.Settings.ProtocolParameters().NetworkName)
+	require.Equal(t, iotago.SlotIndex(0), snapshot.Settings.LatestCommitment().Index())
+
+	balance, outputCount, err := snapshot.LedgerState.ComputeLedgerBalance()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1_000_0000), balance)
+	require.Equal(t, 1, outputCount)
+
+	require.Equal(t, rootBlocks, snapshot.RootBlocks)
+	require.Equal(t, validators, snapshot.Committee)
+	// the attestation window of a genesis snapshot only contains the genesis slot, which has no attestations.
+	require.Equal(t, map[iotago.SlotIndex]*SlotAttestations{0: {}}, snapshot.AttestationWindow)
+
+	require.NoError(t, snapshot.VerifyCommitmentChain())
+	require.NoError(t, snapshot.VerifyLedgerIndex())
+	require.NoError(t, snapshot.VerifyStateTree())
+	require.True(t, verify(snapshot))
+}
+
+func TestSnapshot_VerifyStateTree(t *testing.T) {
+	snapshot, err := ReadSnapshot(createSnapshot(t, map[iotago.AccountID]int64{}, map[iotago.BlockID]iotago.CommitmentID{}))
+	require.NoError(t, err)
+	defer snapshot.Close()
+
+	// the genesis commitment does not commit to any roots.
+	require.Nil(t, snapshot.Roots)
+	require.NoError(t, snapshot.VerifyStateTree())
+
+	setLatestCommitment := func(roots *iotago.Roots) {
+		commitment, commitmentErr := model.CommitmentFromCommitment(iotago.NewCommitment(1, snapshot.Settings.LatestCommitment().ID(), model.RootsID(roots), 0), snapshot.latestAPI())
+		require.NoError(t, commitmentErr)
+		require.NoError(t, snapshot.Settings.SetLatestCommitment(commitment))
+	}
+
+	roots := iotago.NewRoots(iotago.Identifier{1}, iotago.Identifier{2}, iotago.Identifier{3}, snapshot.LedgerState.StateTreeRoot(), iotago.Identifier{4})
+	setLatestCommitment(roots)
+
+	// the roots of a commitment that commits to roots need to be part of the snapshot.
+	require.Error(t, snapshot.VerifyStateTree())
+
+	snapshot.Roots = roots
+	require.NoError(t, snapshot.VerifyStateTree())
+
+	// the roots need to match the commitment.
+	snapshot.Roots = iotago.NewRoots(iotago.Identifier{5}, iotago.Identifier{2}, iotago.Identifier{3}, snapshot.LedgerState.StateTreeRoot(), iotago.Identifier{4})
+	require.Error(t, snapshot.VerifyStateTree())
+
+	// the state tree needs to match the committed state root.
+	roots = iotago.NewRoots(iotago.Identifier{1}, iotago.Identifier{2}, iotago.Identifier{3}, iotago.Identifier{6}, iotago.Identifier{4})
+	setLatestCommitment(roots)
+	snapshot.Roots = roots
+	require.Error(t, snapshot.VerifyStateTree())
+}
+
+func TestReadSnapshot_Invalid(t *testing.T) {
+	filePath := createSnapshot(t, map[iotago.AccountID]int64{}, map[iotago.BlockID]iotago.CommitmentID{})
+
+	snapshotBytes, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+
+	// a snapshot that is cut off is rejected.
+	require.NoError(t, os.WriteFile(filePath, snapshotBytes[:len(snapshotBytes)-1], 0o600))
+	_, err = ReadSnapshot(filePath)
+	require.Error(t, err)
+
+	// a snapshot that contains more sections than Engine.Export writes is rejected.
+	require.NoError(t, os.WriteFile(filePath, append(snapshotBytes, 0), 0o600))
+	_, err = ReadSnapshot(filePath)
+	require.Error(t, err)
+
+	_, err = ReadSnapshot(filepath.Join(t.TempDir(), "missing.bin"))
+	require.Error(t, err)
+}
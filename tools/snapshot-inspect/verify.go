@@ -0,0 +1,79 @@
+package main
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/iota-core/pkg/model"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// VerifyCommitmentChain checks that every commitment of the snapshot references the commitment of the previous slot.
+func (s *Snapshot) VerifyCommitmentChain() error {
+	latestCommitment := s.Settings.LatestCommitment()
+
+	previousCommitment, err := s.Commitments.Load(0)
+	if err != nil {
+		return errors.Wrap(err, "failed to load commitment of slot 0")
+	}
+
+	for index := iotago.SlotIndex(1); index <= latestCommitment.Index(); index++ {
+		commitment, err := s.Commitments.Load(index)
+		if err != nil {
+			return errors.Wrapf(err, "failed to load commitment of slot %d", index)
+		}
+
+		if commitment.PrevID() != previousCommitment.ID() {
+			return errors.Errorf("commitment %s of slot %d references %s instead of %s", commitment.ID(), index, commitment.PrevID(), previousCommitment.ID())
+		}
+
+		previousCommitment = commitment
+	}
+
+	if previousCommitment.ID() != latestCommitment.ID() {
+		return errors.Errorf("latest commitment %s does not match the commitment %s of slot %d", latestCommitment.ID(), previousCommitment.ID(), latestCommitment.Index())
+	}
+
+	return nil
+}
+
+// VerifyStateTree recomputes the root of the ledger state tree from the unspent outputs and compares it to the state
+// root that the latest commitment commits to. The genesis commitment does not commit to any roots, so the state tree of
+// a genesis snapshot is only checked against its unspent outputs.
+func (s *Snapshot) VerifyStateTree() error {
+	if !s.LedgerState.CheckStateTree() {
+		return errors.New("the ledger state tree does not match the unspent outputs")
+	}
+
+	latestCommitment := s.Settings.LatestCommitment()
+	if s.Roots == nil {
+		if latestCommitment.RootsID() != (iotago.Identifier{}) {
+			return errors.Errorf("the snapshot does not contain the roots of the latest commitment %s", latestCommitment.ID())
+		}
+
+		return nil
+	}
+
+	if rootsID := model.RootsID(s.Roots); rootsID != latestCommitment.RootsID() {
+		return errors.Errorf("roots %s do not match the roots %s of the latest commitment %s", rootsID, latestCommitment.RootsID(), latestCommitment.ID())
+	}
+
+	if stateRoot := s.LedgerState.StateTreeRoot(); stateRoot != s.Roots.StateRoot {
+		return errors.Errorf("state tree root %s does not match the state root %s of the latest commitment %s", stateRoot, s.Roots.StateRoot, latestCommitment.ID())
+	}
+
+	return nil
+}
+
+// VerifyLedgerIndex checks that the ledger state was exported at the slot of the latest commitment.
+func (s *Snapshot) VerifyLedgerIndex() error {
+	ledgerIndex, err := s.LedgerState.ReadLedgerIndex()
+	if err != nil {
+		return errors.Wrap(err, "failed to read ledger index")
+	}
+
+	if latestCommitmentIndex := s.Settings.LatestCommitment().Index(); ledgerIndex != latestCommitmentIndex {
+		return errors.Errorf("ledger index %d does not match the slot %d of the latest commitment", ledgerIndex, latestCommitmentIndex)
+	}
+
+	return nil
+}